@@ -0,0 +1,256 @@
+package e2e
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/client"
+	"github.com/fasthttp/websocket"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// changeTimeout - deadline of a change to be streamed.
+const changeTimeout = 5 * time.Second
+
+type (
+	// change - a change of a product streamed by products-service.
+	change struct {
+		ID        string    `json:"id"`
+		Type      string    `json:"type"`
+		ProductID uuid.UUID `json:"product_id"`
+		Product   *struct {
+			Name string `json:"name"`
+		} `json:"product"`
+	}
+
+	// event - a Server-Sent Event of a change.
+	event struct {
+		id, name string
+		change   change
+	}
+)
+
+// TestChangeFeedStreamsEvents tests that changes of products are streamed as Server-Sent Events as they are
+// committed, and that a reconnecting client resumes after the last event it received.
+func TestChangeFeedStreamsEvents(t *testing.T) {
+	e := newEnv(t)
+	ctx := context.Background()
+
+	events, closeStream := e.streamEvents(t, "")
+
+	created, err := e.client.CreateProduct(ctx, client.CreateProductRequest{
+		Name: "Keyboard", Vendor: "Acme", Price: decimal.NewFromInt(10),
+	})
+	require.NoError(t, err)
+	_, err = e.client.UpdateProduct(ctx, created.ID, client.UpdateProductRequest{
+		Name: "Keyboard TKL", VendorID: &created.VendorID,
+	})
+	require.NoError(t, err)
+
+	first := nextEvent(t, events)
+	assert.Equal(t, "create_product", first.name)
+	assert.Equal(t, first.id, first.change.ID)
+	assert.Equal(t, created.ID, first.change.ProductID)
+	require.NotNil(t, first.change.Product)
+
+	second := nextEvent(t, events)
+	assert.Equal(t, "update_product", second.name)
+	require.NotNil(t, second.change.Product)
+	assert.Equal(t, "Keyboard TKL", second.change.Product.Name, "products are attached as they are now")
+	closeStream()
+
+	require.NoError(t, e.client.DeleteProduct(ctx, created.ID))
+
+	events, _ = e.streamEvents(t, first.id)
+	assert.Equal(t, second.id, nextEvent(t, events).id, "the stream resumes after the last event id")
+
+	deleted := nextEvent(t, events)
+	assert.Equal(t, "delete_product", deleted.name)
+	assert.Equal(t, created.ID, deleted.change.ProductID)
+	assert.Nil(t, deleted.change.Product)
+
+	resp, err := http.Get(e.productsURL + "/products-api/v1/products/events?last_event_id=invalid")
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+// TestChangeFeedLimitsStreams tests that concurrent streams of a client are limited.
+func TestChangeFeedLimitsStreams(t *testing.T) {
+	e := newEnv(t)
+
+	var closers []func()
+	for range 5 {
+		_, closeStream := e.streamEvents(t, "")
+		closers = append(closers, closeStream)
+	}
+
+	resp, err := http.Get(e.productsURL + "/products-api/v1/products/events")
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+
+	closers[0]()
+	require.Eventually(t, func() bool {
+		resp, err = http.Get(e.productsURL + "/products-api/v1/products/events")
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		return resp.StatusCode == http.StatusOK
+	}, changeTimeout, 20*time.Millisecond, "closed streams are released")
+}
+
+// TestChangeFeedLimitsStreamsOfForwardedClients tests that streams are limited by client addresses forwarded by
+// the trusted proxy, so clients behind it don't share its limit.
+func TestChangeFeedLimitsStreamsOfForwardedClients(t *testing.T) {
+	e := newEnv(t)
+
+	forwarded := func(ip string) http.Header { return http.Header{proxyHeader: {ip}} }
+	var closers []func()
+	for range 5 {
+		_, closeStream := e.streamEventsWithHeader(t, forwarded("203.0.113.1"))
+		closers = append(closers, closeStream)
+	}
+
+	status := func(header http.Header) int {
+		req, err := http.NewRequest(http.MethodGet, e.productsURL+"/products-api/v1/products/events", nil)
+		require.NoError(t, err)
+		req.Header = header
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+
+		return resp.StatusCode
+	}
+
+	assert.Equal(t, http.StatusTooManyRequests, status(forwarded("203.0.113.1")))
+	assert.Equal(t, http.StatusOK, status(forwarded("203.0.113.2")), "clients behind the proxy have own limits")
+
+	closers[0]()
+	require.Eventually(t, func() bool {
+		return status(forwarded("203.0.113.1")) == http.StatusOK
+	}, changeTimeout, 20*time.Millisecond, "the closed stream of the forwarded client is released")
+}
+
+// TestChangeFeedStreamsMessages tests that changes of products are streamed as WebSocket messages.
+func TestChangeFeedStreamsMessages(t *testing.T) {
+	e := newEnv(t)
+	ctx := context.Background()
+
+	url := "ws" + strings.TrimPrefix(e.productsURL, "http") + "/products-api/v1/products/events"
+	conn, resp, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	defer func() { _ = conn.Close() }()
+
+	created, err := e.client.CreateProduct(ctx, client.CreateProductRequest{
+		Name: "Mouse", Vendor: "Acme", Price: decimal.NewFromInt(5),
+	})
+	require.NoError(t, err)
+	require.NoError(t, e.client.DeleteProduct(ctx, created.ID))
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(changeTimeout)))
+	var got []change
+	for range 2 {
+		var c change
+		require.NoError(t, conn.ReadJSON(&c))
+		got = append(got, c)
+	}
+
+	assert.Equal(t, []string{"create_product", "delete_product"}, []string{got[0].Type, got[1].Type})
+	assert.Equal(t, created.ID, got[1].ProductID)
+
+	conn, resp, err = websocket.DefaultDialer.Dial(url+"?last_event_id="+got[0].ID, nil)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	defer func() { _ = conn.Close() }()
+
+	var resumed change
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(changeTimeout)))
+	require.NoError(t, conn.ReadJSON(&resumed))
+	assert.Equal(t, got[1].ID, resumed.ID)
+}
+
+// streamEvents opens a stream of changes after the last event id, when it is set, and returns its events and
+// a function closing it. The stream is closed when the test ends.
+func (e *env) streamEvents(t *testing.T, lastEventID string) (<-chan event, func()) {
+	t.Helper()
+
+	header := http.Header{}
+	if lastEventID != "" {
+		header.Set("Last-Event-ID", lastEventID)
+	}
+
+	return e.streamEventsWithHeader(t, header)
+}
+
+// streamEventsWithHeader opens a stream of changes requested with the header, see streamEvents.
+func (e *env) streamEventsWithHeader(t *testing.T, header http.Header) (<-chan event, func()) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, e.productsURL+"/products-api/v1/products/events", nil)
+	require.NoError(t, err)
+	req.Header = header
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	events := make(chan event, 10)
+	go func() {
+		defer func() { _ = resp.Body.Close() }()
+		defer close(events)
+
+		var ev event
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if line == "" {
+				// a blank line ends an event, blocks of heartbeats and retries have no ids
+				if ev.id != "" {
+					events <- ev
+				}
+				ev = event{}
+				continue
+			}
+
+			field, value, _ := strings.Cut(line, ": ")
+			switch field {
+			case "id":
+				ev.id = value
+			case "event":
+				ev.name = value
+			case "data":
+				_ = json.Unmarshal([]byte(value), &ev.change)
+			}
+		}
+	}()
+
+	return events, cancel
+}
+
+// nextEvent waits for the next event of a stream.
+func nextEvent(t *testing.T, events <-chan event) event {
+	t.Helper()
+
+	select {
+	case ev, ok := <-events:
+		require.True(t, ok, "the stream is closed")
+		return ev
+	case <-time.After(changeTimeout):
+		require.FailNow(t, "no event is streamed in "+changeTimeout.String())
+		return event{}
+	}
+}
@@ -0,0 +1,5 @@
+// Package e2e contains end-to-end tests of the services, which run both applications in-process connected
+// by a fake SQS queue, so they need neither Docker nor network access:
+//
+//	cd e2e && go test ./...
+package e2e
@@ -0,0 +1,94 @@
+package e2e
+
+import (
+	"testing"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/e2e/fakesqs"
+	notificationsapptest "github.com/at-kh/guru-apps-test-services/notifications-service/pkg/apptest"
+	productsapptest "github.com/at-kh/guru-apps-test-services/products-service/pkg/apptest"
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/client"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// Messages of notifications logged by notifications-service.
+const (
+	notificationCreated = "➕ product created"
+	notificationUpdated = "✏️ product updated"
+	notificationDeleted = "➖ product deleted"
+)
+
+// notificationTimeout - deadline of a message to be handled by notifications-service.
+const notificationTimeout = 5 * time.Second
+
+// adminToken - bearer token of admin APIs of both services.
+const adminToken = "e2e-admin-token"
+
+// proxyHeader - header of client addresses forwarded by the tests to products-service as a trusted proxy.
+const proxyHeader = "X-Real-IP"
+
+// env - both services connected by a queue of the fake SQS server.
+type env struct {
+	sqs              *fakesqs.Server
+	queueURL         string
+	dlqURL           string // dead-letter queue of queueURL, it is filled by tests
+	client           *client.Client
+	productsURL      string                 // base URL of products-service
+	notificationsURL string                 // base URL of notifications-service
+	logs             *observer.ObservedLogs // logs of notifications-service
+}
+
+// newEnv starts the fake SQS server and both services, they are stopped when the test finishes.
+func newEnv(t *testing.T) *env {
+	t.Helper()
+
+	e := &env{sqs: fakesqs.New()}
+	t.Cleanup(e.sqs.Close)
+	e.queueURL = e.sqs.CreateQueue("notifications")
+	e.dlqURL = e.sqs.CreateQueue("notifications-dlq")
+
+	products, err := productsapptest.Start(productsapptest.Options{
+		QueueURL:       e.queueURL,
+		AdminToken:     adminToken,
+		ProxyHeader:    proxyHeader,
+		TrustedProxies: []string{"127.0.0.1"}, // the tests are the proxy
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, products.Close()) })
+
+	core, logs := observer.New(zap.InfoLevel)
+	notifications, err := notificationsapptest.Start(notificationsapptest.Options{
+		QueueURL:   e.queueURL,
+		DLQURL:     e.dlqURL,
+		AdminToken: adminToken,
+		Logger:     zap.New(core),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, notifications.Close()) })
+
+	e.logs = logs
+	e.productsURL = products.URL
+	e.notificationsURL = notifications.URL
+	e.client = client.New(products.URL, client.WithRetries(0, 0, 0), client.WithAdminToken(adminToken))
+
+	return e
+}
+
+// waitNotification waits until notifications-service logs a notification about a product.
+func (e *env) waitNotification(t *testing.T, msg string, id uuid.UUID) {
+	t.Helper()
+
+	e.waitNotifications(t, msg, id, 1)
+}
+
+// waitNotifications waits until notifications-service logs n notifications about a product.
+func (e *env) waitNotifications(t *testing.T, msg string, id uuid.UUID, n int) {
+	t.Helper()
+
+	require.Eventually(t, func() bool {
+		return e.logs.FilterMessage(msg).FilterField(zap.String("id", id.String())).Len() >= n
+	}, notificationTimeout, 10*time.Millisecond, "no %d %q notifications about %s", n, msg, id)
+}
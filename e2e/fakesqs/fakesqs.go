@@ -0,0 +1,478 @@
+// Package fakesqs is an in-process SQS server for tests, it implements the subset of the AWS JSON 1.0 protocol
+// used by the services: GetQueueUrl, GetQueueAttributes, SendMessage, ReceiveMessage with long polling,
+// DeleteMessage, ChangeMessageVisibility and PurgeQueue.
+//
+//	srv := fakesqs.New()
+//	defer srv.Close()
+//
+//	queueURL := srv.CreateQueue("notifications")
+//
+// Received messages are invisible for the visibility timeout of the request, 30 seconds by default, and are
+// received again unless they are deleted.
+package fakesqs
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Defaults of requests.
+const (
+	DefaultVisibilityTimeout = 30 * time.Second
+	MaxWaitTime              = 20 * time.Second
+	MaxNumberOfMessages      = 10
+)
+
+// accountID - account in URLs of queues.
+const accountID = "000000000000"
+
+// Types of errors responded to clients, clients match them by the part after #.
+const (
+	errQueueDoesNotExist = "com.amazonaws.sqs#QueueDoesNotExist"
+	errInvalidAction     = "com.amazonaws.sqs#InvalidAction"
+	errInvalidParameter  = "com.amazonaws.sqs#InvalidParameterValue"
+	errReceiptHandle     = "com.amazonaws.sqs#ReceiptHandleIsInvalid"
+)
+
+type (
+	// Server - SQS server listening on a local port.
+	Server struct {
+		URL string // endpoint of the server, e.g. http://127.0.0.1:12345
+
+		http    *httptest.Server
+		mu      sync.Mutex
+		queues  map[string]*queue // queues by names
+		changed chan struct{}     // closed and replaced when messages are sent
+		now     func() time.Time
+	}
+
+	// queue - messages of a queue in order they are sent.
+	queue struct {
+		messages []*message
+		sent     []string // bodies of all sent messages
+	}
+
+	// message - a message with its visibility.
+	message struct {
+		id             string
+		body           string
+		sentAt         time.Time
+		receiveCount   int
+		receiptHandle  string    // handle of the last receive
+		invisibleUntil time.Time // zero when the message was never received
+	}
+
+	// apiError - error responded in the format of the AWS JSON protocol.
+	apiError struct {
+		Type    string `json:"__type"`
+		Message string `json:"message"`
+	}
+)
+
+// New starts a server on a random local port.
+func New() *Server {
+	s := &Server{
+		queues:  make(map[string]*queue),
+		changed: make(chan struct{}),
+		now:     time.Now,
+	}
+	s.http = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.http.URL
+
+	return s
+}
+
+// Close stops the server, long polls in progress are aborted.
+func (s *Server) Close() {
+	s.http.CloseClientConnections()
+	s.http.Close()
+}
+
+// CreateQueue creates a queue if it doesn't exist and returns its URL.
+func (s *Server) CreateQueue(name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.queues[name]; !ok {
+		s.queues[name] = &queue{}
+	}
+
+	return s.queueURL(name)
+}
+
+// DeleteQueue deletes a queue with its messages, e.g. to fail sends of the services.
+func (s *Server) DeleteQueue(queueURL string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.queues, path.Base(queueURL))
+}
+
+// Sent returns bodies of all messages sent to a queue in order, including deleted ones.
+func (s *Server) Sent(queueURL string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	q, ok := s.queues[path.Base(queueURL)]
+	if !ok {
+		return nil
+	}
+
+	return append([]string(nil), q.sent...)
+}
+
+// Len returns number of messages in a queue which are not deleted, including invisible ones.
+func (s *Server) Len(queueURL string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	q, ok := s.queues[path.Base(queueURL)]
+	if !ok {
+		return 0
+	}
+
+	return len(q.messages)
+}
+
+func (s *Server) queueURL(name string) string { return s.URL + "/" + accountID + "/" + name }
+
+// serveHTTP dispatches requests by their X-Amz-Target header, e.g. AmazonSQS.SendMessage.
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	action, ok := strings.CutPrefix(r.Header.Get("X-Amz-Target"), "AmazonSQS.")
+	if r.Method != http.MethodPost || !ok {
+		writeError(w, errInvalidAction, "only the AWS JSON protocol is supported")
+		return
+	}
+
+	switch action {
+	case "GetQueueUrl":
+		s.getQueueURL(w, r)
+	case "GetQueueAttributes":
+		s.getQueueAttributes(w, r)
+	case "SendMessage":
+		s.sendMessage(w, r)
+	case "ReceiveMessage":
+		s.receiveMessage(w, r)
+	case "DeleteMessage":
+		s.deleteMessage(w, r)
+	case "ChangeMessageVisibility":
+		s.changeMessageVisibility(w, r)
+	case "PurgeQueue":
+		s.purgeQueue(w, r)
+	default:
+		writeError(w, errInvalidAction, "action "+action+" is not supported")
+	}
+}
+
+func (s *Server) getQueueURL(w http.ResponseWriter, r *http.Request) {
+	var in struct {
+		QueueName string
+	}
+	if !readInput(w, r, &in) {
+		return
+	}
+
+	s.mu.Lock()
+	_, ok := s.queues[in.QueueName]
+	s.mu.Unlock()
+
+	if !ok {
+		writeError(w, errQueueDoesNotExist, "queue "+in.QueueName+" does not exist")
+		return
+	}
+
+	writeOutput(w, map[string]string{"QueueUrl": s.queueURL(in.QueueName)})
+}
+
+func (s *Server) sendMessage(w http.ResponseWriter, r *http.Request) {
+	var in struct {
+		QueueURL    string `json:"QueueUrl"`
+		MessageBody string
+	}
+	if !readInput(w, r, &in) {
+		return
+	}
+
+	if in.MessageBody == "" {
+		writeError(w, errInvalidParameter, "message body must not be empty")
+		return
+	}
+
+	s.mu.Lock()
+	q, ok := s.queues[path.Base(in.QueueURL)]
+	if ok {
+		m := &message{id: uuid.NewString(), body: in.MessageBody, sentAt: s.now()}
+		q.messages = append(q.messages, m)
+		q.sent = append(q.sent, in.MessageBody)
+		s.notify()
+		s.mu.Unlock()
+
+		writeOutput(w, map[string]string{"MessageId": m.id, "MD5OfMessageBody": md5Hex(in.MessageBody)})
+		return
+	}
+	s.mu.Unlock()
+
+	writeError(w, errQueueDoesNotExist, "queue "+in.QueueURL+" does not exist")
+}
+
+// receiveMessage returns visible messages, it waits up to WaitTimeSeconds until any message is visible.
+func (s *Server) receiveMessage(w http.ResponseWriter, r *http.Request) {
+	var in struct {
+		QueueURL            string `json:"QueueUrl"`
+		MaxNumberOfMessages int
+		WaitTimeSeconds     int
+		VisibilityTimeout   *int
+	}
+	if !readInput(w, r, &in) {
+		return
+	}
+
+	if in.MaxNumberOfMessages <= 0 {
+		in.MaxNumberOfMessages = 1
+	}
+	visibility := DefaultVisibilityTimeout
+	if in.VisibilityTimeout != nil {
+		visibility = time.Duration(*in.VisibilityTimeout) * time.Second
+	}
+
+	wait := time.NewTimer(min(time.Duration(in.WaitTimeSeconds)*time.Second, MaxWaitTime))
+	defer wait.Stop()
+
+	for {
+		s.mu.Lock()
+		q, ok := s.queues[path.Base(in.QueueURL)]
+		if !ok {
+			s.mu.Unlock()
+			writeError(w, errQueueDoesNotExist, "queue "+in.QueueURL+" does not exist")
+			return
+		}
+
+		received, next := s.receive(q, min(in.MaxNumberOfMessages, MaxNumberOfMessages), visibility)
+		changed := s.changed
+		s.mu.Unlock()
+
+		if len(received) > 0 {
+			writeOutput(w, map[string]any{"Messages": received})
+			return
+		}
+
+		// invisible messages become visible without sends, so waits are bounded by the next of them
+		visible := time.NewTimer(MaxWaitTime)
+		if !next.IsZero() {
+			visible.Reset(next.Sub(s.now()))
+		}
+
+		select {
+		case <-changed:
+		case <-visible.C:
+		case <-wait.C:
+			writeOutput(w, map[string]any{"Messages": []any{}})
+			return
+		case <-r.Context().Done():
+			return
+		}
+		visible.Stop()
+	}
+}
+
+// receive marks up to limit visible messages invisible and returns them, it returns the earliest time
+// an invisible message becomes visible when no messages are received. Must be called with the lock held.
+func (s *Server) receive(q *queue, limit int, visibility time.Duration) ([]map[string]any, time.Time) {
+	var (
+		now      = s.now()
+		received []map[string]any
+		next     time.Time
+	)
+
+	for _, m := range q.messages {
+		if now.Before(m.invisibleUntil) {
+			if next.IsZero() || m.invisibleUntil.Before(next) {
+				next = m.invisibleUntil
+			}
+			continue
+		}
+
+		if len(received) == limit {
+			break
+		}
+
+		m.receiptHandle = uuid.NewString()
+		m.invisibleUntil = now.Add(visibility)
+		m.receiveCount++
+		received = append(received, map[string]any{
+			"MessageId":     m.id,
+			"ReceiptHandle": m.receiptHandle,
+			"MD5OfBody":     md5Hex(m.body),
+			"Body":          m.body,
+			"Attributes": map[string]string{
+				"SentTimestamp":           strconv.FormatInt(m.sentAt.UnixMilli(), 10),
+				"ApproximateReceiveCount": strconv.Itoa(m.receiveCount),
+			},
+		})
+	}
+
+	return received, next
+}
+
+func (s *Server) deleteMessage(w http.ResponseWriter, r *http.Request) {
+	var in struct {
+		QueueURL      string `json:"QueueUrl"`
+		ReceiptHandle string
+	}
+	if !readInput(w, r, &in) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	q, ok := s.queues[path.Base(in.QueueURL)]
+	if !ok {
+		writeError(w, errQueueDoesNotExist, "queue "+in.QueueURL+" does not exist")
+		return
+	}
+
+	for i, m := range q.messages {
+		if m.receiptHandle != "" && m.receiptHandle == in.ReceiptHandle {
+			q.messages = append(q.messages[:i], q.messages[i+1:]...)
+			writeOutput(w, struct{}{})
+			return
+		}
+	}
+
+	writeError(w, errReceiptHandle, "receipt handle is invalid")
+}
+
+// changeMessageVisibility makes a received message visible after the timeout, 0 makes it visible at once.
+func (s *Server) changeMessageVisibility(w http.ResponseWriter, r *http.Request) {
+	var in struct {
+		QueueURL          string `json:"QueueUrl"`
+		ReceiptHandle     string
+		VisibilityTimeout int
+	}
+	if !readInput(w, r, &in) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	q, ok := s.queues[path.Base(in.QueueURL)]
+	if !ok {
+		writeError(w, errQueueDoesNotExist, "queue "+in.QueueURL+" does not exist")
+		return
+	}
+
+	for _, m := range q.messages {
+		if m.receiptHandle != "" && m.receiptHandle == in.ReceiptHandle {
+			m.invisibleUntil = s.now().Add(time.Duration(in.VisibilityTimeout) * time.Second)
+			s.notify()
+			writeOutput(w, struct{}{})
+			return
+		}
+	}
+
+	writeError(w, errReceiptHandle, "receipt handle is invalid")
+}
+
+// getQueueAttributes returns numbers of visible and invisible messages, messages are never delayed.
+func (s *Server) getQueueAttributes(w http.ResponseWriter, r *http.Request) {
+	var in struct {
+		QueueURL string `json:"QueueUrl"`
+	}
+	if !readInput(w, r, &in) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	q, ok := s.queues[path.Base(in.QueueURL)]
+	if !ok {
+		writeError(w, errQueueDoesNotExist, "queue "+in.QueueURL+" does not exist")
+		return
+	}
+
+	var visible, invisible int
+	now := s.now()
+	for _, m := range q.messages {
+		if now.Before(m.invisibleUntil) {
+			invisible++
+		} else {
+			visible++
+		}
+	}
+
+	writeOutput(w, map[string]any{"Attributes": map[string]string{
+		"ApproximateNumberOfMessages":           strconv.Itoa(visible),
+		"ApproximateNumberOfMessagesNotVisible": strconv.Itoa(invisible),
+		"ApproximateNumberOfMessagesDelayed":    "0",
+	}})
+}
+
+// purgeQueue deletes all messages of a queue, purges are not limited in time unlike in SQS.
+func (s *Server) purgeQueue(w http.ResponseWriter, r *http.Request) {
+	var in struct {
+		QueueURL string `json:"QueueUrl"`
+	}
+	if !readInput(w, r, &in) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	q, ok := s.queues[path.Base(in.QueueURL)]
+	if !ok {
+		writeError(w, errQueueDoesNotExist, "queue "+in.QueueURL+" does not exist")
+		return
+	}
+	q.messages = nil
+
+	writeOutput(w, struct{}{})
+}
+
+// notify wakes up long polls. Must be called with the lock held.
+func (s *Server) notify() {
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+// readInput decodes JSON input of an action, it responds an error and returns false when it is invalid.
+func readInput(w http.ResponseWriter, r *http.Request, in any) bool {
+	if err := json.NewDecoder(r.Body).Decode(in); err != nil {
+		writeError(w, errInvalidParameter, "invalid input: "+err.Error())
+		return false
+	}
+
+	return true
+}
+
+// writeOutput writes JSON output of an action.
+func writeOutput(w http.ResponseWriter, out any) {
+	w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+	_ = json.NewEncoder(w).Encode(out)
+}
+
+// writeError writes an error of an action, all errors are errors of clients.
+func writeError(w http.ResponseWriter, errType, msg string) {
+	w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(apiError{Type: errType, Message: msg})
+}
+
+// md5Hex returns hex encoded MD5 of a body, clients verify bodies by it.
+func md5Hex(body string) string {
+	sum := md5.Sum([]byte(body))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,154 @@
+package fakesqs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newClient returns an SQS client of the server.
+func newClient(s *Server) *sqs.Client {
+	return sqs.New(sqs.Options{
+		Region:       "us-east-1",
+		BaseEndpoint: aws.String(s.URL),
+		Credentials:  credentials.NewStaticCredentialsProvider("test", "test", ""),
+	})
+}
+
+// TestServer tests the round trip of a message by the SDK client, which verifies checksums of bodies.
+func TestServer(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	queueURL := s.CreateQueue("notifications")
+	c := newClient(s)
+	ctx := context.Background()
+
+	out, err := c.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{QueueName: aws.String("notifications")})
+	require.NoError(t, err)
+	assert.Equal(t, queueURL, aws.ToString(out.QueueUrl))
+
+	// a long poll returns once a message is sent
+	received := make(chan *sqs.ReceiveMessageOutput, 1)
+	go func() {
+		out, err := c.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
+			QueueUrl:            &queueURL,
+			MaxNumberOfMessages: 10,
+			WaitTimeSeconds:     5,
+			VisibilityTimeout:   1,
+		})
+		assert.NoError(t, err)
+		received <- out
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	_, err = c.SendMessage(ctx, &sqs.SendMessageInput{QueueUrl: &queueURL, MessageBody: aws.String(`{"a":1}`)})
+	require.NoError(t, err)
+
+	msgs := (<-received).Messages
+	require.Len(t, msgs, 1)
+	assert.Equal(t, `{"a":1}`, aws.ToString(msgs[0].Body))
+
+	// a message which is not deleted is received again after the visibility timeout
+	redelivered := receive(t, c, queueURL, 2)
+	require.Len(t, redelivered, 1)
+	assert.Equal(t, aws.ToString(msgs[0].MessageId), aws.ToString(redelivered[0].MessageId))
+
+	_, err = c.DeleteMessage(ctx, &sqs.DeleteMessageInput{QueueUrl: &queueURL,
+		ReceiptHandle: redelivered[0].ReceiptHandle})
+	require.NoError(t, err)
+	assert.Equal(t, 0, s.Len(queueURL))
+	assert.Equal(t, []string{`{"a":1}`}, s.Sent(queueURL))
+
+	_, err = c.DeleteMessage(ctx, &sqs.DeleteMessageInput{QueueUrl: &queueURL,
+		ReceiptHandle: redelivered[0].ReceiptHandle})
+	var invalidHandle *types.ReceiptHandleIsInvalid
+	require.ErrorAs(t, err, &invalidHandle)
+
+	assert.Empty(t, receive(t, c, queueURL, 1), "empty queue is polled until the wait time")
+}
+
+// TestServerQueueDoesNotExist tests errors of unknown queues.
+func TestServerQueueDoesNotExist(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	queueURL := s.CreateQueue("notifications")
+	s.DeleteQueue(queueURL)
+	c := newClient(s)
+
+	var notExist *types.QueueDoesNotExist
+
+	_, err := c.GetQueueUrl(context.Background(), &sqs.GetQueueUrlInput{QueueName: aws.String("notifications")})
+	require.ErrorAs(t, err, &notExist)
+
+	_, err = c.SendMessage(context.Background(), &sqs.SendMessageInput{QueueUrl: &queueURL,
+		MessageBody: aws.String("{}")})
+	require.ErrorAs(t, err, &notExist)
+}
+
+// TestServerVisibilityAndPurge tests numbers of messages by their visibility, releases of received messages and
+// purges of queues.
+func TestServerVisibilityAndPurge(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	queueURL := s.CreateQueue("notifications")
+	c := newClient(s)
+	ctx := context.Background()
+
+	for _, body := range []string{`{"a":1}`, `{"a":2}`} {
+		_, err := c.SendMessage(ctx, &sqs.SendMessageInput{QueueUrl: &queueURL, MessageBody: aws.String(body)})
+		require.NoError(t, err)
+	}
+
+	out, err := c.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{QueueUrl: &queueURL, MaxNumberOfMessages: 1,
+		MessageSystemAttributeNames: []types.MessageSystemAttributeName{types.MessageSystemAttributeNameAll}})
+	require.NoError(t, err)
+	require.Len(t, out.Messages, 1)
+	assert.Equal(t, "1", out.Messages[0].Attributes["ApproximateReceiveCount"])
+	assert.NotEmpty(t, out.Messages[0].Attributes["SentTimestamp"])
+	assertCounts(t, c, queueURL, "1", "1")
+
+	_, err = c.ChangeMessageVisibility(ctx, &sqs.ChangeMessageVisibilityInput{QueueUrl: &queueURL,
+		ReceiptHandle: out.Messages[0].ReceiptHandle, VisibilityTimeout: 0})
+	require.NoError(t, err)
+	assertCounts(t, c, queueURL, "2", "0")
+
+	_, err = c.PurgeQueue(ctx, &sqs.PurgeQueueInput{QueueUrl: &queueURL})
+	require.NoError(t, err)
+	assertCounts(t, c, queueURL, "0", "0")
+	assert.Len(t, s.Sent(queueURL), 2)
+}
+
+// assertCounts asserts numbers of visible and invisible messages of a queue.
+func assertCounts(t *testing.T, c *sqs.Client, queueURL, visible, invisible string) {
+	t.Helper()
+
+	out, err := c.GetQueueAttributes(context.Background(), &sqs.GetQueueAttributesInput{QueueUrl: &queueURL,
+		AttributeNames: []types.QueueAttributeName{types.QueueAttributeNameAll}})
+	require.NoError(t, err)
+	assert.Equal(t, visible, out.Attributes["ApproximateNumberOfMessages"])
+	assert.Equal(t, invisible, out.Attributes["ApproximateNumberOfMessagesNotVisible"])
+}
+
+// receive receives messages waiting up to waitSeconds.
+func receive(t *testing.T, c *sqs.Client, queueURL string, waitSeconds int32) []types.Message {
+	t.Helper()
+
+	out, err := c.ReceiveMessage(context.Background(), &sqs.ReceiveMessageInput{
+		QueueUrl:            &queueURL,
+		MaxNumberOfMessages: 10,
+		WaitTimeSeconds:     waitSeconds,
+	})
+	require.NoError(t, err)
+
+	return out.Messages
+}
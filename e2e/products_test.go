@@ -0,0 +1,129 @@
+package e2e
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/client"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestProductLifecycleNotifies tests that creating, updating and deleting a product notifies about it and
+// handled messages are deleted from the queue.
+func TestProductLifecycleNotifies(t *testing.T) {
+	e := newEnv(t)
+	ctx := context.Background()
+
+	created, err := e.client.CreateProduct(ctx, client.CreateProductRequest{
+		Name:        "Keyboard",
+		Vendor:      "Acme",
+		Description: "Mechanical keyboard",
+		Price:       decimal.RequireFromString("49.90"),
+		Tags:        []string{"Peripherals"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Acme", created.Vendor)
+	assert.Equal(t, []string{"peripherals"}, created.Tags)
+	e.waitNotification(t, notificationCreated, created.ID)
+
+	got, err := e.client.GetProduct(ctx, created.ID, "")
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, got.ID)
+	assert.True(t, decimal.RequireFromString("49.90").Equal(got.Price))
+
+	updated, err := e.client.UpdateProduct(ctx, created.ID, client.UpdateProductRequest{
+		Name:        "Keyboard TKL",
+		VendorID:    &created.VendorID,
+		Description: "Tenkeyless keyboard",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Keyboard TKL", updated.Name)
+	e.waitNotification(t, notificationUpdated, created.ID)
+
+	require.NoError(t, e.client.DeleteProduct(ctx, created.ID))
+	e.waitNotification(t, notificationDeleted, created.ID)
+
+	_, err = e.client.GetProduct(ctx, created.ID, "")
+	require.ErrorAs(t, err, &errs.NotFound{})
+
+	assert.Len(t, e.sqs.Sent(e.queueURL), 3)
+	require.Eventually(t, func() bool { return e.sqs.Len(e.queueURL) == 0 }, notificationTimeout,
+		10*time.Millisecond, "handled messages are deleted")
+}
+
+// TestCreateProductConflictIsNotPublished tests that products which are not created are not announced.
+func TestCreateProductConflictIsNotPublished(t *testing.T) {
+	e := newEnv(t)
+	ctx := context.Background()
+
+	req := client.CreateProductRequest{Name: "Mouse", Vendor: "Acme", Price: decimal.NewFromInt(10)}
+
+	created, err := e.client.CreateProduct(ctx, req)
+	require.NoError(t, err)
+
+	req.Vendor = "  ACME "
+	_, err = e.client.CreateProduct(ctx, req)
+	require.ErrorAs(t, err, &errs.Conflict{}, "vendors are matched by normalized names")
+
+	e.waitNotification(t, notificationCreated, created.ID)
+	assert.Len(t, e.sqs.Sent(e.queueURL), 1)
+}
+
+// TestCreateProductRolledBackWhenNotPublished tests that a product is not created when its event can't be
+// published, so consumers never miss products.
+func TestCreateProductRolledBackWhenNotPublished(t *testing.T) {
+	e := newEnv(t)
+	ctx := context.Background()
+
+	e.sqs.DeleteQueue(e.queueURL)
+
+	_, err := e.client.CreateProduct(ctx, client.CreateProductRequest{
+		Name:   "Monitor",
+		Vendor: "Acme",
+		Price:  decimal.NewFromInt(200),
+	})
+	require.ErrorAs(t, err, &errs.Internal{})
+
+	list, err := e.client.GetProducts(ctx, client.ProductFilter{})
+	require.NoError(t, err)
+	assert.Zero(t, list.Pagination.Total)
+	assert.Empty(t, list.Products)
+}
+
+// TestRepublishEventsNotifiesAgain tests that events of stored products are published again by the admin API,
+// which requires its token.
+func TestRepublishEventsNotifiesAgain(t *testing.T) {
+	e := newEnv(t)
+	ctx := context.Background()
+
+	created, err := e.client.CreateProduct(ctx, client.CreateProductRequest{
+		Name:   "Headset",
+		Vendor: "Acme",
+		Price:  decimal.NewFromInt(80),
+	})
+	require.NoError(t, err)
+	e.waitNotification(t, notificationCreated, created.ID)
+
+	result, err := e.client.RepublishEvents(ctx, client.RepublishRequest{ProductID: &created.ID})
+	require.NoError(t, err)
+	assert.Equal(t, client.RepublishResult{Products: 1, Created: 1}, result)
+	e.waitNotifications(t, notificationCreated, created.ID, 2)
+
+	from := created.CreatedAt.Add(-time.Second)
+	result, err = e.client.RepublishEvents(ctx, client.RepublishRequest{From: &from})
+	require.NoError(t, err)
+	assert.Equal(t, client.RepublishResult{Products: 1, Created: 1}, result)
+	e.waitNotifications(t, notificationCreated, created.ID, 3)
+
+	_, err = e.client.RepublishEvents(ctx, client.RepublishRequest{})
+	require.ErrorAs(t, err, &errs.FieldsValidation{})
+
+	_, err = client.New(e.productsURL, client.WithRetries(0, 0, 0)).
+		RepublishEvents(ctx, client.RepublishRequest{ProductID: &created.ID})
+	require.ErrorAs(t, err, &errs.Unauthorized{})
+	assert.Len(t, e.sqs.Sent(e.queueURL), 3)
+}
@@ -0,0 +1,154 @@
+package e2e
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Responses of the admin API of queues of notifications-service.
+type (
+	queueStats struct {
+		Name     string `json:"name"`
+		Visible  int64  `json:"visible"`
+		InFlight int64  `json:"in_flight"`
+	}
+
+	queueMessage struct {
+		ID           string `json:"id"`
+		Body         string `json:"body"`
+		ReceiveCount int64  `json:"receive_count"`
+	}
+
+	redriveResult struct {
+		Moved    []string `json:"moved"`
+		NotFound []string `json:"not_found"`
+	}
+)
+
+// TestDeadLetterQueueRedrive tests that messages of the dead-letter queue are inspected, moved back to the main
+// queue and handled, and that queues are purged by the admin API of notifications-service.
+func TestDeadLetterQueueRedrive(t *testing.T) {
+	e := newEnv(t)
+
+	ids := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+	messageIDs := make([]string, len(ids))
+	for i, id := range ids {
+		messageIDs[i] = e.sendToDLQ(t, `{"event_type":"create_product","product_id":"`+id.String()+`"}`)
+	}
+
+	status, _ := e.notificationsAdmin(t, http.MethodGet, "/queues", "", nil, nil)
+	require.Equal(t, http.StatusUnauthorized, status, "the admin API requires its token")
+
+	var stats struct {
+		Queues []queueStats `json:"queues"`
+	}
+	status, _ = e.notificationsAdmin(t, http.MethodGet, "/queues", adminToken, nil, &stats)
+	require.Equal(t, http.StatusOK, status)
+	assert.Equal(t, []queueStats{{Name: "main"}, {Name: "dlq", Visible: 3}}, stats.Queues)
+
+	var peeked struct {
+		Messages []queueMessage `json:"messages"`
+	}
+	status, _ = e.notificationsAdmin(t, http.MethodGet, "/queues/dlq/messages?limit=2", adminToken, nil, &peeked)
+	require.Equal(t, http.StatusOK, status)
+	require.Len(t, peeked.Messages, 2)
+	assert.Equal(t, messageIDs[0], peeked.Messages[0].ID)
+	assert.Contains(t, peeked.Messages[0].Body, ids[0].String())
+	assert.Equal(t, int64(1), peeked.Messages[0].ReceiveCount)
+
+	status, _ = e.notificationsAdmin(t, http.MethodGet, "/queues/dlq/messages", adminToken, nil, &peeked)
+	require.Equal(t, http.StatusOK, status)
+	assert.Len(t, peeked.Messages, 3, "peeked messages are visible again")
+
+	var redriven redriveResult
+	status, _ = e.notificationsAdmin(t, http.MethodPost, "/queues/dlq/redrive", adminToken,
+		map[string]any{"message_ids": []string{messageIDs[1], "missing"}}, &redriven)
+	require.Equal(t, http.StatusOK, status)
+	assert.Equal(t, redriveResult{Moved: []string{messageIDs[1]}, NotFound: []string{"missing"}}, redriven)
+	e.waitNotification(t, notificationCreated, ids[1])
+	assert.Equal(t, 2, e.sqs.Len(e.dlqURL))
+
+	status, _ = e.notificationsAdmin(t, http.MethodPost, "/queues/dlq/redrive", adminToken,
+		map[string]any{}, &redriven)
+	require.Equal(t, http.StatusBadRequest, status, "either message ids or all must be set")
+
+	status, _ = e.notificationsAdmin(t, http.MethodPost, "/queues/dlq/redrive", adminToken,
+		map[string]any{"all": true}, &redriven)
+	require.Equal(t, http.StatusOK, status)
+	assert.ElementsMatch(t, []string{messageIDs[0], messageIDs[2]}, redriven.Moved)
+	e.waitNotification(t, notificationCreated, ids[0])
+	e.waitNotification(t, notificationCreated, ids[2])
+	assert.Zero(t, e.sqs.Len(e.dlqURL))
+
+	e.sendToDLQ(t, `{"event_type":"create_product","product_id":"`+uuid.NewString()+`"}`)
+	status, _ = e.notificationsAdmin(t, http.MethodDelete, "/queues/dlq/messages", adminToken, nil, nil)
+	require.Equal(t, http.StatusNoContent, status)
+	assert.Zero(t, e.sqs.Len(e.dlqURL))
+
+	status, _ = e.notificationsAdmin(t, http.MethodDelete, "/queues/other/messages", adminToken, nil, nil)
+	require.Equal(t, http.StatusNotFound, status)
+}
+
+// sendToDLQ sends a message to the dead-letter queue as SQS does with messages failed to be handled, it returns
+// the id of the message.
+func (e *env) sendToDLQ(t *testing.T, body string) string {
+	t.Helper()
+
+	c := sqs.New(sqs.Options{
+		Region:       "us-east-1",
+		BaseEndpoint: aws.String(e.sqs.URL),
+		Credentials:  credentials.NewStaticCredentialsProvider("test", "test", ""),
+	})
+
+	out, err := c.SendMessage(context.Background(), &sqs.SendMessageInput{
+		QueueUrl:    aws.String(e.dlqURL),
+		MessageBody: aws.String(body),
+	})
+	require.NoError(t, err)
+
+	return aws.ToString(out.MessageId)
+}
+
+// notificationsAdmin sends a request to the admin API of notifications-service with the token, when it is set,
+// and decodes the JSON response into out. It returns the status and the body of the response.
+func (e *env) notificationsAdmin(t *testing.T, method, path, token string, payload, out any) (int, string) {
+	t.Helper()
+
+	var body io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		require.NoError(t, err)
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, e.notificationsURL+"/notifications-api/v1/admin"+path, body)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	if out != nil && resp.StatusCode == http.StatusOK {
+		require.NoError(t, json.Unmarshal(data, out), string(data))
+	}
+
+	return resp.StatusCode, string(data)
+}
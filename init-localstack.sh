@@ -1,3 +1,8 @@
 #!/bin/bash
 
-awslocal sqs create-queue --queue-name test-queue
+awslocal sqs create-queue --queue-name test-queue-dlq
+# events failed to be handled 5 times are moved to the dead-letter queue
+awslocal sqs create-queue --queue-name test-queue --attributes '{
+  "RedrivePolicy": "{\"deadLetterTargetArn\":\"arn:aws:sqs:us-east-1:000000000000:test-queue-dlq\",\"maxReceiveCount\":\"5\"}"
+}'
+awslocal s3 mb s3://product-images
@@ -7,11 +7,11 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
-	"time"
 
-	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/api/domain/health"
 	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/app"
 	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/config"
+	platformconfig "github.com/at-kh/guru-apps-test-services/platform/config"
+	"github.com/at-kh/guru-apps-test-services/platform/health"
 	_ "go.uber.org/automaxprocs"
 )
 
@@ -28,12 +28,13 @@ func main() {
 	ctx, cancel := registerGracefulShutdown()
 	defer cancel()
 
-	cfgPath := flag.String("c", config.DefaultPath, "configuration file")
+	var cfgPaths platformconfig.Paths
+	flag.Var(&cfgPaths, "c", "configuration file, repeat to overlay files (default "+config.DefaultPath+")")
 	flag.Parse()
 
-	app.New(
+	err := app.New(
 		app.Meta{
-			ConfigPath: *cfgPath,
+			ConfigPaths: cfgPaths,
 			Info: health.Info{
 				Name:         name,
 				BuildCommit:  commit,
@@ -42,9 +43,13 @@ func main() {
 			},
 		},
 	).Run(ctx)
+	if err != nil {
+		log.Fatalf("run: %v", err)
+	}
 }
 
-// registerGracefulShutdown returns a context that is canceled on signals.
+// registerGracefulShutdown returns a context that is canceled on signals, components of the application
+// are stopped by their own deadlines, a second signal forces exit.
 func registerGracefulShutdown() (context.Context, context.CancelFunc) {
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -52,23 +57,14 @@ func registerGracefulShutdown() (context.Context, context.CancelFunc) {
 	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
 
 	go func() {
-		defer signal.Stop(signals)
-
 		sig := <-signals
 		log.Printf("[signal] received=%s action=graceful-shutdown", sig)
 
-		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 10*time.Second)
-
 		cancel()
 
-		select {
-		case sig2 := <-signals:
-			log.Printf("[signal] second received: forcing exit (%s)", sig2)
-			os.Exit(1)
-		case <-shutdownCtx.Done():
-			shutdownCancel()
-			return
-		}
+		sig = <-signals
+		log.Printf("[signal] second received: forcing exit (%s)", sig)
+		os.Exit(1)
 	}()
 
 	return ctx, cancel
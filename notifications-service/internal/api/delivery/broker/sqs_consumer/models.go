@@ -1,11 +1,24 @@
 package sqs_consumer
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 // message - defines message struct for SQS consumer, supported event types:
 //   - create_product
+//   - update_product
 //   - delete_product
+//   - stock_low
+//   - out_of_stock
 type message struct {
-	EventType string    `json:"event_type"`
-	ProductID uuid.UUID `json:"product_id"`
+	EventType  string    `json:"event_type"`
+	ProductID  uuid.UUID `json:"product_id"`
+	OccurredAt time.Time `json:"occurred_at"` // time the event was published at
+
+	// stock alerts only
+	Warehouse string `json:"warehouse,omitempty"`
+	Available int64  `json:"available,omitempty"`
+	Threshold int64  `json:"threshold,omitempty"`
 }
@@ -5,7 +5,7 @@ import (
 
 	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/api/delivery"
 	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/api/services"
-	"github.com/at-kh/guru-apps-test-services/notifications-service/pkg/errs"
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
 	"github.com/goccy/go-json"
 	"go.uber.org/zap"
 )
@@ -39,6 +39,20 @@ func (h Handler) CreateNotification(_ context.Context, body []byte) error {
 	return nil
 }
 
+// UpdateNotification - log "update product" by SQS message.
+func (h Handler) UpdateNotification(_ context.Context, body []byte) error {
+	var msg message
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return errs.Internal{Cause: err.Error()}
+	}
+
+	h.logger.Debug("processing updated product", zap.String("product_id", msg.ProductID.String()))
+
+	h.service.Update(msg.ProductID)
+
+	return nil
+}
+
 // DeleteNotification - log "delete product" by SQS message.
 func (h Handler) DeleteNotification(_ context.Context, body []byte) error {
 	var msg message
@@ -52,3 +66,31 @@ func (h Handler) DeleteNotification(_ context.Context, body []byte) error {
 
 	return nil
 }
+
+// StockLowNotification - alert "stock is low" by SQS message.
+func (h Handler) StockLowNotification(_ context.Context, body []byte) error {
+	var msg message
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return errs.Internal{Cause: err.Error()}
+	}
+
+	h.logger.Debug("processing low stock", zap.String("product_id", msg.ProductID.String()))
+
+	h.service.StockLow(msg.ProductID, msg.Warehouse, msg.Available, msg.Threshold)
+
+	return nil
+}
+
+// OutOfStockNotification - alert "out of stock" by SQS message.
+func (h Handler) OutOfStockNotification(_ context.Context, body []byte) error {
+	var msg message
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return errs.Internal{Cause: err.Error()}
+	}
+
+	h.logger.Debug("processing out of stock", zap.String("product_id", msg.ProductID.String()))
+
+	h.service.OutOfStock(msg.ProductID, msg.Warehouse)
+
+	return nil
+}
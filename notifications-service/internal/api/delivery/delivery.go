@@ -12,6 +12,28 @@ type (
 	HealthHTTPHandler interface {
 		// Health - handler for getting meta information endpoint.
 		Health(ctx *fiber.Ctx) error
+		// Ready - handler for readiness endpoint.
+		Ready(ctx *fiber.Ctx) error
+	}
+
+	// QueuesHTTPHandler - describes an interface for administration of queues of events over HTTP.
+	QueuesHTTPHandler interface {
+		// GetStats - handler for getting numbers of messages of queues endpoint.
+		GetStats(ctx *fiber.Ctx) error
+		// PeekDLQ - handler for peeking at messages of the dead-letter queue endpoint.
+		PeekDLQ(ctx *fiber.Ctx) error
+		// RedriveDLQ - handler for moving messages of the dead-letter queue back to the main queue endpoint.
+		RedriveDLQ(ctx *fiber.Ctx) error
+		// Purge - handler for purging a queue endpoint.
+		Purge(ctx *fiber.Ctx) error
+	}
+
+	// ConfigsHTTPHandler - describes an interface for work with the configuration over HTTP.
+	ConfigsHTTPHandler interface {
+		// Get - handler for getting the effective configuration endpoint.
+		Get(ctx *fiber.Ctx) error
+		// Reload - handler for reloading the configuration endpoint.
+		Reload(ctx *fiber.Ctx) error
 	}
 )
 
@@ -20,6 +42,9 @@ type (
 	// SQSConsumerHandler - describes an interface for work with SQS consumer over HTTP.
 	SQSConsumerHandler interface {
 		CreateNotification(ctx context.Context, body []byte) error
+		UpdateNotification(ctx context.Context, body []byte) error
 		DeleteNotification(ctx context.Context, body []byte) error
+		StockLowNotification(ctx context.Context, body []byte) error
+		OutOfStockNotification(ctx context.Context, body []byte) error
 	}
 )
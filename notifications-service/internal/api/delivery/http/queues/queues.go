@@ -0,0 +1,80 @@
+package queues
+
+import (
+	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/api/delivery"
+	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/api/domain/queues"
+	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/api/services"
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/platform/responder"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+var _ delivery.QueuesHTTPHandler = &Handler{}
+
+type (
+	// Handler defines a Handler for HTTP requests for queues of events.
+	Handler struct {
+		responder.Responder
+
+		service services.Queues
+		log     *zap.Logger
+	}
+)
+
+// NewHandler - create new handler.
+func NewHandler(responder responder.Responder, service services.Queues, log *zap.Logger) *Handler {
+	return &Handler{
+		Responder: responder,
+		service:   service,
+		log:       log.With(zap.String("http_handler", "queues")),
+	}
+}
+
+// GetStats - get approximate numbers of messages of the main queue and of the dead-letter queue:
+//   - GET /admin/queues
+func (h Handler) GetStats(ctx *fiber.Ctx) error {
+	stats, err := h.service.Stats(ctx.Context())
+	if err != nil {
+		return err
+	}
+
+	return h.Respond(ctx, fiber.StatusOK, fromDomainStats(stats))
+}
+
+// PeekDLQ - get messages of the dead-letter queue without deleting them:
+//   - GET /admin/queues/dlq/messages?limit=10
+func (h Handler) PeekDLQ(ctx *fiber.Ctx) error {
+	msgs, err := h.service.PeekDLQ(ctx.Context(), ctx.QueryInt("limit", queues.DefaultPeekLimit))
+	if err != nil {
+		return err
+	}
+
+	return h.Respond(ctx, fiber.StatusOK, fromDomainMessages(msgs))
+}
+
+// RedriveDLQ - move selected or all messages of the dead-letter queue back to the main queue:
+//   - POST /admin/queues/dlq/redrive
+func (h Handler) RedriveDLQ(ctx *fiber.Ctx) error {
+	var req redriveRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return errs.BadRequest{Cause: "invalid JSON body"}
+	}
+
+	result, err := h.service.RedriveDLQ(ctx.Context(), req.toDomain())
+	if err != nil {
+		return err
+	}
+
+	return h.Respond(ctx, fiber.StatusOK, fromDomainRedrive(result))
+}
+
+// Purge - delete all messages of the main queue or of the dead-letter queue:
+//   - DELETE /admin/queues/:queue/messages
+func (h Handler) Purge(ctx *fiber.Ctx) error {
+	if err := h.service.Purge(ctx.Context(), ctx.Params("queue")); err != nil {
+		return err
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
@@ -0,0 +1,19 @@
+package queues
+
+import "github.com/at-kh/guru-apps-test-services/notifications-service/internal/api/domain/queues"
+
+type (
+	// redriveRequest - request model for moving messages of the dead-letter queue back to the main queue.
+	redriveRequest struct {
+		MessageIDs []string `json:"message_ids"`
+		All        bool     `json:"all"`
+	}
+)
+
+// toDomain converts request model to domain model.
+func (r redriveRequest) toDomain() queues.Redrive {
+	return queues.Redrive{
+		MessageIDs: r.MessageIDs,
+		All:        r.All,
+	}
+}
@@ -0,0 +1,81 @@
+package queues
+
+import (
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/api/domain/queues"
+)
+
+type (
+	// statsResponse is a response for approximate numbers of messages of a queue.
+	statsResponse struct {
+		Name     string `json:"name"`
+		URL      string `json:"url"`
+		Visible  int64  `json:"visible"`
+		InFlight int64  `json:"in_flight"`
+		Delayed  int64  `json:"delayed"`
+	}
+
+	// statsListResponse is a response for numbers of messages of queues.
+	statsListResponse struct {
+		Queues []statsResponse `json:"queues"`
+	}
+
+	// messageResponse is a response for a message of a queue.
+	messageResponse struct {
+		ID           string    `json:"id"`
+		Body         string    `json:"body"`
+		SentAt       time.Time `json:"sent_at"`
+		ReceiveCount int64     `json:"receive_count"`
+	}
+
+	// messageListResponse is a response for a list of messages of a queue.
+	messageListResponse struct {
+		Messages []messageResponse `json:"messages"`
+	}
+
+	// redriveResponse is a response for messages moved from the dead-letter queue back to the main queue.
+	redriveResponse struct {
+		Moved    []string `json:"moved"`
+		NotFound []string `json:"not_found"`
+	}
+)
+
+// fromDomainStats converts domain model to response model.
+func fromDomainStats(list []queues.Stats) statsListResponse {
+	result := make([]statsResponse, 0, len(list))
+	for _, s := range list {
+		result = append(result, statsResponse{
+			Name:     s.Name,
+			URL:      s.URL,
+			Visible:  s.Visible,
+			InFlight: s.InFlight,
+			Delayed:  s.Delayed,
+		})
+	}
+
+	return statsListResponse{Queues: result}
+}
+
+// fromDomainMessages converts domain model to response model.
+func fromDomainMessages(list queues.Messages) messageListResponse {
+	result := make([]messageResponse, 0, len(list))
+	for _, m := range list {
+		result = append(result, messageResponse{
+			ID:           m.ID,
+			Body:         m.Body,
+			SentAt:       m.SentAt,
+			ReceiveCount: m.ReceiveCount,
+		})
+	}
+
+	return messageListResponse{Messages: result}
+}
+
+// fromDomainRedrive converts domain model to response model.
+func fromDomainRedrive(r queues.RedriveResult) redriveResponse {
+	return redriveResponse{
+		Moved:    append([]string{}, r.Moved...),
+		NotFound: append([]string{}, r.NotFound...),
+	}
+}
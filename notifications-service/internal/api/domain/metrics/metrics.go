@@ -0,0 +1,25 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Namespace defines namespace for metrics.
+const Namespace = "notifications_service"
+
+// Results of handling events, values of the result label of EventsCounter.
+const (
+	ResultHandled   = "handled"
+	ResultFailed    = "failed"    // the handler failed, the message is received again
+	ResultInvalid   = "invalid"   // the message is not JSON, it is deleted
+	ResultUnhandled = "unhandled" // no handler for the event type, the message is deleted
+)
+
+type (
+	// Metrics defines metrics for application.
+	Metrics struct {
+		EventsCounter        *prometheus.CounterVec   // labeled by event_type and result
+		EventLagHistogram    *prometheus.HistogramVec // labeled by event_type
+		ConfigReloadsCounter *prometheus.CounterVec   // labeled by result
+	}
+)
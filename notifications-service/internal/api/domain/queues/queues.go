@@ -0,0 +1,50 @@
+package queues
+
+import "time"
+
+// Names of queues of the admin API.
+const (
+	Main = "main" // the queue of events consumed by the service
+	DLQ  = "dlq"  // the dead-letter queue of events failed to be handled too many times
+)
+
+// Limits of peeked messages.
+const (
+	DefaultPeekLimit = 10
+	MaxPeekLimit     = 100
+)
+
+type (
+	// Stats describes approximate numbers of messages of a queue, SQS reports them with a delay.
+	Stats struct {
+		Name     string
+		URL      string
+		Visible  int64 // available for receiving
+		InFlight int64 // received and neither deleted nor visible again yet
+		Delayed  int64 // not available for receiving yet
+	}
+
+	// Message describes a message of a queue.
+	Message struct {
+		ID           string
+		Body         string
+		SentAt       time.Time
+		ReceiveCount int64 // times the message was received, including receives by peeks
+	}
+
+	// Messages describe a list of Message.
+	Messages []Message
+
+	// Redrive describes messages to move from the dead-letter queue back to the main queue, either the messages
+	// of MessageIDs or all of them.
+	Redrive struct {
+		MessageIDs []string
+		All        bool
+	}
+
+	// RedriveResult describes messages moved from the dead-letter queue back to the main queue.
+	RedriveResult struct {
+		Moved    []string // ids of moved messages
+		NotFound []string // requested ids not found in the dead-letter queue, e.g. received by another client
+	}
+)
@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/api/domain/queues"
+)
+
+type (
+	// QueuesRepository defines the interface for repositories of queues and their messages.
+	QueuesRepository interface {
+		Stats(ctx context.Context, queueURL string) (queues.Stats, error)
+		// Peek returns up to limit messages of the queue without deleting them, they are visible again
+		// right after.
+		Peek(ctx context.Context, queueURL string, limit int) (queues.Messages, error)
+		// Move sends messages of ids, or all messages when ids are nil, from one queue to another and deletes
+		// them from the former.
+		Move(ctx context.Context, fromURL, toURL string, ids []string) (queues.RedriveResult, error)
+		Purge(ctx context.Context, queueURL string) error
+	}
+)
@@ -0,0 +1,288 @@
+package sqs_queues
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/api/domain/queues"
+	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/api/repositories"
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"go.uber.org/zap"
+)
+
+var _ repositories.QueuesRepository = &Repository{}
+
+const (
+	// receiveBatch - max number of messages received by a request.
+	receiveBatch = 10
+	// visibilityTimeout - seconds messages received by peeks and moves are hidden from other consumers, unless
+	// they are released earlier.
+	visibilityTimeout = 30
+	// releaseTimeout - deadline of making received messages visible again.
+	releaseTimeout = 5 * time.Second
+)
+
+// Repository - defines a repository of SQS queues.
+type Repository struct {
+	client *sqs.Client
+	logger *zap.Logger
+}
+
+// NewRepository creates a new repository.
+func NewRepository(client *sqs.Client, logger *zap.Logger) repositories.QueuesRepository {
+	return &Repository{
+		client: client,
+		logger: logger.With(zap.String("repositories", "sqs_queues")),
+	}
+}
+
+// Stats returns approximate numbers of messages of the queue.
+func (r *Repository) Stats(ctx context.Context, queueURL string) (queues.Stats, error) {
+	if ctx.Err() != nil {
+		return queues.Stats{}, ctx.Err()
+	}
+
+	out, err := r.client.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl: aws.String(queueURL),
+		AttributeNames: []types.QueueAttributeName{
+			types.QueueAttributeNameApproximateNumberOfMessages,
+			types.QueueAttributeNameApproximateNumberOfMessagesNotVisible,
+			types.QueueAttributeNameApproximateNumberOfMessagesDelayed,
+		},
+	})
+	if err != nil {
+		return queues.Stats{}, mapError("get attributes of the queue", err)
+	}
+
+	stats := queues.Stats{URL: queueURL}
+	for name, dest := range map[types.QueueAttributeName]*int64{
+		types.QueueAttributeNameApproximateNumberOfMessages:           &stats.Visible,
+		types.QueueAttributeNameApproximateNumberOfMessagesNotVisible: &stats.InFlight,
+		types.QueueAttributeNameApproximateNumberOfMessagesDelayed:    &stats.Delayed,
+	} {
+		value, ok := out.Attributes[string(name)]
+		if !ok {
+			continue
+		}
+
+		if *dest, err = strconv.ParseInt(value, 10, 64); err != nil {
+			return queues.Stats{}, errs.Internal{Cause: "invalid attribute " + string(name) + " of the queue: " + value}
+		}
+	}
+
+	return stats, nil
+}
+
+// Peek receives up to limit messages of the queue and makes them visible again, so they are not deleted,
+// but their receive counts are increased.
+func (r *Repository) Peek(ctx context.Context, queueURL string, limit int) (queues.Messages, error) {
+	var received []types.Message
+	defer func() { r.release(ctx, queueURL, received) }()
+
+	for len(received) < limit {
+		batch, err := r.receive(ctx, queueURL, min(limit-len(received), receiveBatch))
+		if err != nil {
+			return nil, err
+		}
+		if len(batch) == 0 {
+			break
+		}
+
+		received = append(received, batch...)
+	}
+
+	items := make(queues.Messages, 0, len(received))
+	for _, m := range received {
+		items = append(items, toDomain(m))
+	}
+
+	return items, nil
+}
+
+// Move sends messages of ids, or all messages when ids are nil, from one queue to another and deletes them from
+// the former. Messages are received in one pass over the queue: until all of ids are found, the queue has no
+// visible messages, a kept message is received again or as many messages are received as the queue had visible,
+// so messages reappearing after visibilityTimeout or sent meanwhile don't prolong it. Received messages which
+// are not moved are made visible again. Messages moved before an error are not moved back.
+func (r *Repository) Move(ctx context.Context, fromURL, toURL string, ids []string) (queues.RedriveResult, error) {
+	stats, err := r.Stats(ctx, fromURL)
+	if err != nil {
+		return queues.RedriveResult{}, err
+	}
+
+	wanted := make(map[string]bool, len(ids)) // ids which are not found yet
+	for _, id := range ids {
+		wanted[id] = true
+	}
+
+	var (
+		result   queues.RedriveResult
+		kept     []types.Message    // received messages which are not moved
+		keptAt   = map[string]int{} // indexes of kept messages by ids
+		received int64              // number of received messages
+		wrapped  bool               // whether a kept message is received again
+	)
+	defer func() { r.release(ctx, fromURL, kept) }()
+
+	for (ids == nil || len(wanted) > 0) && !wrapped && received < max(stats.Visible, 1) {
+		batch, err := r.receive(ctx, fromURL, receiveBatch)
+		if err != nil {
+			return result, err
+		}
+		if len(batch) == 0 {
+			break
+		}
+		received += int64(len(batch))
+
+		for i, m := range batch {
+			id := aws.ToString(m.MessageId)
+			if k, ok := keptAt[id]; ok {
+				kept[k] = m // the former receipt handle is no longer valid
+				wrapped = true
+				continue
+			}
+			if ids != nil && !wanted[id] {
+				keptAt[id] = len(kept)
+				kept = append(kept, m)
+				continue
+			}
+
+			if err = r.move(ctx, fromURL, toURL, m); err != nil {
+				kept = append(kept, batch[i:]...)
+				return result, err
+			}
+
+			delete(wanted, id)
+			result.Moved = append(result.Moved, id)
+		}
+	}
+
+	for _, id := range ids {
+		if wanted[id] && !slices.Contains(result.NotFound, id) {
+			result.NotFound = append(result.NotFound, id)
+		}
+	}
+
+	return result, nil
+}
+
+// Purge deletes all messages of the queue, SQS allows a purge of a queue once in 60 seconds.
+func (r *Repository) Purge(ctx context.Context, queueURL string) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	if _, err := r.client.PurgeQueue(ctx, &sqs.PurgeQueueInput{QueueUrl: aws.String(queueURL)}); err != nil {
+		return mapError("purge the queue", err)
+	}
+
+	return nil
+}
+
+// receive receives up to maxNumber visible messages of the queue and hides them by visibilityTimeout.
+func (r *Repository) receive(ctx context.Context, queueURL string, maxNumber int) ([]types.Message, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	out, err := r.client.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
+		QueueUrl:            aws.String(queueURL),
+		MaxNumberOfMessages: int32(maxNumber),
+		VisibilityTimeout:   visibilityTimeout,
+		MessageSystemAttributeNames: []types.MessageSystemAttributeName{
+			types.MessageSystemAttributeNameSentTimestamp,
+			types.MessageSystemAttributeNameApproximateReceiveCount,
+		},
+		MessageAttributeNames: []string{"All"},
+	})
+	if err != nil {
+		return nil, mapError("receive messages of the queue", err)
+	}
+
+	return out.Messages, nil
+}
+
+// move sends a received message to the queue at toURL and deletes it from the queue at fromURL.
+func (r *Repository) move(ctx context.Context, fromURL, toURL string, m types.Message) error {
+	if _, err := r.client.SendMessage(ctx, &sqs.SendMessageInput{
+		QueueUrl:          aws.String(toURL),
+		MessageBody:       m.Body,
+		MessageAttributes: m.MessageAttributes,
+	}); err != nil {
+		return mapError("send the message", err)
+	}
+
+	if _, err := r.client.DeleteMessage(ctx, &sqs.DeleteMessageInput{
+		QueueUrl:      aws.String(fromURL),
+		ReceiptHandle: m.ReceiptHandle,
+	}); err != nil {
+		return mapError("delete the moved message", err)
+	}
+
+	return nil
+}
+
+// release makes received messages visible again, messages failed to be released are visible again after
+// visibilityTimeout.
+func (r *Repository) release(ctx context.Context, queueURL string, msgs []types.Message) {
+	if len(msgs) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), releaseTimeout)
+	defer cancel()
+
+	for _, m := range msgs {
+		if _, err := r.client.ChangeMessageVisibility(ctx, &sqs.ChangeMessageVisibilityInput{
+			QueueUrl:          aws.String(queueURL),
+			ReceiptHandle:     m.ReceiptHandle,
+			VisibilityTimeout: 0,
+		}); err != nil {
+			r.logger.Warn("failed to release message", zap.Error(err),
+				zap.String("message_id", aws.ToString(m.MessageId)))
+		}
+	}
+}
+
+// toDomain converts a received message to domain model.
+func toDomain(m types.Message) queues.Message {
+	msg := queues.Message{
+		ID:   aws.ToString(m.MessageId),
+		Body: aws.ToString(m.Body),
+	}
+
+	sentAt := m.Attributes[string(types.MessageSystemAttributeNameSentTimestamp)]
+	if ms, err := strconv.ParseInt(sentAt, 10, 64); err == nil {
+		msg.SentAt = time.UnixMilli(ms).UTC()
+	}
+
+	receiveCount := m.Attributes[string(types.MessageSystemAttributeNameApproximateReceiveCount)]
+	msg.ReceiveCount, _ = strconv.ParseInt(receiveCount, 10, 64)
+
+	return msg
+}
+
+// mapError maps errors of SQS to errs errors.
+func mapError(action string, err error) error {
+	var (
+		notExist   *types.QueueDoesNotExist
+		inProgress *types.PurgeQueueInProgress
+	)
+
+	switch {
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return err
+	case errors.As(err, &notExist):
+		return errs.NotFound{What: "queue"}
+	case errors.As(err, &inProgress):
+		return errs.TooManyRequests{Cause: "the queue was purged less than 60 seconds ago"}
+	default:
+		return errs.Internal{Cause: "failed to " + action + ": " + err.Error()}
+	}
+}
@@ -0,0 +1,124 @@
+package sqs_queues
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/api/domain/queues"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// testQueue - fake SQS queue serving the AWS JSON protocol, received messages are never hidden, as if every
+// receive took longer than visibilityTimeout, and next returns a message of every receive.
+type testQueue struct {
+	visible int                      // approximate number of visible messages
+	next    func(receive int) string // id of the message of a receive
+
+	mu       sync.Mutex
+	receives int
+	handles  map[string]string // last receipt handles by ids
+	actions  []string          // actions with their ids, e.g. DeleteMessage:m1
+}
+
+// serveHTTP serves actions used by the repository.
+func (q *testQueue) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	var in struct {
+		ReceiptHandle string
+		MessageBody   string
+	}
+	if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	action := strings.TrimPrefix(r.Header.Get("X-Amz-Target"), "AmazonSQS.")
+	out := map[string]any{}
+	switch action {
+	case "GetQueueAttributes":
+		out["Attributes"] = map[string]string{"ApproximateNumberOfMessages": strconv.Itoa(q.visible)}
+	case "ReceiveMessage":
+		q.receives++
+		id := q.next(q.receives)
+		q.handles[id] = id + "-" + strconv.Itoa(q.receives)
+		out["Messages"] = []map[string]any{{
+			"MessageId": id, "ReceiptHandle": q.handles[id], "Body": "body", "MD5OfBody": md5Hex("body"),
+		}}
+	case "SendMessage":
+		out["MessageId"] = "sent"
+		out["MD5OfMessageBody"] = md5Hex(in.MessageBody)
+	case "DeleteMessage", "ChangeMessageVisibility":
+		q.actions = append(q.actions, action+":"+in.ReceiptHandle)
+	}
+
+	w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+	_ = json.NewEncoder(w).Encode(out)
+}
+
+// newTestRepository returns a repository of the fake queue.
+func newTestRepository(t *testing.T, q *testQueue) *Repository {
+	t.Helper()
+
+	q.handles = map[string]string{}
+	srv := httptest.NewServer(http.HandlerFunc(q.serveHTTP))
+	t.Cleanup(srv.Close)
+
+	client := sqs.New(sqs.Options{
+		Region:       "us-east-1",
+		BaseEndpoint: aws.String(srv.URL),
+		Credentials:  credentials.NewStaticCredentialsProvider("test", "test", ""),
+	})
+
+	return NewRepository(client, zap.NewNop()).(*Repository)
+}
+
+// TestMoveOnePass tests that moves end after one pass over the queue although received messages reappear.
+func TestMoveOnePass(t *testing.T) {
+	const fromURL, toURL = "http://sqs/000000000000/dlq", "http://sqs/000000000000/main"
+
+	t.Run("kept message received again", func(t *testing.T) {
+		q := &testQueue{visible: 100, next: func(int) string { return "m1" }}
+		r := newTestRepository(t, q)
+
+		result, err := r.Move(context.Background(), fromURL, toURL, []string{"missing"})
+		require.NoError(t, err)
+
+		assert.Equal(t, queues.RedriveResult{NotFound: []string{"missing"}}, result)
+		assert.Equal(t, 2, q.receives)
+		assert.Equal(t, []string{"ChangeMessageVisibility:m1-2"}, q.actions,
+			"the message is released once by its last receipt handle")
+	})
+
+	t.Run("messages sent meanwhile", func(t *testing.T) {
+		q := &testQueue{visible: 2, next: func(receive int) string { return "m" + strconv.Itoa(receive) }}
+		r := newTestRepository(t, q)
+
+		result, err := r.Move(context.Background(), fromURL, toURL, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, queues.RedriveResult{Moved: []string{"m1", "m2"}}, result,
+			"as many messages are received as the queue had visible")
+		assert.Equal(t, []string{"DeleteMessage:m1-1", "DeleteMessage:m2-2"}, q.actions)
+	})
+}
+
+// md5Hex returns the hex MD5 digest of s, SQS clients check bodies by it.
+func md5Hex(s string) string {
+	sum := md5.Sum([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
@@ -25,7 +25,26 @@ func (s Service) Create(id uuid.UUID) {
 	s.logger.Info("➕ product created", zap.String("id", id.String()))
 }
 
+// Update - log info about updated product.
+func (s Service) Update(id uuid.UUID) {
+	s.logger.Info("✏️ product updated", zap.String("id", id.String()))
+}
+
 // Delete - log info about deleted product.
 func (s Service) Delete(id uuid.UUID) {
 	s.logger.Info("➖ product deleted", zap.String("id", id.String()))
 }
+
+// StockLow - alert about product stock at or below its low stock threshold.
+func (s Service) StockLow(id uuid.UUID, warehouse string, available, threshold int64) {
+	s.logger.Warn("⚠️ product stock is low", zap.String("id", id.String()),
+		zap.String("warehouse", warehouse),
+		zap.Int64("available", available),
+		zap.Int64("threshold", threshold))
+}
+
+// OutOfStock - alert about product out of stock.
+func (s Service) OutOfStock(id uuid.UUID, warehouse string) {
+	s.logger.Warn("🚫 product is out of stock", zap.String("id", id.String()),
+		zap.String("warehouse", warehouse))
+}
@@ -0,0 +1,125 @@
+package queues
+
+import (
+	"context"
+
+	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/api/domain/queues"
+	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/api/repositories"
+	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/api/services"
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"go.uber.org/zap"
+)
+
+var _ services.Queues = &Service{}
+
+// Service - defines services struct.
+type Service struct {
+	repo   repositories.QueuesRepository
+	urls   map[string]string // URLs of queues by their names
+	logger *zap.Logger
+}
+
+// NewService constructor, dlqURL is empty when the main queue has no dead-letter queue.
+func NewService(repo repositories.QueuesRepository, mainURL, dlqURL string, logger *zap.Logger) *Service {
+	urls := map[string]string{queues.Main: mainURL}
+	if dlqURL != "" {
+		urls[queues.DLQ] = dlqURL
+	}
+
+	return &Service{
+		repo:   repo,
+		urls:   urls,
+		logger: logger.With(zap.String("service", "queues")),
+	}
+}
+
+// Stats returns approximate numbers of messages of the main queue and of the dead-letter queue.
+func (s Service) Stats(ctx context.Context) ([]queues.Stats, error) {
+	result := make([]queues.Stats, 0, len(s.urls))
+	for _, name := range []string{queues.Main, queues.DLQ} {
+		url, ok := s.urls[name]
+		if !ok {
+			continue
+		}
+
+		stats, err := s.repo.Stats(ctx, url)
+		if err != nil {
+			return nil, err
+		}
+		stats.Name = name
+
+		result = append(result, stats)
+	}
+
+	return result, nil
+}
+
+// PeekDLQ returns up to limit messages of the dead-letter queue without deleting them.
+func (s Service) PeekDLQ(ctx context.Context, limit int) (queues.Messages, error) {
+	url, err := s.queueURL(queues.DLQ)
+	if err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = queues.DefaultPeekLimit
+	}
+
+	return s.repo.Peek(ctx, url, min(limit, queues.MaxPeekLimit))
+}
+
+// RedriveDLQ moves messages of the dead-letter queue back to the main queue, so they are handled again.
+func (s Service) RedriveDLQ(ctx context.Context, e queues.Redrive) (queues.RedriveResult, error) {
+	dlqURL, err := s.queueURL(queues.DLQ)
+	if err != nil {
+		return queues.RedriveResult{}, err
+	}
+
+	var ids []string
+	switch {
+	case e.All && len(e.MessageIDs) > 0:
+		return queues.RedriveResult{}, errs.BadRequest{Cause: "either message_ids or all must be set"}
+	case !e.All && len(e.MessageIDs) == 0:
+		return queues.RedriveResult{}, errs.BadRequest{Cause: "message_ids or all is required"}
+	case !e.All:
+		ids = e.MessageIDs
+	}
+
+	result, err := s.repo.Move(ctx, dlqURL, s.urls[queues.Main], ids)
+	if err != nil {
+		s.logger.Error("redrive of the dead-letter queue failed", zap.Error(err),
+			zap.Int("moved", len(result.Moved)))
+		return queues.RedriveResult{}, err
+	}
+
+	s.logger.Info("dead-letter queue redriven", zap.Int("moved", len(result.Moved)),
+		zap.Strings("not_found", result.NotFound))
+
+	return result, nil
+}
+
+// Purge deletes all messages of a queue by its name.
+func (s Service) Purge(ctx context.Context, name string) error {
+	url, err := s.queueURL(name)
+	if err != nil {
+		return err
+	}
+
+	if err = s.repo.Purge(ctx, url); err != nil {
+		return err
+	}
+
+	s.logger.Warn("queue purged", zap.String("queue", name))
+
+	return nil
+}
+
+// queueURL returns URL of a queue by its name.
+func (s Service) queueURL(name string) (string, error) {
+	url, ok := s.urls[name]
+	if !ok {
+		return "", errs.NotFound{What: "queue " + name}
+	}
+
+	return url, nil
+}
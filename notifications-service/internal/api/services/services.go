@@ -1,11 +1,32 @@
 package services
 
 import (
+	"context"
+
+	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/api/domain/queues"
+	"github.com/at-kh/guru-apps-test-services/platform/reload"
 	"github.com/google/uuid"
 )
 
 // Notifications - interface for notifications services.
 type Notifications interface {
 	Create(id uuid.UUID)
+	Update(id uuid.UUID)
 	Delete(id uuid.UUID)
+	StockLow(id uuid.UUID, warehouse string, available, threshold int64)
+	OutOfStock(id uuid.UUID, warehouse string)
+}
+
+// Queues - interface for services of the queues of events and their dead-letter queue.
+type Queues interface {
+	Stats(ctx context.Context) ([]queues.Stats, error)
+	PeekDLQ(ctx context.Context, limit int) (queues.Messages, error)
+	RedriveDLQ(ctx context.Context, e queues.Redrive) (queues.RedriveResult, error)
+	Purge(ctx context.Context, name string) error
+}
+
+// Configs - interface for reloads of the configuration without restart.
+type Configs interface {
+	Reload(ctx context.Context, trigger string) (reload.Reload, error)
+	State(ctx context.Context) (reload.State, error)
 }
@@ -2,13 +2,19 @@ package app
 
 import (
 	"context"
+	"sync/atomic"
 	"time"
 
 	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/api/delivery"
-	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/api/domain/health"
+	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/api/domain/metrics"
+	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/api/repositories"
 	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/api/services"
 	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/config"
+	"github.com/at-kh/guru-apps-test-services/platform/health"
+	"github.com/at-kh/guru-apps-test-services/platform/lifecycle"
+	"github.com/at-kh/guru-apps-test-services/platform/responder"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/shopspring/decimal"
 	"go.uber.org/zap"
 )
@@ -16,8 +22,8 @@ import (
 type (
 	// Meta defines meta for application.
 	Meta struct {
-		ConfigPath string
-		Info       health.Info
+		ConfigPaths []string // configuration files merged in order, config.DefaultPath when empty
+		Info        health.Info
 	}
 
 	// App defines main application struct.
@@ -26,41 +32,83 @@ type (
 		meta Meta
 
 		// Tech dependencies.
-		cfg       *config.Config
-		logger    *zap.Logger
-		sqsClient *sqs.Client
+		cfg        *config.Config
+		broker     atomic.Pointer[config.Broker] // settings of the broker, they are changed by reloads
+		logger     *zap.Logger
+		logLevel   zap.AtomicLevel // level of logger, it is changed by reloads of the configuration
+		sqsClient  *sqs.Client
+		lifecycle  *lifecycle.Manager // starts and stops workers, reports readiness
+		metrics    *metrics.Metrics
+		metricsReg *prometheus.Registry // registry of metrics, the default one when nil
+		responder  responder.Responder  // responder for http responses
 
 		// Repository dependencies.
+		queuesRepository repositories.QueuesRepository
 
 		// Services dependencies.
 		notificationsService services.Notifications
+		queuesService        services.Queues
+		configsService       services.Configs
 
 		// Delivery dependencies.
 		healthHTTPHandler  delivery.HealthHTTPHandler
+		queuesHTTPHandler  delivery.QueuesHTTPHandler
+		configsHTTPHandler delivery.ConfigsHTTPHandler
 		sqsConsumerHandler delivery.SQSConsumerHandler
 	}
-
-	worker func(ctx context.Context, a *App)
 )
 
 // New - app constructor without init for components.
 func New(meta Meta) *App { return &App{meta: meta} }
 
-// Run – initialize configuration and application dependencies, run workers.
-func (a *App) Run(ctx context.Context) {
-	decimal.MarshalJSONWithoutQuotes = true
-	time.Local = time.UTC
+// WithConfig sets the configuration, so it is not loaded from files and env.
+func (a *App) WithConfig(cfg *config.Config) *App {
+	a.cfg = cfg
+	return a
+}
+
+// WithLogger sets the logger of the application, its level is not changed by reloads of the configuration.
+func (a *App) WithLogger(logger *zap.Logger) *App {
+	a.logger = logger
+	return a
+}
+
+// WithMetricsRegistry sets the registry of metrics served by /metrics, so several applications may run
+// in the same process.
+func (a *App) WithMetricsRegistry(reg *prometheus.Registry) *App {
+	a.metricsReg = reg
+	return a
+}
+
+// SetGlobals sets process-wide settings of the application, settings which are already set are not written,
+// so programs embedding applications call it before starting goroutines, e.g. tests running several of them.
+func SetGlobals() {
+	if !decimal.MarshalJSONWithoutQuotes {
+		decimal.MarshalJSONWithoutQuotes = true
+	}
+	if time.Local != time.UTC {
+		time.Local = time.UTC
+	}
+}
+
+// Run – initialize configuration and application dependencies, run workers until ctx is done and stop them.
+func (a *App) Run(ctx context.Context) error {
+	SetGlobals()
 
 	// Initialize configuration and tech dependencies
 	a.initConfig()
 	a.initLogger()
+	a.initMetrics()
+	a.initLifecycle()
+	a.initResponder()
 	a.initMessageBroker(ctx)
 
 	// Layers registration
+	a.registerRepositories()
 	a.registerServices()
 	a.registerHTTPHandlers()
 	a.registerBrokerHandlers()
 
 	// Run workers
-	a.runWorkers(ctx)
+	return a.runWorkers(ctx)
 }
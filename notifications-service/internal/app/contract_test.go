@@ -0,0 +1,116 @@
+package app
+
+import (
+	"context"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/api/delivery/broker/sqs_consumer"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// eventsDir - the catalog of events shared with producers: a JSON schema and a golden payload of every event type.
+const eventsDir = "../../../events"
+
+// TestContract_BrokerHandlers checks that every golden payload of the catalog is valid by its schema and is handled
+// by the handler of its event type with the values it carries, and that there are no handlers out of the catalog.
+func TestContract_BrokerHandlers(t *testing.T) {
+	schemas := loadSchemas(t)
+
+	service := &notificationsRecorder{}
+	a := &App{sqsConsumerHandler: sqs_consumer.NewHandler(service, zap.NewNop())}
+	handlers := a.brokerHandlers()
+
+	require.ElementsMatch(t, slices.Collect(maps.Keys(schemas)), slices.Collect(maps.Keys(handlers)),
+		"event types handled must be the event types of the catalog")
+
+	for eventType, schema := range schemas {
+		t.Run(eventType, func(t *testing.T) {
+			body, err := os.ReadFile(filepath.Join(eventsDir, "golden", eventType+".json"))
+			require.NoError(t, err)
+
+			payload, err := jsonschema.UnmarshalJSON(strings.NewReader(string(body)))
+			require.NoError(t, err)
+			require.NoError(t, schema.Validate(payload), "golden payload is not valid by its schema")
+
+			var golden notification
+			require.NoError(t, json.Unmarshal(body, &golden))
+			if eventType == eventTypeOutOfStock {
+				golden.Available, golden.Threshold = 0, 0 // out of stock is notified by the warehouse only
+			}
+			golden.EventType = eventType
+
+			*service = notificationsRecorder{}
+			require.NoError(t, handlers[eventType](context.Background(), body))
+			assert.Equal(t, []notification{golden}, service.notifications)
+		})
+	}
+}
+
+// loadSchemas compiles schemas of the catalog by their event types.
+func loadSchemas(t *testing.T) map[string]*jsonschema.Schema {
+	t.Helper()
+
+	paths, err := filepath.Glob(filepath.Join(eventsDir, "schemas", "*.schema.json"))
+	require.NoError(t, err)
+	require.NotEmpty(t, paths, "the catalog of events is not found")
+
+	c := jsonschema.NewCompiler()
+	c.AssertFormat()
+
+	schemas := make(map[string]*jsonschema.Schema, len(paths))
+	for _, path := range paths {
+		schema, err := c.Compile(path)
+		require.NoError(t, err)
+		schemas[strings.TrimSuffix(filepath.Base(path), ".schema.json")] = schema
+	}
+
+	return schemas
+}
+
+// notification - a call of the notifications service, named by the event type it is expected for.
+type notification struct {
+	EventType string    `json:"-"`
+	ProductID uuid.UUID `json:"product_id"`
+	Warehouse string    `json:"warehouse"`
+	Available int64     `json:"available"`
+	Threshold int64     `json:"threshold"`
+}
+
+// notificationsRecorder - the notifications service recording its calls.
+type notificationsRecorder struct {
+	notifications []notification
+}
+
+func (r *notificationsRecorder) Create(id uuid.UUID) {
+	r.notifications = append(r.notifications, notification{EventType: eventTypeCreateProduct, ProductID: id})
+}
+
+func (r *notificationsRecorder) Update(id uuid.UUID) {
+	r.notifications = append(r.notifications, notification{EventType: eventTypeUpdateProduct, ProductID: id})
+}
+
+func (r *notificationsRecorder) Delete(id uuid.UUID) {
+	r.notifications = append(r.notifications, notification{EventType: eventTypeDeleteProduct, ProductID: id})
+}
+
+func (r *notificationsRecorder) StockLow(id uuid.UUID, warehouse string, available, threshold int64) {
+	r.notifications = append(r.notifications, notification{
+		EventType: eventTypeStockLow, ProductID: id, Warehouse: warehouse, Available: available, Threshold: threshold,
+	})
+}
+
+func (r *notificationsRecorder) OutOfStock(id uuid.UUID, warehouse string) {
+	r.notifications = append(r.notifications, notification{
+		EventType: eventTypeOutOfStock, ProductID: id, Warehouse: warehouse,
+	})
+}
@@ -5,12 +5,17 @@ import (
 	"github.com/gofiber/fiber/v2/log"
 )
 
-// initConfig - init config from yaml and env with validation.
+// initConfig - init config from yaml files and env with validation, unless it is set by WithConfig.
 func (a *App) initConfig() {
-	cfg, err := config.InitConfig(config.DefaultPath)
-	if err != nil {
-		log.Fatal("config error: %v", err)
+	if a.cfg == nil {
+		cfg, err := config.InitConfig(a.meta.ConfigPaths...)
+		if err != nil {
+			log.Fatalf("config error: %v", err)
+		}
+
+		a.cfg = cfg
 	}
 
-	a.cfg = cfg
+	broker := a.cfg.Delivery.Broker
+	a.broker.Store(&broker)
 }
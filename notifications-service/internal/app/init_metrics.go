@@ -0,0 +1,42 @@
+package app
+
+import (
+	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/api/domain/metrics"
+	platformmetrics "github.com/at-kh/guru-apps-test-services/platform/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// initMetrics - initialize metrics for application.
+func (a *App) initMetrics() {
+	registerer, _ := platformmetrics.Registry(a.metricsReg)
+	factory := promauto.With(registerer)
+
+	a.metrics = &metrics.Metrics{
+		EventsCounter: factory.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: metrics.Namespace,
+				Name:      "events_cnt",
+				Help:      "Total number of received events by result of handling",
+			},
+			[]string{"event_type", "result"},
+		),
+		EventLagHistogram: factory.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: metrics.Namespace,
+				Name:      "event_lag_seconds",
+				Help:      "Time from publishing of events to the end of their handling",
+				Buckets:   prometheus.ExponentialBuckets(0.001, 2, 16), // 1ms - 32s
+			},
+			[]string{"event_type"},
+		),
+		ConfigReloadsCounter: factory.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: metrics.Namespace,
+				Name:      "config_reloads_cnt",
+				Help:      "Total number of reloads of the configuration",
+			},
+			[]string{"result"},
+		),
+	}
+}
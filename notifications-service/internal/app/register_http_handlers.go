@@ -1,10 +1,14 @@
 package app
 
 import (
-	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/api/delivery/http/health"
+	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/api/delivery/http/queues"
+	"github.com/at-kh/guru-apps-test-services/platform/health"
+	"github.com/at-kh/guru-apps-test-services/platform/reload"
 )
 
 // registerHTTPHandlers initializes the http handlers.
 func (a *App) registerHTTPHandlers() {
-	a.healthHTTPHandler = health.NewHandler(a.meta.Info)
+	a.healthHTTPHandler = health.NewHandler(a.meta.Info, a.lifecycle.Ready)
+	a.queuesHTTPHandler = queues.NewHandler(a.responder, a.queuesService, a.logger)
+	a.configsHTTPHandler = reload.NewHandler(a.responder, a.configsService)
 }
@@ -1,6 +1,8 @@
 package app
 
 import (
+	"github.com/at-kh/guru-apps-test-services/platform/auth"
+	"github.com/at-kh/guru-apps-test-services/platform/metrics"
 	"github.com/gofiber/fiber/v2"
 )
 
@@ -8,4 +10,15 @@ import (
 func (a *App) registerHTTPRoutes(app *fiber.App) {
 	r := app.Group("/notifications-api/v1")
 	r.Get("/health", a.healthHTTPHandler.Health)
+	r.Get("/ready", a.healthHTTPHandler.Ready)
+
+	admin := r.Group("/admin", auth.BearerToken(a.cfg.Delivery.HTTPServer.AdminToken))
+	admin.Get("/queues", a.queuesHTTPHandler.GetStats)
+	admin.Get("/queues/dlq/messages", a.queuesHTTPHandler.PeekDLQ)
+	admin.Post("/queues/dlq/redrive", a.queuesHTTPHandler.RedriveDLQ)
+	admin.Delete("/queues/:queue/messages", a.queuesHTTPHandler.Purge)
+	admin.Get("/config", a.configsHTTPHandler.Get)
+	admin.Post("/config/reload", a.configsHTTPHandler.Reload)
+
+	r.Get("/metrics", metrics.Handler(metrics.Registry(a.metricsReg)))
 }
@@ -0,0 +1,49 @@
+package app
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/config"
+	"github.com/at-kh/guru-apps-test-services/platform/responder"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// TestAdminRoutesRequireToken fails when a route of the admin API, e.g. reloading the configuration,
+// is registered outside of the group authorized by the admin token.
+func TestAdminRoutesRequireToken(t *testing.T) {
+	cfg := &config.Config{Delivery: config.Delivery{HTTPServer: config.HTTPServer{AdminToken: "secret"}}}
+	a := &App{cfg: cfg, logger: zap.NewNop()}
+	a.registerHTTPHandlers()
+
+	router := fiber.New(fiber.Config{ErrorHandler: responder.New().HandleError})
+	a.registerHTTPRoutes(router)
+
+	var checked int
+	for _, route := range router.GetRoutes(true) {
+		if route.Method == http.MethodHead || !strings.HasPrefix(route.Path, "/notifications-api/v1/admin/") {
+			continue
+		}
+
+		target := strings.ReplaceAll(route.Path, ":queue", "main")
+		for _, authorization := range []string{"", "Bearer wrong"} {
+			req := httptest.NewRequest(route.Method, target, http.NoBody)
+			if authorization != "" {
+				req.Header.Set(fiber.HeaderAuthorization, authorization)
+			}
+
+			resp, err := router.Test(req)
+			require.NoError(t, err)
+			_ = resp.Body.Close()
+			assert.Equal(t, http.StatusUnauthorized, resp.StatusCode, "%s %s %q", route.Method, route.Path, authorization)
+		}
+		checked++
+	}
+
+	assert.NotZero(t, checked, "admin routes are registered")
+}
@@ -0,0 +1,8 @@
+package app
+
+import "github.com/at-kh/guru-apps-test-services/notifications-service/internal/api/repositories/sqs_queues"
+
+// registerRepositories register repositories in-app struct.
+func (a *App) registerRepositories() {
+	a.queuesRepository = sqs_queues.NewRepository(a.sqsClient, a.logger)
+}
@@ -1,8 +1,16 @@
 package app
 
-import "github.com/at-kh/guru-apps-test-services/notifications-service/internal/api/services/notifications"
+import (
+	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/api/services/notifications"
+	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/api/services/queues"
+	"github.com/at-kh/guru-apps-test-services/platform/reload"
+)
 
 // registerServices register services in-app struct.
 func (a *App) registerServices() {
 	a.notificationsService = notifications.NewService(a.logger)
+	a.queuesService = queues.NewService(a.queuesRepository, a.cfg.Delivery.Broker.URL, a.cfg.Delivery.Broker.DLQURL,
+		a.logger)
+	a.configsService = reload.NewService(a.loadConfig, a.cfg, a.applyLiveConfig, a.metrics.ConfigReloadsCounter,
+		a.logger)
 }
@@ -2,25 +2,38 @@ package app
 
 import (
 	"context"
-	"sync"
+	"errors"
+	"syscall"
+
+	"github.com/at-kh/guru-apps-test-services/platform/lifecycle"
 )
 
-// runWorkers run workers.
-func (a *App) runWorkers(ctx context.Context) {
-	workers := []worker{
-		serveHTTP,
-		serveBroker,
-	}
+// runWorkers runs components until ctx is done. They are started in order and stopped in reverse order once
+// readiness turns false: the HTTP server stops accepting and drains requests, reloads of the configuration
+// and polling of the queue stop, received messages are handled and the logger is flushed.
+func (a *App) runWorkers(ctx context.Context) error {
+	handling, polling := serveBroker(a)
 
-	wg := new(sync.WaitGroup)
-	wg.Add(len(workers))
+	a.lifecycle.Add(
+		lifecycle.Component{
+			Name:        "logger",
+			Stop:        func(context.Context) error { return a.syncLogger() },
+			StopTimeout: a.cfg.Lifecycle.StopTimeout,
+		},
+		handling,
+		polling,
+		reloadConfig(a),
+		serveHTTP(a),
+	)
+
+	return a.lifecycle.Run(ctx)
+}
 
-	for _, work := range workers {
-		go func(ctx context.Context, work func(context.Context, *App), t *App) {
-			work(ctx, t)
-			wg.Done()
-		}(ctx, work, a)
+// syncLogger flushes buffered logs, errors of syncing terminals which don't support it are ignored.
+func (a *App) syncLogger() error {
+	if err := a.logger.Sync(); err != nil && !errors.Is(err, syscall.EINVAL) && !errors.Is(err, syscall.ENOTTY) {
+		return err
 	}
 
-	wg.Wait()
+	return nil
 }
@@ -0,0 +1,54 @@
+package app
+
+import (
+	"context"
+
+	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/config"
+	"github.com/at-kh/guru-apps-test-services/platform/lifecycle"
+	"github.com/at-kh/guru-apps-test-services/platform/reload"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// reloadConfig returns the component reloading the configuration on SIGHUP and on changes of configuration
+// files, which are checked every watch interval.
+func reloadConfig(a *App) lifecycle.Component {
+	paths := a.meta.ConfigPaths
+	if len(paths) == 0 {
+		paths = []string{config.DefaultPath}
+	}
+
+	return lifecycle.Component{
+		Name: "configuration reload",
+		Run: func(ctx context.Context) error {
+			reload.Watch(ctx, a.configsService, paths, a.cfg.Reload.WatchInterval)
+			return nil
+		},
+		StopTimeout: a.cfg.Lifecycle.StopTimeout,
+	}
+}
+
+// loadConfig loads the configuration to reload from yaml files and env with validation.
+func (a *App) loadConfig() (*config.Config, error) {
+	cfg, err := config.InitConfig(a.meta.ConfigPaths...)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = zapcore.ParseLevel(cfg.Logger.Level); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// applyLiveConfig applies settings of the configuration which are safe to change without restart,
+// they are tagged reload:"live" in config.Config.
+func (a *App) applyLiveConfig(cfg *config.Config) {
+	if err := a.logLevel.UnmarshalText([]byte(cfg.Logger.Level)); err != nil {
+		a.logger.Error("failed to set log level", zap.Error(err))
+	}
+
+	broker := cfg.Delivery.Broker
+	a.broker.Store(&broker)
+}
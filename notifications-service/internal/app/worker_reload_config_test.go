@@ -0,0 +1,60 @@
+package app
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// TestApplyLiveConfig tests that the log level and polling and handling of messages are reloaded without
+// restart, while the graceful timeout of handlers needs it.
+func TestApplyLiveConfig(t *testing.T) {
+	base, err := os.ReadFile(filepath.Join("..", "..", config.DefaultPath))
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, base, 0o600))
+
+	a := New(Meta{ConfigPaths: []string{path}}).WithLogger(zap.NewNop())
+	a.initConfig()
+	a.initLogger()
+	a.initMetrics()
+	a.registerServices()
+	require.Equal(t, int32(5), a.broker.Load().MaxNumberOfMessages)
+
+	changed := strings.NewReplacer(
+		"level: info", "level: debug",
+		"wait-time-seconds: 10", "wait-time-seconds: 20",
+		"handler-timeout: 60s", "handler-timeout: 30s",
+		// the graceful timeout of the broker, not of the HTTP server
+		"graceful-timeout: 60s\n    max-number-of-messages: 5", "graceful-timeout: 90s\n    max-number-of-messages: 10",
+	).Replace(string(base))
+	require.NoError(t, os.WriteFile(path, []byte(changed), 0o600))
+
+	reload, err := a.configsService.Reload(context.Background(), "test")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{
+		"logger.level",
+		"delivery.broker.handler-timeout",
+		"delivery.broker.max-number-of-messages",
+		"delivery.broker.wait-time-seconds",
+	}, reload.Applied)
+	assert.Equal(t, []string{"delivery.broker.graceful-timeout"}, reload.Rejected)
+
+	broker := a.broker.Load()
+	assert.Equal(t, int32(10), broker.MaxNumberOfMessages)
+	assert.Equal(t, int32(20), broker.WaitTimeSeconds)
+	assert.Equal(t, 30*time.Second, broker.HandlerTimeout)
+	assert.Equal(t, 60*time.Second, broker.GracefulTimeout, "settings which need restart are not changed")
+	assert.Equal(t, zapcore.DebugLevel, a.logLevel.Level())
+	assert.Equal(t, int32(5), a.cfg.Delivery.Broker.MaxNumberOfMessages, "the initial config is not changed")
+}
@@ -2,9 +2,10 @@ package app
 
 import (
 	"context"
-	"sync"
 	"time"
 
+	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/api/domain/metrics"
+	"github.com/at-kh/guru-apps-test-services/platform/lifecycle"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
 	"github.com/goccy/go-json"
@@ -14,120 +15,185 @@ import (
 // Event types for notifications services.
 const (
 	eventTypeCreateProduct = "create_product"
+	eventTypeUpdateProduct = "update_product"
 	eventTypeDeleteProduct = "delete_product"
+	eventTypeStockLow      = "stock_low"
+	eventTypeOutOfStock    = "out_of_stock"
+
+	eventTypeUnknown = "unknown" // label of events which are invalid or have no handlers
 )
 
 // brokerRoutes registers broker routes.
 func (a *App) brokerHandlers() map[string]func(ctx context.Context, body []byte) error {
 	return map[string]func(ctx context.Context, body []byte) error{
 		eventTypeCreateProduct: a.sqsConsumerHandler.CreateNotification,
+		eventTypeUpdateProduct: a.sqsConsumerHandler.UpdateNotification,
 		eventTypeDeleteProduct: a.sqsConsumerHandler.DeleteNotification,
+		eventTypeStockLow:      a.sqsConsumerHandler.StockLowNotification,
+		eventTypeOutOfStock:    a.sqsConsumerHandler.OutOfStockNotification,
 	}
 }
 
-// serveBroker listen for registered subjects.
-func serveBroker(ctx context.Context, app *App) {
-	queueURL := app.cfg.Delivery.Broker.URL
-	handlers := app.brokerHandlers()
+// brokerConsumer - polls the queue and handles received messages. Polling and handling are stopped
+// separately, so messages received before shutdown are still handled.
+type brokerConsumer struct {
+	app      *App
+	queueURL string
+	handlers map[string]func(ctx context.Context, body []byte) error
+
+	handling lifecycle.WaitGroup
+	ctx      context.Context // ctx of handlers, it is canceled when handlers miss the graceful timeout
+	cancel   context.CancelFunc
+}
+
+// serveBroker listen for registered subjects, it returns components of handling and polling, the polling
+// component must be stopped first.
+func serveBroker(app *App) (handling, polling lifecycle.Component) {
+	c := &brokerConsumer{
+		app:      app,
+		queueURL: app.cfg.Delivery.Broker.URL,
+		handlers: app.brokerHandlers(),
+	}
 
-	app.logger.Info("starting SQS consumer")
+	handling = lifecycle.Component{
+		Name: "SQS handlers",
+		Start: func(ctx context.Context) error {
+			c.ctx, c.cancel = context.WithCancel(context.WithoutCancel(ctx))
+			return nil
+		},
+		Stop: func(ctx context.Context) error {
+			defer c.cancel()
+
+			app.logger.Info("waiting for messages to finish processing")
+			return c.handling.WaitContext(ctx)
+		},
+		StopTimeout: app.cfg.Delivery.Broker.GracefulTimeout,
+	}
 
-	wg := &sync.WaitGroup{}
+	polling = lifecycle.Component{
+		Name:        "SQS consumer",
+		Run:         c.poll,
+		StopTimeout: app.cfg.Lifecycle.StopTimeout,
+	}
+
+	return handling, polling
+}
+
+// poll receives messages until ctx is done and handles them in background.
+func (c *brokerConsumer) poll(ctx context.Context) error {
+	c.app.logger.Info("starting SQS consumer")
+
+	for ctx.Err() == nil {
+		// settings are read on every receive, so reloads of the configuration apply to the next one
+		broker := c.app.broker.Load()
+
+		resp, err := c.app.sqsClient.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
+			QueueUrl:            &c.queueURL,
+			MaxNumberOfMessages: broker.MaxNumberOfMessages,
+			WaitTimeSeconds:     broker.WaitTimeSeconds,
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			c.app.logger.Error("failed to receive messages", zap.Error(err))
 
-	go func() {
-		for {
 			select {
 			case <-ctx.Done():
-				app.logger.Info("stopping SQS consumer, waiting for messages to finish processing")
-				wg.Wait()
-				app.logger.Info("SQS consumer stopped gracefully")
-				return
-			default:
-				resp, err := app.sqsClient.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
-					QueueUrl:            &queueURL,
-					MaxNumberOfMessages: app.cfg.Delivery.Broker.MaxNumberOfMessages,
-					WaitTimeSeconds:     app.cfg.Delivery.Broker.WaitTimeSeconds,
-				})
-				if err != nil {
-					if ctx.Err() != nil {
-						return
-					}
-					app.logger.Error("failed to receive messages", zap.Error(err))
-					time.Sleep(app.cfg.Delivery.Broker.RetryDelay)
-					continue
-				}
-
-				if resp == nil || len(resp.Messages) == 0 {
-					continue
-				}
-
-				for _, m := range resp.Messages {
-					select {
-					case <-ctx.Done():
-						return
-					default:
-					}
-
-					wg.Add(1)
-					go func(msg types.Message) {
-						defer wg.Done()
-
-						handlerCtx, handlerCancel := context.WithTimeout(ctx, app.cfg.Delivery.Broker.HandlerTimeout)
-						defer handlerCancel()
-
-						delCtx, delCancel := context.WithTimeout(context.Background(),
-							app.cfg.Delivery.Broker.DeleteTimeout)
-						defer delCancel()
-
-						if msg.Body == nil || *msg.Body == "" {
-							app.logger.Warn("received message with empty body")
-							return
-						}
-
-						var msgBody struct {
-							EventType string `json:"event_type"`
-						}
-						if err = json.Unmarshal([]byte(*msg.Body), &msgBody); err != nil {
-							app.logger.Error("failed to parse message", zap.Error(err),
-								zap.String("message_body", *msg.Body))
-							if _, delErr := app.sqsClient.DeleteMessage(delCtx, &sqs.DeleteMessageInput{
-								QueueUrl:      &queueURL,
-								ReceiptHandle: msg.ReceiptHandle,
-							}); delErr != nil {
-								app.logger.Error("failed to delete invalid message", zap.Error(delErr))
-							}
-							return
-						}
-
-						handler, ok := handlers[msgBody.EventType]
-						if !ok {
-							app.logger.Warn("no handler for event type",
-								zap.String("event_type", msgBody.EventType))
-							if _, delErr := app.sqsClient.DeleteMessage(delCtx, &sqs.DeleteMessageInput{
-								QueueUrl:      &queueURL,
-								ReceiptHandle: msg.ReceiptHandle,
-							}); delErr != nil {
-								app.logger.Error("failed to delete unhandled message", zap.Error(delErr))
-							}
-							return
-						}
-
-						if err = handler(handlerCtx, []byte(*msg.Body)); err != nil {
-							app.logger.Error("handler failed", zap.Error(err),
-								zap.String("event_type", msgBody.EventType),
-								zap.String("message_body", *msg.Body))
-							return
-						}
-
-						if _, err = app.sqsClient.DeleteMessage(delCtx, &sqs.DeleteMessageInput{
-							QueueUrl:      &queueURL,
-							ReceiptHandle: msg.ReceiptHandle,
-						}); err != nil {
-							app.logger.Error("failed to delete msg after successful processing", zap.Error(err))
-						}
-					}(m)
-				}
+			case <-time.After(broker.RetryDelay):
 			}
+			continue
+		}
+
+		if resp == nil {
+			continue
+		}
+
+		// received messages are handled even when polling is stopped, they are invisible to other consumers
+		for _, m := range resp.Messages {
+			c.handling.Add(1)
+			go func(msg types.Message) {
+				defer c.handling.Done()
+				c.handle(msg)
+			}(m)
+		}
+	}
+
+	c.app.logger.Info("SQS consumer stopped")
+
+	return nil
+}
+
+// handle handles a message and deletes it from the queue, messages failed to be handled are received again.
+func (c *brokerConsumer) handle(msg types.Message) {
+	app := c.app
+
+	handlerCtx, handlerCancel := context.WithTimeout(c.ctx, app.broker.Load().HandlerTimeout)
+	defer handlerCancel()
+
+	if msg.Body == nil || *msg.Body == "" {
+		app.logger.Warn("received message with empty body")
+		return
+	}
+
+	var msgBody struct {
+		EventType  string    `json:"event_type"`
+		OccurredAt time.Time `json:"occurred_at"` // zero in events published before they were timestamped
+	}
+	if err := json.Unmarshal([]byte(*msg.Body), &msgBody); err != nil {
+		c.observe(eventTypeUnknown, metrics.ResultInvalid, time.Time{})
+		app.logger.Error("failed to parse message", zap.Error(err),
+			zap.String("message_body", *msg.Body))
+		if delErr := c.delete(msg); delErr != nil {
+			app.logger.Error("failed to delete invalid message", zap.Error(delErr))
 		}
-	}()
+		return
+	}
+
+	handler, ok := c.handlers[msgBody.EventType]
+	if !ok {
+		c.observe(eventTypeUnknown, metrics.ResultUnhandled, time.Time{})
+		app.logger.Warn("no handler for event type",
+			zap.String("event_type", msgBody.EventType))
+		if delErr := c.delete(msg); delErr != nil {
+			app.logger.Error("failed to delete unhandled message", zap.Error(delErr))
+		}
+		return
+	}
+
+	if err := handler(handlerCtx, []byte(*msg.Body)); err != nil {
+		c.observe(msgBody.EventType, metrics.ResultFailed, time.Time{})
+		app.logger.Error("handler failed", zap.Error(err),
+			zap.String("event_type", msgBody.EventType),
+			zap.String("message_body", *msg.Body))
+		return
+	}
+	c.observe(msgBody.EventType, metrics.ResultHandled, msgBody.OccurredAt)
+
+	if err := c.delete(msg); err != nil {
+		app.logger.Error("failed to delete msg after successful processing", zap.Error(err))
+	}
+}
+
+// observe counts a received event by result of handling and observes its lag when the time it occurred at
+// is known. Types of events without handlers are not labeled by their values, so they can't flood metrics.
+func (c *brokerConsumer) observe(eventType, result string, occurredAt time.Time) {
+	c.app.metrics.EventsCounter.WithLabelValues(eventType, result).Inc()
+
+	if !occurredAt.IsZero() {
+		c.app.metrics.EventLagHistogram.WithLabelValues(eventType).Observe(time.Since(occurredAt).Seconds())
+	}
+}
+
+// delete deletes a message from the queue.
+func (c *brokerConsumer) delete(msg types.Message) error {
+	delCtx, delCancel := context.WithTimeout(context.Background(), c.app.broker.Load().DeleteTimeout)
+	defer delCancel()
+
+	_, err := c.app.sqsClient.DeleteMessage(delCtx, &sqs.DeleteMessageInput{
+		QueueUrl:      &c.queueURL,
+		ReceiptHandle: msg.ReceiptHandle,
+	})
+
+	return err
 }
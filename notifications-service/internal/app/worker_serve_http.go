@@ -1,20 +1,17 @@
 package app
 
 import (
-	"context"
-	"errors"
-	"net/http"
-
+	"github.com/at-kh/guru-apps-test-services/platform/lifecycle"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/compress"
 	"github.com/gofiber/fiber/v2/middleware/favicon"
 	"github.com/gofiber/fiber/v2/middleware/recover"
 	"github.com/gofiber/fiber/v2/middleware/requestid"
-	"go.uber.org/zap"
 )
 
-// serveHTTP serves HTTP.
-func serveHTTP(ctx context.Context, app *App) {
+// serveHTTP serves HTTP, on stop the server stops accepting connections and drains in-flight requests
+// until the graceful timeout.
+func serveHTTP(app *App) lifecycle.Component {
 	router := fiber.New(fiber.Config{
 		Prefork:                  false,
 		ReadTimeout:              app.cfg.Delivery.HTTPServer.ReadTimeout,
@@ -24,33 +21,18 @@ func serveHTTP(ctx context.Context, app *App) {
 		AppName:                  app.meta.Info.Name,
 		EnableTrustedProxyCheck:  true,
 		EnableSplittingOnParsers: true,
+		DisableStartupMessage:    true,
+		ErrorHandler:             app.responder.HandleError,
 	})
 
-	app.registerHTTPRoutes(router)
-
-	// Middlewares
+	// Middlewares, registered before routes to run for them
 	router.Use(compress.New(compress.Config{Level: compress.LevelBestSpeed}))
 	router.Use(requestid.New())
 	router.Use(recover.New())
 	router.Use(favicon.New())
 
-	go func() {
-		<-ctx.Done()
-		app.logger.Info("HTTP server: initiating graceful shutdown")
-
-		sdCtx, cancel := context.WithTimeout(context.Background(), app.cfg.Delivery.HTTPServer.GracefulTimeout)
-		defer cancel()
-
-		if err := router.Shutdown(); err != nil {
-			app.logger.Error("HTTP server shutdown error", zap.Error(err))
-		}
-
-		<-sdCtx.Done()
-		app.logger.Info("HTTP server stopped gracefully")
-	}()
+	app.registerHTTPRoutes(router)
 
-	err := router.Listen(app.cfg.Delivery.HTTPServer.ListenAddress)
-	if err != nil && !errors.Is(err, http.ErrServerClosed) {
-		app.logger.Fatal("failed to start HTTP server", zap.Error(err))
-	}
+	cfg := app.cfg.Delivery.HTTPServer
+	return lifecycle.HTTPServer(router, cfg.ListenAddress, cfg.GracefulTimeout, app.logger)
 }
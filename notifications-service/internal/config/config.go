@@ -12,7 +12,29 @@ const DefaultPath = "./cmd/config.yaml"
 type (
 	// Config defines the properties of the application configuration.
 	Config struct {
-		Delivery Delivery `yaml:"delivery" valid:"check,deep"`
+		Delivery  Delivery  `yaml:"delivery"  valid:"check,deep"`
+		Logger    Logger    `yaml:"logger"    valid:"check,deep"`
+		Reload    Reload    `yaml:"reload"`
+		Lifecycle Lifecycle `yaml:"lifecycle" valid:"check,deep"`
+	}
+
+	// Logger defines the logger section of the application configuration.
+	Logger struct {
+		Level string `yaml:"level" valid:"required" reload:"live"` // debug, info, warn or error
+	}
+
+	// Reload defines reloads of the configuration without restart, on SIGHUP or changes of files.
+	Reload struct {
+		WatchInterval time.Duration `yaml:"watch-interval"` // interval of checks of files, 0 disables them
+	}
+
+	// Lifecycle defines startup and shutdown of the application, the HTTP server drains requests by its graceful
+	// timeout and handlers of messages finish by the graceful timeout of the broker.
+	Lifecycle struct {
+		// time between readiness turns false and the HTTP server stops accepting, so load balancers stop routing
+		ReadinessDelay time.Duration `yaml:"readiness-delay"`
+		// deadline of stopping other components, e.g. polling of the queue
+		StopTimeout time.Duration `yaml:"stop-timeout" valid:"required"`
 	}
 
 	// Delivery defines API server configuration.
@@ -28,17 +50,21 @@ type (
 		WriteTimeout       time.Duration `yaml:"write-timeout"   valid:"required"`
 		GracefulTimeout    time.Duration `yaml:"graceful-timeout" valid:"required"`
 		BodySizeLimitBytes int           `yaml:"body-size-limit" valid:"required"`
+		AdminToken         string        `yaml:"admin-token" secret:"true"` // bearer token of /admin, disabled when empty
+		ErrorFormat        string        `yaml:"error-format"`              // problem or legacy, problem by default
 	}
 
 	// Broker defines the message queue section of the API server configuration.
 	Broker struct {
 		URL    string `valid:"check,deep"`
 		Region string `valid:"check,deep"`
+		DLQURL string `yaml:"dlq-url"` // URL of the dead-letter queue, its admin endpoints are disabled when empty
 
-		RetryDelay          time.Duration `yaml:"retry-delay" valid:"required"`
-		DeleteTimeout       time.Duration `yaml:"delete-timeout" valid:"required"`
-		HandlerTimeout      time.Duration `yaml:"handler-timeout" valid:"required"`
-		MaxNumberOfMessages int32         `yaml:"max-number-of-messages" valid:"required"`
-		WaitTimeSeconds     int32         `yaml:"wait-time-seconds" valid:"required"`
+		RetryDelay          time.Duration `yaml:"retry-delay"            valid:"required" reload:"live"`
+		DeleteTimeout       time.Duration `yaml:"delete-timeout"         valid:"required" reload:"live"`
+		HandlerTimeout      time.Duration `yaml:"handler-timeout"        valid:"required" reload:"live"`
+		GracefulTimeout     time.Duration `yaml:"graceful-timeout"       valid:"required"` // deadline of handlers on stop
+		MaxNumberOfMessages int32         `yaml:"max-number-of-messages" valid:"required" reload:"live"`
+		WaitTimeSeconds     int32         `yaml:"wait-time-seconds"      valid:"required" reload:"live"`
 	}
 )
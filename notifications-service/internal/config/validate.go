@@ -8,6 +8,32 @@ func (c Config) Validate() []string {
 	if e := c.Delivery.Validate(); len(e) > 0 {
 		errs = append(errs, e...)
 	}
+	if e := c.Logger.Validate(); len(e) > 0 {
+		errs = append(errs, e...)
+	}
+	if e := c.Lifecycle.Validate(); len(e) > 0 {
+		errs = append(errs, e...)
+	}
+
+	return errs
+}
+
+// Validate validates struct accordingly to fields tags
+func (l Logger) Validate() []string {
+	var errs []string
+	if l.Level == "" {
+		errs = append(errs, "level::is_required")
+	}
+
+	return errs
+}
+
+// Validate validates struct accordingly to fields tags
+func (l Lifecycle) Validate() []string {
+	var errs []string
+	if l.StopTimeout == 0 {
+		errs = append(errs, "stop_timeout::is_required")
+	}
 
 	return errs
 }
@@ -59,6 +85,9 @@ func (b Broker) Validate() []string {
 	if b.HandlerTimeout == 0 {
 		errs = append(errs, "handler_timeout::is_required")
 	}
+	if b.GracefulTimeout == 0 {
+		errs = append(errs, "graceful_timeout::is_required")
+	}
 	if b.MaxNumberOfMessages == 0 {
 		errs = append(errs, "max_number_of_messages::is_required")
 	}
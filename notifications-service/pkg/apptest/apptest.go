@@ -0,0 +1,153 @@
+// Package apptest starts the notifications-service application in-process for tests of other packages and
+// modules, e.g. end-to-end tests of the services together:
+//
+//	srv, err := apptest.Start(apptest.Options{QueueURL: queueURL, Logger: logger})
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	defer srv.Close()
+//
+// Messages are consumed from QueueURL, which may be served by a fake SQS server. Notifications are logged,
+// so tests observe them by the logger.
+package apptest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/app"
+	"github.com/at-kh/guru-apps-test-services/notifications-service/internal/config"
+	"github.com/at-kh/guru-apps-test-services/platform/health"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+// StartTimeout - deadline of the application to become ready.
+const StartTimeout = 10 * time.Second
+
+type (
+	// Options - options of the started application.
+	Options struct {
+		QueueURL   string      // URL of the SQS queue of notifications, e.g. http://127.0.0.1:4566/000000000000/queue
+		DLQURL     string      // URL of the dead-letter queue of QueueURL, optional
+		AdminToken string      // bearer token of the admin API, it is disabled when empty
+		Logger     *zap.Logger // zap.NewNop when nil
+	}
+
+	// Server - the application running in background.
+	Server struct {
+		URL string // base URL of the HTTP API, e.g. http://127.0.0.1:10001
+
+		cancel context.CancelFunc
+		done   chan error
+	}
+)
+
+// init sets process-wide settings of the application before tests start goroutines reading them.
+func init() { app.SetGlobals() }
+
+// Start starts the application on a random local port and waits until it is ready. The application exits
+// the process on fatal errors of initialization, e.g. when the queue doesn't exist.
+func Start(opts Options) (*Server, error) {
+	if opts.Logger == nil {
+		opts.Logger = zap.NewNop()
+	}
+
+	httpAddress, err := freeAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	a := app.New(app.Meta{Info: health.Info{Name: "notifications-service"}}).
+		WithConfig(newConfig(httpAddress, opts)).
+		WithLogger(opts.Logger).
+		WithMetricsRegistry(prometheus.NewRegistry())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &Server{
+		URL:    "http://" + httpAddress,
+		cancel: cancel,
+		done:   make(chan error, 1),
+	}
+
+	go func() { s.done <- a.Run(ctx) }()
+
+	if err = s.waitReady(); err != nil {
+		return nil, errors.Join(err, s.Close())
+	}
+
+	return s, nil
+}
+
+// Close stops the application and waits until it is stopped, messages being handled are handled first.
+func (s *Server) Close() error {
+	s.cancel()
+	return <-s.done
+}
+
+// waitReady polls the readiness endpoint until it responds 200 or the application fails.
+func (s *Server) waitReady() error {
+	deadline := time.After(StartTimeout)
+	for {
+		resp, err := http.Get(s.URL + "/notifications-api/v1/ready")
+		if err == nil {
+			_ = resp.Body.Close()
+			if resp.StatusCode == http.StatusOK {
+				return nil
+			}
+		}
+
+		select {
+		case err = <-s.done:
+			s.done <- err
+			return fmt.Errorf("application stopped before it was ready: %w", err)
+		case <-deadline:
+			return errors.New("application is not ready after " + StartTimeout.String())
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+// newConfig returns the configuration of the application listening on the address. Queues are polled by short
+// waits, so the application stops fast.
+func newConfig(httpAddress string, opts Options) *config.Config {
+	return &config.Config{
+		Delivery: config.Delivery{
+			HTTPServer: config.HTTPServer{
+				ListenAddress:      httpAddress,
+				ReadTimeout:        10 * time.Second,
+				WriteTimeout:       10 * time.Second,
+				GracefulTimeout:    5 * time.Second,
+				BodySizeLimitBytes: 1 << 20,
+				AdminToken:         opts.AdminToken,
+			},
+			Broker: config.Broker{
+				URL:                 opts.QueueURL,
+				DLQURL:              opts.DLQURL,
+				Region:              "us-east-1",
+				RetryDelay:          100 * time.Millisecond,
+				DeleteTimeout:       5 * time.Second,
+				HandlerTimeout:      5 * time.Second,
+				GracefulTimeout:     5 * time.Second,
+				MaxNumberOfMessages: 10,
+				WaitTimeSeconds:     1,
+			},
+		},
+		Lifecycle: config.Lifecycle{StopTimeout: 5 * time.Second},
+	}
+}
+
+// freeAddress returns a local address with a port which is free at the moment.
+func freeAddress() (string, error) {
+	l, err := net.Listen("tcp4", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+	defer l.Close()
+
+	return l.Addr().String(), nil
+}
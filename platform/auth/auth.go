@@ -0,0 +1,37 @@
+// Package auth authorizes requests of administrative APIs.
+package auth
+
+import (
+	"crypto/subtle"
+	"strings"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/gofiber/fiber/v2"
+)
+
+// bearerPrefix - the scheme of the Authorization header carrying a token.
+const bearerPrefix = "Bearer "
+
+// BearerToken returns a middleware allowing requests with the token in the Authorization header, e.g.
+// "Authorization: Bearer <token>". All requests are forbidden when the token is empty, so administrative APIs
+// are disabled until their token is configured.
+func BearerToken(token string) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		if token == "" {
+			return errs.Forbidden{Cause: "admin API is disabled, its token is not configured"}
+		}
+
+		header := ctx.Get(fiber.HeaderAuthorization)
+		if len(header) < len(bearerPrefix) || !strings.EqualFold(header[:len(bearerPrefix)], bearerPrefix) {
+			ctx.Set(fiber.HeaderWWWAuthenticate, "Bearer")
+			return errs.Unauthorized{Cause: "bearer token is required"}
+		}
+
+		if subtle.ConstantTimeCompare([]byte(header[len(bearerPrefix):]), []byte(token)) != 1 {
+			ctx.Set(fiber.HeaderWWWAuthenticate, `Bearer error="invalid_token"`)
+			return errs.Unauthorized{Cause: "invalid bearer token"}
+		}
+
+		return ctx.Next()
+	}
+}
@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/at-kh/guru-apps-test-services/platform/responder"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBearerToken(t *testing.T) {
+	tests := []struct {
+		name          string
+		token         string
+		authorization string
+		wantStatus    int
+		wantBody      string
+	}{
+		{
+			name:          "valid token",
+			token:         "secret",
+			authorization: "Bearer secret",
+			wantStatus:    fiber.StatusOK,
+			wantBody:      "ok",
+		},
+		{
+			name:          "scheme is case insensitive",
+			token:         "secret",
+			authorization: "bearer secret",
+			wantStatus:    fiber.StatusOK,
+			wantBody:      "ok",
+		},
+		{
+			name:       "missing header",
+			token:      "secret",
+			wantStatus: fiber.StatusUnauthorized,
+			wantBody:   "bearer token is required",
+		},
+		{
+			name:          "other scheme",
+			token:         "secret",
+			authorization: "Basic c2VjcmV0",
+			wantStatus:    fiber.StatusUnauthorized,
+			wantBody:      "bearer token is required",
+		},
+		{
+			name:          "invalid token",
+			token:         "secret",
+			authorization: "Bearer secret2",
+			wantStatus:    fiber.StatusUnauthorized,
+			wantBody:      "invalid bearer token",
+		},
+		{
+			name:          "token is not configured",
+			authorization: "Bearer ",
+			wantStatus:    fiber.StatusForbidden,
+			wantBody:      "admin API is disabled",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New(fiber.Config{ErrorHandler: responder.New().HandleError})
+			app.Get("/admin", BearerToken(tt.token), func(ctx *fiber.Ctx) error { return ctx.SendString("ok") })
+
+			req := httptest.NewRequest(fiber.MethodGet, "/admin", nil)
+			if tt.authorization != "" {
+				req.Header.Set(fiber.HeaderAuthorization, tt.authorization)
+			}
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+			defer func() { _ = resp.Body.Close() }()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+			assert.Contains(t, string(body), tt.wantBody)
+			if tt.wantStatus == fiber.StatusUnauthorized {
+				assert.Contains(t, resp.Header.Get(fiber.HeaderWWWAuthenticate), "Bearer")
+			}
+		})
+	}
+}
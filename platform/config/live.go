@@ -0,0 +1,90 @@
+package config
+
+import (
+	"net/url"
+	"reflect"
+	"regexp"
+)
+
+// redactedValue - replacement of redacted secrets.
+const redactedValue = "xxxxx"
+
+// dsnPasswordRe - password of a keyword/value DSN, e.g. host=psql password=root.
+var dsnPasswordRe = regexp.MustCompile(`(password\s*=\s*)('[^']*'|\S+)`)
+
+// MergeLive returns a copy of current with fields tagged reload:"live" taken from loaded, they are safe to
+// change without restart. Returns paths of changed fields which are applied and of changed fields which
+// are rejected as they need restart, e.g. storage.postgres.dsn.
+func MergeLive[T any](current, loaded *T) (merged *T, applied, rejected []string) {
+	next := *current
+
+	loadedFields := make(map[string]reflect.Value)
+	WalkFields(reflect.ValueOf(loaded).Elem(), "", func(path string, field reflect.Value, _ reflect.StructField) {
+		loadedFields[path] = field
+	})
+
+	WalkFields(reflect.ValueOf(&next).Elem(), "", func(path string, field reflect.Value, sf reflect.StructField) {
+		value := loadedFields[path]
+		if reflect.DeepEqual(field.Interface(), value.Interface()) {
+			return
+		}
+
+		if sf.Tag.Get("reload") != "live" {
+			rejected = append(rejected, path)
+			return
+		}
+
+		field.Set(value)
+		applied = append(applied, path)
+	})
+
+	return &next, applied, rejected
+}
+
+// Redact returns a copy of cfg, a struct, with passwords of fields tagged secret:"true" replaced, so it can
+// be printed or logged.
+func Redact[T any](cfg T) T {
+	redacted := cfg
+	WalkFields(reflect.ValueOf(&redacted).Elem(), "", func(_ string, field reflect.Value, sf reflect.StructField) {
+		if sf.Tag.Get("secret") != "true" {
+			return
+		}
+
+		switch field.Kind() {
+		case reflect.String:
+			field.SetString(redact(field.String()))
+		case reflect.Slice:
+			if field.Type().Elem().Kind() != reflect.String {
+				return
+			}
+
+			// the copy is made of the type of the field, as fields and their items may be of named types,
+			// e.g. []DSN, which []string can't be assigned or converted to
+			items := reflect.MakeSlice(field.Type(), field.Len(), field.Len())
+			for i := range field.Len() {
+				items.Index(i).SetString(redact(field.Index(i).String()))
+			}
+			field.Set(items)
+		default:
+		}
+	})
+
+	return redacted
+}
+
+// redact replaces password of a URL or keyword/value DSN, other values are replaced entirely.
+func redact(value string) string {
+	if u, err := url.Parse(value); err == nil && u.Scheme != "" && u.Host != "" {
+		return u.Redacted()
+	}
+
+	if dsnPasswordRe.MatchString(value) {
+		return dsnPasswordRe.ReplaceAllString(value, "${1}"+redactedValue)
+	}
+
+	if value == "" {
+		return ""
+	}
+
+	return redactedValue
+}
@@ -0,0 +1,67 @@
+// Package config loads configurations of applications from YAML files and env.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Paths - defines paths of configuration files set by repeated flags, e.g. -c base.yaml -c prod.yaml.
+type Paths []string
+
+// String returns paths separated by commas.
+func (p *Paths) String() string { return strings.Join(*p, ",") }
+
+// Set adds a path.
+func (p *Paths) Set(path string) error {
+	*p = append(*p, path)
+	return nil
+}
+
+// Load - load configuration into dest, a pointer to a struct, from yaml files and env. Files are merged in order,
+// so later files overlay keys they set, e.g. an environment overlay over the base file. Fields are overridden
+// by env afterwards, see OverrideEnv.
+func Load(dest any, aliases map[string]string, paths ...string) error {
+	for _, path := range paths {
+		if err := LoadYAML(path, dest); err != nil {
+			return fmt.Errorf("failed to load yaml: %w", err)
+		}
+	}
+
+	if err := OverrideEnv(dest, aliases); err != nil {
+		return fmt.Errorf("failed to load env: %w", err)
+	}
+
+	return nil
+}
+
+// LoadYAML - load config from yaml, keys which are not in the file keep their values.
+func LoadYAML(path string, dest any) error {
+	if reflect.TypeOf(dest).Kind() != reflect.Ptr {
+		return errors.New("config destination must be pointer")
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("configuration file not found: %s", path)
+		}
+
+		return fmt.Errorf("failed to read configuration file %s: %w", path, err)
+	}
+
+	if len(data) == 0 {
+		return fmt.Errorf("configuration file is empty: %s", path)
+	}
+
+	if err = yaml.Unmarshal(data, dest); err != nil {
+		return fmt.Errorf("failed to parse YAML configuration file %s: %w", path, err)
+	}
+
+	return nil
+}
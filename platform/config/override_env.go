@@ -0,0 +1,137 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+)
+
+// listSeparator - separator of items of lists set by env, e.g. DSNs which may contain commas.
+const listSeparator = ";"
+
+// OverrideEnv - override fields of dest, a pointer to a struct, from env and the .env file. Names of env are
+// derived from yaml keys of fields, e.g. STORAGE_POSTGRES_CONN_MAX_OPEN_NUM, values may be read from files named
+// by env with the _FILE suffix, e.g. STORAGE_POSTGRES_DSN_FILE, so secrets are not kept in env. aliases are short
+// names of env kept for compatibility by derived names, e.g. DB_DSN of STORAGE_POSTGRES_DSN, derived names take
+// precedence over them.
+func OverrideEnv(dest any, aliases map[string]string) error {
+	v := reflect.ValueOf(dest)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return errors.New("config destination must be pointer to struct")
+	}
+
+	_ = godotenv.Load()
+
+	var errs []error
+	WalkFields(v.Elem(), "", func(path string, field reflect.Value, _ reflect.StructField) {
+		env := EnvName(path)
+		value, ok, err := lookupEnv(env, aliases[env])
+		if err == nil && ok {
+			err = setField(field, value)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", env, err))
+		}
+	})
+
+	return errors.Join(errs...)
+}
+
+// WalkFields calls fn for fields of v which are not structs with their paths of yaml keys, e.g.
+// storage.postgres.dsn, keys of fields without yaml tags are their lower-cased names.
+func WalkFields(v reflect.Value, prefix string, fn func(path string, field reflect.Value, sf reflect.StructField)) {
+	for i := range v.NumField() {
+		sf := v.Type().Field(i)
+
+		key, _, _ := strings.Cut(sf.Tag.Get("yaml"), ",")
+		if key == "-" {
+			continue
+		}
+		if key == "" {
+			key = strings.ToLower(sf.Name)
+		}
+
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+
+		if field := v.Field(i); field.Kind() == reflect.Struct {
+			WalkFields(field, path, fn)
+		} else {
+			fn(path, field, sf)
+		}
+	}
+}
+
+// EnvName returns name of env overriding a field by its path, e.g. STORAGE_POSTGRES_DSN.
+func EnvName(path string) string {
+	return strings.ToUpper(strings.NewReplacer(".", "_", "-", "_").Replace(path))
+}
+
+// lookupEnv returns value of a field set by env, by env with the _FILE suffix or by alias of env when it is
+// not empty. Empty values are ignored.
+func lookupEnv(env, alias string) (string, bool, error) {
+	names := []string{env}
+	if alias != "" {
+		names = append(names, alias)
+	}
+
+	for _, name := range names {
+		value, path := os.Getenv(name), os.Getenv(name+"_FILE")
+
+		switch {
+		case value != "" && path != "":
+			return "", false, fmt.Errorf("both %s and %s_FILE are set", name, name)
+		case value != "":
+			return value, true, nil
+		case path != "":
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return "", false, fmt.Errorf("failed to read %s_FILE: %w", name, err)
+			}
+
+			return strings.TrimRight(string(data), "\r\n"), true, nil
+		}
+	}
+
+	return "", false, nil
+}
+
+// setField parses value by the type of field and sets it.
+func setField(field reflect.Value, value string) error {
+	switch {
+	case field.Type() == reflect.TypeFor[time.Duration]():
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(d))
+	case field.Kind() == reflect.String:
+		field.SetString(value)
+	case field.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case field.CanInt():
+		n, err := strconv.ParseInt(value, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(n)
+	case field.Kind() == reflect.Slice && field.Type().Elem().Kind() == reflect.String:
+		field.Set(reflect.ValueOf(strings.Split(value, listSeparator)).Convert(field.Type()))
+	default:
+		return fmt.Errorf("unsupported type %s", field.Type())
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package errs
+
+import (
+	"net/http"
+	"strings"
+)
+
+const (
+	errEmpty      = "empty"
+	errValidation = "validation_error"
+
+	divider      = " - "
+	separator    = ","
+	fieldDivider = "::"
+)
+
+// HTTPError - interface for HTTP errors
+type HTTPError interface {
+	error
+	StatusCode() int
+}
+
+// Custom errors
+type (
+	// Empty - an error that indicates that nothing was found by the specified search parameters.
+	Empty struct {
+		What string `json:"what"`
+	}
+
+	// AlreadyExists - describes a conflict of a created entity with an existing one, e.g. a duplicate name,
+	// it is a Conflict for clients telling duplicates from other conflicts.
+	AlreadyExists struct {
+		What string `json:"what"`
+	}
+
+	// FieldsValidation - describes situation for bad request with text.
+	FieldsValidation struct {
+		Errors []string `json:"errors"`
+	}
+
+	// FieldError - describes an invalid field: price::max_value_is::1000000 is
+	// {Field: "price", Code: "max_value_is", Params: ["1000000"]}.
+	FieldError struct {
+		Field  string   `json:"field"`
+		Code   string   `json:"code"`
+		Params []string `json:"params,omitempty"`
+	}
+)
+
+// NewEmpty - error for empty.
+func NewEmpty(what string) Empty { return Empty{What: what} }
+
+// Error implements error interface.
+func (e Empty) Error() string {
+	if e.What != "" {
+		return format(errEmpty, e.What)
+	}
+
+	return errEmpty
+}
+
+// StatusCode implements error interface.
+func (e Empty) StatusCode() int { return http.StatusNotFound }
+
+// NewAlreadyExists - error for already existing entity.
+func NewAlreadyExists(what string) AlreadyExists { return AlreadyExists{What: what} }
+
+// Error implements error interface.
+func (e AlreadyExists) Error() string { return Conflict(e).Error() }
+
+// StatusCode implements error interface.
+func (e AlreadyExists) StatusCode() int { return http.StatusConflict }
+
+// Unwrap returns the error as Conflict, so it is handled as other conflicts.
+func (e AlreadyExists) Unwrap() error { return Conflict(e) }
+
+// NewFieldsValidation - error for fields validation.
+func NewFieldsValidation(errors []string) FieldsValidation {
+	return FieldsValidation{Errors: errors}
+}
+
+// Error implements error interface.
+func (e FieldsValidation) Error() string {
+	if len(e.Errors) == 0 {
+		return errValidation
+	}
+
+	var sb strings.Builder
+	sb.WriteString(errValidation)
+	sb.WriteString(divider)
+	sb.WriteString(strings.Join(e.Errors, separator))
+
+	return sb.String()
+}
+
+// StatusCode implements error interface.
+func (e FieldsValidation) StatusCode() int { return http.StatusBadRequest }
+
+// Fields returns validation errors as structured field errors.
+func (e FieldsValidation) Fields() []FieldError {
+	fields := make([]FieldError, 0, len(e.Errors))
+	for _, msg := range e.Errors {
+		parts := strings.Split(msg, fieldDivider)
+
+		field := FieldError{Field: parts[0]}
+		if len(parts) > 1 {
+			field.Code = parts[1]
+			field.Params = parts[2:]
+		}
+		if len(field.Params) == 0 {
+			field.Params = nil
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields
+}
+
+// String returns field error in the format of FieldsValidation errors.
+func (e FieldError) String() string {
+	if e.Code == "" {
+		return e.Field
+	}
+
+	return strings.Join(append([]string{e.Field, e.Code}, e.Params...), fieldDivider)
+}
+
+// format formats error
+func format(messages ...string) string { return strings.Join(messages, divider) }
@@ -2,6 +2,7 @@ package errs
 
 import (
 	"errors"
+	"reflect"
 	"testing"
 )
 
@@ -10,6 +11,7 @@ func TestErrorsImplementHTTPError(t *testing.T) {
 	types := []HTTPError{
 		// Custom errors
 		Empty{},
+		AlreadyExists{},
 		FieldsValidation{},
 
 		// Client errors
@@ -44,6 +46,20 @@ func TestErrorsImplementHTTPError(t *testing.T) {
 	}
 }
 
+// TestAlreadyExists_IsConflict tests that duplicates are responded and handled as other conflicts.
+func TestAlreadyExists_IsConflict(t *testing.T) {
+	err := error(AlreadyExists{What: "vendor already exists"})
+
+	if got, want := err.Error(), (Conflict{What: "vendor already exists"}).Error(); got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+
+	var conflict Conflict
+	if !errors.As(err, &conflict) || conflict.What != "vendor already exists" {
+		t.Errorf("errors.As(Conflict) = %v, want the conflict of the duplicate", conflict)
+	}
+}
+
 // TestInternalSrv_Error tests the Error method of the Internal struct.
 func TestInternalSrv_Error(t *testing.T) {
 	type fields struct {
@@ -126,3 +142,40 @@ func TestFieldsValidation_Error(t *testing.T) {
 		})
 	}
 }
+
+// TestFieldsValidation_Fields tests the Fields method of the FieldsValidation struct.
+func TestFieldsValidation_Fields(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  string
+		want FieldError
+	}{
+		{
+			name: "rule without params",
+			msg:  "name::is_required",
+			want: FieldError{Field: "name", Code: "is_required"},
+		},
+		{
+			name: "rule with param",
+			msg:  "price::max_value_is::1000000",
+			want: FieldError{Field: "price", Code: "max_value_is", Params: []string{"1000000"}},
+		},
+		{
+			name: "field only",
+			msg:  "vendor",
+			want: FieldError{Field: "vendor"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FieldsValidation{Errors: []string{tt.msg}}.Fields()
+			if !reflect.DeepEqual(got, []FieldError{tt.want}) {
+				t.Errorf("Fields() = %v, want %v", got, tt.want)
+			}
+			if got[0].String() != tt.msg {
+				t.Errorf("String() = %v, want %v", got[0].String(), tt.msg)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Package health serves liveness and readiness of applications.
+package health
+
+import (
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/gofiber/fiber/v2"
+)
+
+type (
+	// Info describes meta information about application.
+	Info struct {
+		Name         string
+		BuildCommit  string
+		BuildDate    string
+		BuildVersion string
+	}
+
+	// InfoResponse – describes a response for status event.
+	InfoResponse struct {
+		Name    string `json:"name"`
+		Commit  string `json:"commit,omitempty"`
+		Date    string `json:"date,omitempty"`
+		Version string `json:"version"`
+	}
+
+	// ReadinessResponse – describes a response of readiness of the application.
+	ReadinessResponse struct {
+		Status string `json:"status"`
+	}
+
+	// Handler defines a Handler for HTTP requests for checking status.
+	Handler struct {
+		infoResponse InfoResponse
+		ready        func() bool
+	}
+)
+
+// NewHandler defines a handler constructor, ready reports whether the application accepts requests.
+func NewHandler(info Info, ready func() bool) *Handler {
+	return &Handler{
+		ready: ready,
+		infoResponse: InfoResponse{
+			Name:    info.Name,
+			Commit:  info.BuildCommit,
+			Date:    info.BuildDate,
+			Version: info.BuildVersion,
+		},
+	}
+}
+
+// Health - handler for getting meta info endpoint.
+func (h Handler) Health(ctx *fiber.Ctx) error { return ctx.JSON(h.infoResponse) }
+
+// Ready - handler for readiness endpoint, it fails while the application starts or shuts down.
+func (h Handler) Ready(ctx *fiber.Ctx) error {
+	if !h.ready() {
+		return errs.ServiceUnavailable{Cause: "application is not ready"}
+	}
+
+	return ctx.JSON(ReadinessResponse{Status: "ready"})
+}
@@ -0,0 +1,46 @@
+package health
+
+import (
+	"io"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/at-kh/guru-apps-test-services/platform/responder"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	var ready atomic.Bool
+	h := NewHandler(Info{Name: "test-service", BuildCommit: "abc123", BuildVersion: "1.2.3"}, ready.Load)
+
+	app := fiber.New(fiber.Config{ErrorHandler: responder.New().HandleError})
+	app.Get("/health", h.Health)
+	app.Get("/ready", h.Ready)
+
+	get := func(path string) (int, string) {
+		resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, path, nil))
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+
+		return resp.StatusCode, string(body)
+	}
+
+	status, body := get("/health")
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.JSONEq(t, `{"name":"test-service","commit":"abc123","version":"1.2.3"}`, body)
+
+	status, body = get("/ready")
+	assert.Equal(t, fiber.StatusServiceUnavailable, status)
+	assert.Contains(t, body, "application is not ready")
+
+	ready.Store(true)
+	status, body = get("/ready")
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.JSONEq(t, `{"status":"ready"}`, body)
+}
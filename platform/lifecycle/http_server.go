@@ -0,0 +1,31 @@
+package lifecycle
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// HTTPServer returns the component serving router at address, on stop the server stops accepting connections
+// and drains in-flight requests until gracefulTimeout.
+func HTTPServer(router *fiber.App, address string, gracefulTimeout time.Duration, logger *zap.Logger) Component {
+	var listener net.Listener
+
+	return Component{
+		Name: "HTTP server",
+		Start: func(context.Context) (err error) {
+			if listener, err = net.Listen(fiber.NetworkTCP4, address); err != nil {
+				return err
+			}
+
+			logger.Info("HTTP server started", zap.String("address", address))
+			return nil
+		},
+		Run:         func(context.Context) error { return router.Listener(listener) },
+		Stop:        router.ShutdownWithContext,
+		StopTimeout: gracefulTimeout,
+	}
+}
@@ -0,0 +1,67 @@
+package lifecycle
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// TestHTTPServer tests that the server drains in-flight requests on stop.
+func TestHTTPServer(t *testing.T) {
+	free, err := net.Listen(fiber.NetworkTCP4, "127.0.0.1:0")
+	require.NoError(t, err)
+	address := free.Addr().String()
+	require.NoError(t, free.Close())
+
+	received, release := make(chan struct{}), make(chan struct{})
+	router := fiber.New(fiber.Config{DisableStartupMessage: true})
+	router.Get("/slow", func(ctx *fiber.Ctx) error {
+		close(received)
+		<-release
+		return ctx.SendString("done")
+	})
+
+	m := New(0, zap.NewNop())
+	m.Add(HTTPServer(router, address, time.Second, zap.NewNop()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- m.Run(ctx) }()
+	require.Eventually(t, m.Ready, time.Second, 10*time.Millisecond)
+
+	type result struct {
+		status int
+		body   string
+		err    error
+	}
+	responses := make(chan result)
+	go func() {
+		resp, reqErr := http.Get("http://" + address + "/slow")
+		if reqErr != nil {
+			responses <- result{err: reqErr}
+			return
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		responses <- result{status: resp.StatusCode, body: string(body)}
+	}()
+
+	<-received
+	cancel()
+	time.Sleep(50 * time.Millisecond) // the server stops accepting while the request is in flight
+	close(release)
+
+	resp := <-responses
+	require.NoError(t, resp.err)
+	assert.Equal(t, http.StatusOK, resp.status)
+	assert.Equal(t, "done", resp.body)
+	require.NoError(t, <-done)
+}
@@ -0,0 +1,221 @@
+// Package lifecycle starts components of an application in dependency order and stops them in reverse order,
+// every component is stopped by its own deadline.
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// DefaultStopTimeout - deadline of stopping a component without StopTimeout.
+const DefaultStopTimeout = 10 * time.Second
+
+type (
+	// Component - a part of the application managed by Manager, all functions are optional.
+	Component struct {
+		Name string
+		// Start starts the component and returns once it is started, e.g. opens a listener.
+		Start func(ctx context.Context) error
+		// Run serves until its ctx is canceled or Stop is called, e.g. accepts connections of the listener.
+		// An error returned before shutdown stops the application.
+		Run func(ctx context.Context) error
+		// Unready is called when readiness turns false before the readiness delay, e.g. health checks report
+		// the component is not serving, so clients stop sending requests before it is stopped.
+		Unready func()
+		// Stop stops the component gracefully until ctx is done, ctx of Run is canceled after Stop returns.
+		Stop func(ctx context.Context) error
+		// StopTimeout - deadline of Stop and of waiting for Run to return, DefaultStopTimeout when zero.
+		StopTimeout time.Duration
+	}
+
+	// Manager - starts components in the order they are added, reports readiness once all of them are started
+	// and stops them in reverse order when the application shuts down.
+	Manager struct {
+		components     []Component
+		ready          atomic.Bool
+		readinessDelay time.Duration
+		logger         *zap.Logger
+	}
+
+	// running - state of a started component.
+	running struct {
+		Component
+		cancel context.CancelFunc
+		done   chan struct{}
+	}
+)
+
+// New - constructor of Manager, readinessDelay is time between readiness turns false and the first component
+// is stopped, so load balancers stop routing requests before listeners are closed.
+func New(readinessDelay time.Duration, logger *zap.Logger) *Manager {
+	return &Manager{
+		readinessDelay: readinessDelay,
+		logger:         logger.With(zap.String("component", "lifecycle")),
+	}
+}
+
+// Add adds components, they are started after components added before them and stopped before them.
+func (m *Manager) Add(components ...Component) {
+	m.components = append(m.components, components...)
+}
+
+// Ready reports whether all components are started and the application doesn't shut down.
+func (m *Manager) Ready() bool { return m.ready.Load() }
+
+// Run starts components and blocks until ctx is done or a component fails, then stops started components.
+// It returns errors of starting, running and stopping components.
+func (m *Manager) Run(ctx context.Context) error {
+	started := make([]*running, 0, len(m.components))
+	failed := make(chan error, len(m.components))
+
+	var startErr error
+	for _, c := range m.components {
+		if startErr = ctx.Err(); startErr != nil {
+			break
+		}
+
+		r, err := m.start(ctx, c, failed)
+		if err != nil {
+			startErr = fmt.Errorf("failed to start %s: %w", c.Name, err)
+			break
+		}
+		started = append(started, r)
+	}
+
+	var runErr error
+	if startErr == nil {
+		m.ready.Store(true)
+		m.logger.Info("application started", zap.Int("components", len(started)))
+
+		select {
+		case <-ctx.Done():
+			m.logger.Info("shutting down application")
+		case runErr = <-failed:
+			m.logger.Error("component failed, shutting down application", zap.Error(runErr))
+		}
+	}
+
+	m.ready.Store(false)
+	for _, r := range started {
+		if r.Unready != nil {
+			r.Unready()
+		}
+	}
+	if startErr == nil && m.readinessDelay > 0 {
+		m.logger.Info("application is not ready, waiting for readiness delay",
+			zap.Duration("delay", m.readinessDelay))
+		time.Sleep(m.readinessDelay)
+	}
+
+	errs := []error{startErr, runErr}
+	for i := len(started) - 1; i >= 0; i-- {
+		errs = append(errs, m.stop(started[i]))
+	}
+
+	return errors.Join(errs...)
+}
+
+// start starts a component and its Run, errors of Run before the component is stopped are sent to failed.
+func (m *Manager) start(ctx context.Context, c Component, failed chan<- error) (*running, error) {
+	if c.Start != nil {
+		if err := c.Start(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	// ctx of Run is not canceled by ctx of the application, components are stopped in order by stop
+	runCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	r := &running{Component: c, cancel: cancel, done: make(chan struct{})}
+
+	if c.Run == nil {
+		close(r.done)
+	} else {
+		go func() {
+			defer close(r.done)
+
+			if err := c.Run(runCtx); err != nil && runCtx.Err() == nil {
+				failed <- fmt.Errorf("%s: %w", c.Name, err)
+			}
+		}()
+	}
+
+	m.logger.Debug("component started", zap.String("name", c.Name))
+
+	return r, nil
+}
+
+// stop stops a component and waits for its Run to return until the deadline of the component.
+func (m *Manager) stop(r *running) error {
+	timeout := r.StopTimeout
+	if timeout <= 0 {
+		timeout = DefaultStopTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	begin := time.Now()
+
+	// Stop is not waited for after the deadline, so a component ignoring ctx doesn't block the next ones
+	stopped := make(chan error, 1)
+	go func() {
+		if r.Stop == nil {
+			stopped <- nil
+			return
+		}
+		stopped <- r.Stop(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-stopped:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	r.cancel()
+
+	if err == nil {
+		select {
+		case <-r.done:
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+	}
+
+	if err != nil {
+		m.logger.Error("failed to stop component", zap.String("name", r.Name),
+			zap.Duration("duration", time.Since(begin)), zap.Error(err))
+		return fmt.Errorf("failed to stop %s: %w", r.Name, err)
+	}
+
+	m.logger.Info("component stopped", zap.String("name", r.Name), zap.Duration("duration", time.Since(begin)))
+
+	return nil
+}
+
+// WaitGroup - sync.WaitGroup which may be waited for until ctx is done.
+type WaitGroup struct {
+	sync.WaitGroup
+}
+
+// WaitContext waits for the group until ctx is done, it returns an error of ctx when the group is not done.
+func (wg *WaitGroup) WaitContext(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
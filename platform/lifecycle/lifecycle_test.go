@@ -0,0 +1,149 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// recorder records events of components in order.
+type recorder struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (r *recorder) add(event string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events = append(r.events, event)
+}
+
+func (r *recorder) get() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]string(nil), r.events...)
+}
+
+// component returns a component which records its events, its Run serves until ctx is canceled.
+func (r *recorder) component(name string, m *Manager) Component {
+	return Component{
+		Name:  name,
+		Start: func(context.Context) error { r.add("start " + name); return nil },
+		Run: func(ctx context.Context) error {
+			<-ctx.Done()
+			r.add("run " + name + " done")
+			return nil
+		},
+		Unready: func() { r.add("unready " + name + " ready=" + strconv.FormatBool(m.Ready())) },
+		Stop:    func(context.Context) error { r.add("stop " + name); return nil },
+	}
+}
+
+// TestManagerRun tests that components are started in order, readiness turns false first and components are
+// stopped in reverse order.
+func TestManagerRun(t *testing.T) {
+	rec := &recorder{}
+	m := New(0, zap.NewNop())
+	m.Add(rec.component("db", m), rec.component("http", m))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- m.Run(ctx) }()
+
+	require.Eventually(t, m.Ready, time.Second, time.Millisecond)
+	cancel()
+	require.NoError(t, <-done)
+
+	assert.False(t, m.Ready())
+	assert.Equal(t, []string{
+		"start db", "start http",
+		"unready db ready=false", "unready http ready=false",
+		"stop http", "run http done",
+		"stop db", "run db done",
+	}, rec.get())
+}
+
+// TestManagerRunErrors tests failures of components.
+func TestManagerRunErrors(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	t.Run("start", func(t *testing.T) {
+		rec := &recorder{}
+		m := New(time.Hour, zap.NewNop())
+		m.Add(rec.component("db", m), Component{
+			Name:  "http",
+			Start: func(context.Context) error { return errFailed },
+		}, rec.component("never", m))
+
+		err := m.Run(context.Background())
+		require.ErrorIs(t, err, errFailed)
+		assert.ErrorContains(t, err, "failed to start http")
+		assert.Equal(t, []string{"start db", "unready db ready=false", "stop db", "run db done"}, rec.get(),
+			"started components are stopped without the readiness delay")
+	})
+
+	t.Run("run", func(t *testing.T) {
+		rec := &recorder{}
+		m := New(0, zap.NewNop())
+		m.Add(rec.component("db", m), Component{
+			Name: "http",
+			Run:  func(context.Context) error { return errFailed },
+		})
+
+		err := m.Run(context.Background())
+		require.ErrorIs(t, err, errFailed)
+		assert.Equal(t, []string{"start db", "unready db ready=false", "stop db", "run db done"}, rec.get())
+	})
+
+	t.Run("stop deadline", func(t *testing.T) {
+		rec := &recorder{}
+		m := New(0, zap.NewNop())
+		m.Add(rec.component("db", m), Component{
+			Name:        "http",
+			Run:         func(context.Context) error { select {} },
+			StopTimeout: 10 * time.Millisecond,
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := m.Run(ctx)
+		require.ErrorIs(t, err, context.Canceled, "nothing is started when ctx is done")
+
+		ctx, cancel = context.WithCancel(context.Background())
+		go func() {
+			for !m.Ready() {
+				time.Sleep(time.Millisecond)
+			}
+			cancel()
+		}()
+
+		err = m.Run(ctx)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.ErrorContains(t, err, "failed to stop http")
+		assert.Equal(t, []string{"start db", "unready db ready=false", "stop db", "run db done"}, rec.get(),
+			"components are stopped after a component misses its deadline")
+	})
+}
+
+// TestWaitGroupWaitContext tests waiting for a group until ctx is done.
+func TestWaitGroupWaitContext(t *testing.T) {
+	var wg WaitGroup
+	wg.Add(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, wg.WaitContext(ctx), context.DeadlineExceeded)
+
+	wg.Done()
+	require.NoError(t, wg.WaitContext(context.Background()))
+}
@@ -0,0 +1,64 @@
+// Package logger builds JSON loggers of applications with a level which may be changed while they run.
+package logger
+
+import (
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// New builds a JSON logger writing to stdout at level, e.g. info, its errors are written to stderr.
+// The returned level changes the level of the logger, e.g. on reloads of the configuration.
+func New(level string) (*zap.Logger, zap.AtomicLevel, error) {
+	atomicLevel, err := zap.ParseAtomicLevel(level)
+	if err != nil {
+		return nil, atomicLevel, fmt.Errorf("invalid log level: %w", err)
+	}
+
+	cfg := zap.Config{
+		Level:            atomicLevel,
+		Development:      false,
+		Encoding:         "json",
+		OutputPaths:      []string{"stdout"},
+		ErrorOutputPaths: []string{"stderr"},
+		EncoderConfig:    EncoderConfig(),
+	}
+
+	logger, err := cfg.Build(
+		zap.AddCaller(),
+		zap.AddCallerSkip(1),
+		zap.AddStacktrace(zap.DPanicLevel),
+	)
+	if err != nil {
+		return nil, atomicLevel, fmt.Errorf("failed to build logger: %w", err)
+	}
+
+	return logger, atomicLevel, nil
+}
+
+// EncoderConfig returns the encoder configuration of loggers: RFC 3339 timestamps with nanoseconds, durations
+// as strings, e.g. 1.5s, lower-cased levels and short callers.
+func EncoderConfig() zapcore.EncoderConfig {
+	return zapcore.EncoderConfig{
+		TimeKey:        "timestamp",
+		LevelKey:       "level",
+		NameKey:        "logger",
+		CallerKey:      "caller",
+		MessageKey:     "msg",
+		StacktraceKey:  "stack",
+		EncodeTime:     encodeTime,
+		EncodeDuration: encodeDuration,
+		EncodeLevel:    zapcore.LowercaseLevelEncoder,
+		EncodeCaller:   zapcore.ShortCallerEncoder,
+	}
+}
+
+func encodeTime(t time.Time, enc zapcore.PrimitiveArrayEncoder) {
+	enc.AppendString(t.Format(time.RFC3339Nano))
+}
+
+func encodeDuration(d time.Duration, enc zapcore.PrimitiveArrayEncoder) {
+	enc.AppendString(d.String())
+}
@@ -0,0 +1,46 @@
+package logger
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestNew(t *testing.T) {
+	logger, level, err := New("warn")
+	require.NoError(t, err)
+	defer func() { _ = logger.Sync() }()
+
+	assert.False(t, logger.Core().Enabled(zap.InfoLevel))
+	assert.True(t, logger.Core().Enabled(zap.WarnLevel))
+
+	level.SetLevel(zap.DebugLevel)
+	assert.True(t, logger.Core().Enabled(zap.DebugLevel), "the level changes the level of the logger")
+
+	_, _, err = New("loud")
+	assert.ErrorContains(t, err, "invalid log level")
+}
+
+func TestEncoderConfig(t *testing.T) {
+	var buf bytes.Buffer
+	core := zapcore.NewCore(zapcore.NewJSONEncoder(EncoderConfig()), zapcore.AddSync(&buf), zap.InfoLevel)
+
+	zap.New(core).Warn("slow request", zap.Duration("elapsed", 1500*time.Millisecond))
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, "warn", entry["level"])
+	assert.Equal(t, "slow request", entry["msg"])
+	assert.Equal(t, "1.5s", entry["elapsed"])
+
+	ts, ok := entry["timestamp"].(string)
+	require.True(t, ok)
+	_, err := time.Parse(time.RFC3339Nano, ts)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,31 @@
+// Package metrics serves Prometheus metrics of applications.
+package metrics
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/valyala/fasthttp/fasthttpadaptor"
+)
+
+// Registry returns reg as the registerer and the gatherer of metrics, or the default ones when reg is nil,
+// so several applications may run in the same process with registries of their own, e.g. in tests.
+func Registry(reg *prometheus.Registry) (prometheus.Registerer, prometheus.Gatherer) {
+	if reg == nil {
+		return prometheus.DefaultRegisterer, prometheus.DefaultGatherer
+	}
+
+	return reg, reg
+}
+
+// Handler returns a handler serving metrics of gatherer in the Prometheus format, scrapes are counted
+// by metrics registered in registerer.
+func Handler(registerer prometheus.Registerer, gatherer prometheus.Gatherer) fiber.Handler {
+	handler := fasthttpadaptor.NewFastHTTPHandler(
+		promhttp.InstrumentMetricHandler(registerer, promhttp.HandlerFor(gatherer, promhttp.HandlerOpts{})))
+
+	return func(c *fiber.Ctx) error {
+		handler(c.Context())
+		return c.SendStatus(fiber.StatusOK)
+	}
+}
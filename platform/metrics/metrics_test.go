@@ -0,0 +1,48 @@
+package metrics
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	registerer, gatherer := Registry(nil)
+	assert.Equal(t, prometheus.DefaultRegisterer, registerer)
+	assert.Equal(t, prometheus.DefaultGatherer, gatherer)
+
+	reg := prometheus.NewRegistry()
+	registerer, gatherer = Registry(reg)
+	assert.Equal(t, reg, registerer)
+	assert.Equal(t, reg, gatherer)
+}
+
+func TestHandler(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	counter := prometheus.NewCounter(prometheus.CounterOpts{Name: "test_events_cnt", Help: "Events"})
+	reg.MustRegister(counter)
+	counter.Add(3)
+
+	app := fiber.New()
+	app.Get("/metrics", Handler(Registry(reg)))
+
+	scrape := func() string {
+		resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/metrics", nil))
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+		return string(body)
+	}
+
+	assert.Contains(t, scrape(), "test_events_cnt 3")
+	assert.Contains(t, scrape(), `promhttp_metric_handler_requests_total{code="200"} 1`, "scrapes are counted")
+}
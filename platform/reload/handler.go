@@ -0,0 +1,99 @@
+package reload
+
+import (
+	"context"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/platform/responder"
+	"github.com/gofiber/fiber/v2"
+)
+
+type (
+	// Reloader - reloads the configuration and reports its state, see Service.
+	Reloader interface {
+		Reload(ctx context.Context, trigger string) (Reload, error)
+		State(ctx context.Context) (State, error)
+	}
+
+	// ReloadResponse is a response for a reload of the configuration.
+	ReloadResponse struct {
+		At       time.Time `json:"at"`
+		Trigger  string    `json:"trigger"`
+		Result   string    `json:"result"`
+		Applied  []string  `json:"applied"`
+		Rejected []string  `json:"rejected"`
+		Error    string    `json:"error,omitempty"`
+	}
+
+	// StateResponse is a response for the effective configuration.
+	StateResponse struct {
+		Config     map[string]any  `json:"config"`
+		Reloads    uint64          `json:"reloads"`
+		LastReload *ReloadResponse `json:"last_reload,omitempty"`
+	}
+
+	// Handler defines a Handler for HTTP requests for the configuration, its routes are for admins only.
+	Handler struct {
+		responder.Responder
+
+		reloader Reloader
+	}
+)
+
+// NewHandler - create new handler.
+func NewHandler(responder responder.Responder, reloader Reloader) *Handler {
+	return &Handler{Responder: responder, reloader: reloader}
+}
+
+// Get - get the effective configuration with redacted secrets and its last reload:
+//   - GET /admin/config
+func (h Handler) Get(ctx *fiber.Ctx) error {
+	state, err := h.reloader.State(ctx.Context())
+	if err != nil {
+		return err
+	}
+
+	return h.Respond(ctx, fiber.StatusOK, fromState(state))
+}
+
+// Reload - reload the configuration, settings which need restart are not applied:
+//   - POST /admin/config/reload
+func (h Handler) Reload(ctx *fiber.Ctx) error {
+	reload, err := h.reloader.Reload(ctx.Context(), TriggerAPI)
+	if err != nil {
+		return err
+	}
+
+	return h.Respond(ctx, fiber.StatusOK, fromReload(reload))
+}
+
+// fromReload converts a reload to its response.
+func fromReload(r Reload) ReloadResponse {
+	resp := ReloadResponse{
+		At:       r.At,
+		Trigger:  r.Trigger,
+		Result:   r.Result,
+		Applied:  r.Applied,
+		Rejected: r.Rejected,
+		Error:    r.Error,
+	}
+	if resp.Applied == nil {
+		resp.Applied = []string{}
+	}
+	if resp.Rejected == nil {
+		resp.Rejected = []string{}
+	}
+
+	return resp
+}
+
+// fromState converts a state to its response.
+func fromState(s State) StateResponse {
+	resp := StateResponse{Config: s.Config, Reloads: s.Reloads}
+	if s.LastReload != nil {
+		last := fromReload(*s.LastReload)
+		resp.LastReload = &last
+	}
+
+	return resp
+}
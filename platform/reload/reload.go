@@ -0,0 +1,159 @@
+// Package reload reloads configurations of applications without restart, on SIGHUP, changes of files
+// or requests of admins.
+package reload
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/platform/config"
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+)
+
+// Results of reloads of the configuration.
+const (
+	ResultApplied = "applied" // changes are applied, or there are none
+	ResultPartial = "partial" // some changes need restart, they are ignored and the rest are applied
+	ResultFailed  = "failed"  // the configuration is not loaded or invalid, nothing is applied
+)
+
+// Triggers of reloads of the configuration.
+const (
+	TriggerSignal = "signal" // SIGHUP
+	TriggerFile   = "file"   // configuration files changed
+	TriggerAPI    = "api"    // admin endpoint
+)
+
+type (
+	// Reload describes a reload of the configuration.
+	Reload struct {
+		At       time.Time
+		Trigger  string
+		Result   string
+		Applied  []string // paths of applied settings, e.g. logger.level
+		Rejected []string // paths of changed settings which need restart
+		Error    string
+	}
+
+	// State describes the effective configuration with redacted secrets and its reloads.
+	State struct {
+		Config     map[string]any
+		Reloads    uint64
+		LastReload *Reload
+	}
+
+	// Service - reloads configurations of type T, a struct whose fields safe to change without restart are
+	// tagged reload:"live", see config.MergeLive.
+	Service[T any] struct {
+		mu      sync.Mutex
+		load    func() (*T, error)
+		current *T
+		apply   func(cfg *T)
+		reloads uint64
+		last    *Reload
+		now     func() time.Time
+		counter *prometheus.CounterVec
+		logger  *zap.Logger
+	}
+)
+
+// NewService constructor, load loads and validates the configuration, apply applies settings which are safe
+// to change without restart. Reloads are counted by counter labeled by result, unless it is nil.
+func NewService[T any](
+	load func() (*T, error),
+	current *T,
+	apply func(cfg *T),
+	counter *prometheus.CounterVec,
+	logger *zap.Logger,
+) *Service[T] {
+	return &Service[T]{
+		load:    load,
+		current: current,
+		apply:   apply,
+		now:     time.Now,
+		counter: counter,
+		logger:  logger.With(zap.String("services", "reload")),
+	}
+}
+
+// Reload loads the configuration again and applies changed settings which are safe to change without restart,
+// changes of other settings are logged and ignored until restart.
+func (s *Service[T]) Reload(ctx context.Context, trigger string) (Reload, error) {
+	if ctx.Err() != nil {
+		return Reload{}, ctx.Err()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	reload := Reload{At: s.now().UTC(), Trigger: trigger, Result: ResultApplied}
+	defer func() {
+		s.reloads++
+		s.last = &reload
+
+		if s.counter != nil {
+			s.counter.WithLabelValues(reload.Result).Inc()
+		}
+	}()
+
+	loaded, err := s.load()
+	if err != nil {
+		reload.Result, reload.Error = ResultFailed, err.Error()
+		s.logger.Error("failed to reload configuration, it is not changed", zap.Error(err),
+			zap.String("trigger", trigger))
+
+		return reload, errs.BadRequest{Cause: "invalid configuration: " + err.Error()}
+	}
+
+	merged, applied, rejected := config.MergeLive(s.current, loaded)
+	reload.Applied, reload.Rejected = applied, rejected
+
+	if len(rejected) > 0 {
+		reload.Result = ResultPartial
+		s.logger.Warn("changed settings can't be applied without restart, they are ignored",
+			zap.Strings("settings", rejected), zap.String("trigger", trigger))
+	}
+
+	if len(applied) > 0 {
+		s.apply(merged)
+		s.current = merged
+	}
+
+	s.logger.Info("configuration reloaded", zap.Strings("applied", applied), zap.String("trigger", trigger))
+
+	return reload, nil
+}
+
+// State returns the effective configuration with redacted secrets and its last reload.
+func (s *Service[T]) State(ctx context.Context) (State, error) {
+	if ctx.Err() != nil {
+		return State{}, ctx.Err()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// the configuration is keyed as in yaml files
+	data, err := yaml.Marshal(config.Redact(*s.current))
+	if err != nil {
+		return State{}, fmt.Errorf("failed to encode configuration: %w", err)
+	}
+
+	var cfg map[string]any
+	if err = yaml.Unmarshal(data, &cfg); err != nil {
+		return State{}, fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	state := State{Config: cfg, Reloads: s.reloads}
+	if s.last != nil {
+		last := *s.last
+		state.LastReload = &last
+	}
+
+	return state, nil
+}
@@ -0,0 +1,57 @@
+package reload
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// Watch reloads the configuration on SIGHUP and on changes of files at paths, which are checked every
+// interval, until ctx is done. Files are not checked when interval is 0.
+func Watch(ctx context.Context, reloader Reloader, paths []string, interval time.Duration) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+	defer signal.Stop(signals)
+
+	var checks <-chan time.Time
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		checks = ticker.C
+	}
+
+	version := filesVersion(paths)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-signals:
+			_, _ = reloader.Reload(ctx, TriggerSignal)
+		case <-checks:
+			if v := filesVersion(paths); v != version {
+				version = v
+				_, _ = reloader.Reload(ctx, TriggerFile)
+			}
+		}
+	}
+}
+
+// filesVersion returns modification times and sizes of files, it changes when any of them is written,
+// replaced or removed.
+func filesVersion(paths []string) string {
+	var b strings.Builder
+	for _, path := range paths {
+		b.WriteString(path)
+		if info, err := os.Stat(path); err == nil {
+			b.WriteString(":" + strconv.FormatInt(info.ModTime().UnixNano(), 10))
+			b.WriteString(":" + strconv.FormatInt(info.Size(), 10))
+		}
+		b.WriteString(";")
+	}
+
+	return b.String()
+}
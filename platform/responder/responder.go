@@ -0,0 +1,192 @@
+package responder
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Media types of error responses.
+const (
+	// MIMEProblemJSON - RFC 7807 problem details, the default format of error responses.
+	MIMEProblemJSON = "application/problem+json"
+	// MIMELegacyErrorJSON - accepted by clients expecting errors as {"error": "..."}, responded as application/json.
+	MIMELegacyErrorJSON = "application/vnd.legacy-error+json"
+)
+
+// Formats of error responses used when a client accepts neither of them explicitly.
+const (
+	ErrorFormatProblem = "problem" // RFC 7807 problem details
+	ErrorFormatLegacy  = "legacy"  // {"error": "..."}
+)
+
+// Problem types.
+const (
+	ProblemTypeBlank      = "about:blank" // the problem has no semantics besides its status code
+	ProblemTypeValidation = "urn:problem-type:validation-error"
+)
+
+type (
+	// Error - struct for legacy error response.
+	Error struct {
+		Error string `json:"error"`
+	}
+
+	// Problem - struct for RFC 7807 problem details error response.
+	Problem struct {
+		Type      string            `json:"type"`
+		Title     string            `json:"title"`
+		Status    int               `json:"status"`
+		Detail    string            `json:"detail,omitempty"`
+		RequestID string            `json:"request_id,omitempty"`
+		Errors    []errs.FieldError `json:"errors,omitempty"`
+	}
+
+	// Responder - helper struct wraps request/response functionality for fiber http handlers.
+	Responder struct {
+		legacyByDefault bool
+	}
+
+	// Option - option of Responder.
+	Option func(*Responder)
+
+	// fiberError - error returned by fiber itself, its message is kept as is.
+	fiberError struct {
+		code    int
+		message string
+	}
+)
+
+// New - constructor for Responder entity.
+func New(opts ...Option) Responder {
+	var r Responder
+	for _, opt := range opts {
+		opt(&r)
+	}
+
+	return r
+}
+
+// WithDefaultErrorFormat - sets format of errors responded to clients accepting neither problem details
+// nor legacy errors explicitly, one of ErrorFormat* values, problem details are responded by default.
+func WithDefaultErrorFormat(format string) Option {
+	return func(r *Responder) { r.legacyByDefault = format == ErrorFormatLegacy }
+}
+
+// IsErrorFormat reports whether format is one of ErrorFormat* values or empty, which is the default format.
+func IsErrorFormat(format string) bool {
+	return format == "" || format == ErrorFormatProblem || format == ErrorFormatLegacy
+}
+
+// NewProblem - constructor for Problem of an error.
+func NewProblem(err errs.HTTPError, requestID string) Problem {
+	p := Problem{
+		Type:      ProblemTypeBlank,
+		Title:     http.StatusText(err.StatusCode()),
+		Status:    err.StatusCode(),
+		Detail:    err.Error(),
+		RequestID: requestID,
+	}
+
+	var validation errs.FieldsValidation
+	if errors.As(err, &validation) {
+		p.Type = ProblemTypeValidation
+		p.Detail = "request has invalid fields"
+		p.Errors = validation.Fields()
+	}
+
+	return p
+}
+
+// Respond - helper func for respond.
+func (h Responder) Respond(ctx *fiber.Ctx, code int, payload any) error {
+	ctx.Response().SetStatusCode(code)
+
+	if err := ctx.JSON(payload); err != nil {
+		return errs.Internal{Cause: "invalid json in output"}
+	}
+
+	return nil
+}
+
+// RespondError - helper func for response with error, the format is negotiated by Accept header.
+func (h Responder) RespondError(ctx *fiber.Ctx, err errs.HTTPError) error {
+	if h.legacy(ctx.Get(fiber.HeaderAccept)) {
+		return h.Respond(ctx, err.StatusCode(), Error{Error: err.Error()})
+	}
+
+	ctx.Response().SetStatusCode(err.StatusCode())
+
+	if jsonErr := ctx.JSON(NewProblem(err, requestID(ctx)), MIMEProblemJSON); jsonErr != nil {
+		return errs.Internal{Cause: "invalid json in output"}
+	}
+
+	return nil
+}
+
+// RespondEmpty - helper func for empty response.
+func (h Responder) RespondEmpty(ctx *fiber.Ctx, code int) error {
+	ctx.Response().SetStatusCode(code)
+	return nil
+}
+
+// HandleError - helper func for response with error.
+func (h Responder) HandleError(ctx *fiber.Ctx, err error) error {
+	var fiberErr *fiber.Error
+	if errors.As(err, &fiberErr) {
+		if fiberErr.Code == http.StatusRequestEntityTooLarge {
+			return h.RespondError(ctx, errs.PayloadTooLarge{})
+		}
+
+		return h.RespondError(ctx, fiberError{code: fiberErr.Code, message: fiberErr.Message})
+	}
+
+	var httpErr errs.HTTPError
+	if errors.As(err, &httpErr) {
+		if httpErr.StatusCode() == http.StatusNoContent {
+			return h.RespondEmpty(ctx, http.StatusNoContent)
+		}
+
+		return h.RespondError(ctx, httpErr)
+	}
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return h.RespondError(ctx, errs.GatewayTimeout{Cause: err.Error()})
+	case errors.Is(err, context.Canceled):
+		return h.RespondError(ctx, errs.RequestTimeout{Cause: err.Error()})
+	}
+
+	return h.RespondError(ctx, errs.Internal{Cause: err.Error()})
+}
+
+// legacy reports whether errors are responded in legacy format to a client with accept header.
+func (h Responder) legacy(accept string) bool {
+	switch {
+	case strings.Contains(accept, MIMELegacyErrorJSON):
+		return true
+	case strings.Contains(accept, MIMEProblemJSON):
+		return false
+	default:
+		return h.legacyByDefault
+	}
+}
+
+// requestID returns id of the request set by requestid middleware.
+func requestID(ctx *fiber.Ctx) string {
+	if id := ctx.GetRespHeader(fiber.HeaderXRequestID); id != "" {
+		return id
+	}
+
+	return ctx.Get(fiber.HeaderXRequestID)
+}
+
+// Error implements error interface.
+func (e fiberError) Error() string { return e.message }
+
+// StatusCode implements error interface.
+func (e fiberError) StatusCode() int { return e.code }
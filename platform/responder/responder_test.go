@@ -0,0 +1,127 @@
+package responder
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/goccy/go-json"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/requestid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testError responds err to a request with accept header and returns the response.
+func testError(t *testing.T, err error, accept string) (*http.Response, []byte) {
+	t.Helper()
+
+	return testErrorOf(t, New(), err, accept)
+}
+
+// testErrorOf responds err by the responder to a request with accept header and returns the response.
+func testErrorOf(t *testing.T, r Responder, err error, accept string) (*http.Response, []byte) {
+	t.Helper()
+
+	app := fiber.New(fiber.Config{ErrorHandler: r.HandleError})
+	app.Use(requestid.New(requestid.Config{Generator: func() string { return "req-1" }}))
+	app.Get("/", func(*fiber.Ctx) error { return err })
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	if accept != "" {
+		req.Header.Set(fiber.HeaderAccept, accept)
+	}
+
+	resp, testErr := app.Test(req)
+	require.NoError(t, testErr)
+	body, readErr := io.ReadAll(resp.Body)
+	require.NoError(t, readErr)
+
+	return resp, body
+}
+
+// TestHandleErrorProblem tests that errors are responded as problem details by default.
+func TestHandleErrorProblem(t *testing.T) {
+	resp, body := testError(t, errs.FieldsValidation{Errors: []string{"name::is_required"}}, "application/json")
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, MIMEProblemJSON, resp.Header.Get(fiber.HeaderContentType))
+
+	var problem Problem
+	require.NoError(t, json.Unmarshal(body, &problem))
+	assert.Equal(t, Problem{
+		Type:      ProblemTypeValidation,
+		Title:     "Bad Request",
+		Status:    http.StatusBadRequest,
+		Detail:    "request has invalid fields",
+		RequestID: "req-1",
+		Errors:    []errs.FieldError{{Field: "name", Code: "is_required"}},
+	}, problem)
+
+	resp, body = testError(t, errs.NotFound{What: "product"}, "")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	var notFound Problem
+	require.NoError(t, json.Unmarshal(body, &notFound))
+	assert.Equal(t, ProblemTypeBlank, notFound.Type)
+	assert.Equal(t, "not_found - product", notFound.Detail)
+	assert.Empty(t, notFound.Errors)
+}
+
+// TestHandleErrorLegacy tests that legacy format is responded when it is accepted.
+func TestHandleErrorLegacy(t *testing.T) {
+	resp, body := testError(t, errs.FieldsValidation{Errors: []string{"name::is_required"}}, MIMELegacyErrorJSON)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, fiber.MIMEApplicationJSON, resp.Header.Get(fiber.HeaderContentType))
+	assert.JSONEq(t, `{"error":"validation_error - name::is_required"}`, string(body))
+}
+
+// TestHandleErrorDefaultFormat tests that the default format is responded unless a format is accepted explicitly.
+func TestHandleErrorDefaultFormat(t *testing.T) {
+	legacy := New(WithDefaultErrorFormat(ErrorFormatLegacy))
+	err := errs.NotFound{What: "product"}
+
+	for _, accept := range []string{"", "application/json", "*/*"} {
+		resp, body := testErrorOf(t, legacy, err, accept)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode, accept)
+		assert.Equal(t, fiber.MIMEApplicationJSON, resp.Header.Get(fiber.HeaderContentType), accept)
+		assert.JSONEq(t, `{"error":"not_found - product"}`, string(body), accept)
+	}
+
+	resp, _ := testErrorOf(t, legacy, err, "application/json, "+MIMEProblemJSON)
+	assert.Equal(t, MIMEProblemJSON, resp.Header.Get(fiber.HeaderContentType))
+
+	resp, _ = testErrorOf(t, New(WithDefaultErrorFormat(ErrorFormatProblem)), err, MIMELegacyErrorJSON)
+	assert.Equal(t, fiber.MIMEApplicationJSON, resp.Header.Get(fiber.HeaderContentType))
+}
+
+// TestIsErrorFormat tests formats of errors accepted by configurations.
+func TestIsErrorFormat(t *testing.T) {
+	for _, format := range []string{"", ErrorFormatProblem, ErrorFormatLegacy} {
+		assert.True(t, IsErrorFormat(format), format)
+	}
+	assert.False(t, IsErrorFormat("xml"))
+}
+
+// TestHandleErrorFiber tests that errors of fiber keep their status codes.
+func TestHandleErrorFiber(t *testing.T) {
+	resp, _ := testError(t, fiber.ErrMethodNotAllowed, "")
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+
+	resp, _ = testError(t, fiber.ErrRequestEntityTooLarge, "")
+	assert.Equal(t, http.StatusRequestEntityTooLarge, resp.StatusCode)
+
+	resp, _ = testError(t, io.ErrUnexpectedEOF, "")
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+}
+
+// TestHandleErrorContext tests that context errors are responded as timeouts.
+func TestHandleErrorContext(t *testing.T) {
+	resp, _ := testError(t, fmt.Errorf("query: %w", context.DeadlineExceeded), "")
+	assert.Equal(t, http.StatusGatewayTimeout, resp.StatusCode)
+
+	resp, _ = testError(t, context.Canceled, "")
+	assert.Equal(t, http.StatusRequestTimeout, resp.StatusCode)
+}
@@ -0,0 +1,67 @@
+// Package sqsclient creates clients of SQS queues set by their URLs, e.g. queues of LocalStack.
+package sqsclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+)
+
+// New creates a client of the queue at queueURL in region, requests are sent to the scheme and host of the URL.
+// The queue is checked to exist, so applications with a misconfigured queue fail on startup.
+func New(ctx context.Context, queueURL, region string) (*sqs.Client, error) {
+	u, err := url.Parse(queueURL)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse SQS queue URL: %w", err)
+	}
+
+	queueName, err := QueueName(queueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	awsCfg, err := config.LoadDefaultConfig(ctx,
+		config.WithRegion(region),
+		config.WithCredentialsProvider(
+			credentials.NewStaticCredentialsProvider("test", "test", ""),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load AWS config: %w", err)
+	}
+
+	baseEndpoint := (&url.URL{Scheme: u.Scheme, Host: u.Host}).String()
+	client := sqs.NewFromConfig(awsCfg, func(o *sqs.Options) {
+		o.BaseEndpoint = aws.String(baseEndpoint)
+	})
+
+	if _, err = client.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{QueueName: aws.String(queueName)}); err != nil {
+		return nil, fmt.Errorf("cannot get SQS queue URL: %w", err)
+	}
+
+	return client, nil
+}
+
+// QueueName returns the name of a queue by its URL, e.g. test-queue of:
+//   - http://sqs.us-east-1.localhost.localstack.cloud:4566/000000000000/test-queue
+//   - http://localstack:4566/000000000000/test-queue
+func QueueName(queueURL string) (string, error) {
+	u, err := url.Parse(queueURL)
+	if err != nil {
+		return "", fmt.Errorf("cannot parse SQS URL: %w", err)
+	}
+
+	name := path.Base(u.Path)
+	if name == "/" || name == "." {
+		return "", errors.New("SQS URL has no queue name: " + queueURL)
+	}
+
+	return name, nil
+}
@@ -0,0 +1,57 @@
+package sqsclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueueName(t *testing.T) {
+	for queueURL, want := range map[string]string{
+		"http://sqs.us-east-1.localhost.localstack.cloud:4566/000000000000/test-queue": "test-queue",
+		"http://localstack:4566/000000000000/orders":                                   "orders",
+	} {
+		name, err := QueueName(queueURL)
+		require.NoError(t, err)
+		assert.Equal(t, want, name)
+	}
+
+	for _, queueURL := range []string{"http://localstack:4566", "http://localstack:4566/", "://localstack"} {
+		_, err := QueueName(queueURL)
+		assert.Error(t, err, queueURL)
+	}
+}
+
+func TestNew(t *testing.T) {
+	// fake SQS knowing a single queue, it serves GetQueueUrl of the AWS JSON protocol
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var in struct {
+			QueueName string
+		}
+		if r.Header.Get("X-Amz-Target") != "AmazonSQS.GetQueueUrl" || json.NewDecoder(r.Body).Decode(&in) != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+		if in.QueueName != "test-queue" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"__type":"com.amazonaws.sqs#QueueDoesNotExist","message":"no queue"}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"QueueUrl": "http://" + r.Host + "/000000000000/test-queue"})
+	}))
+	defer srv.Close()
+
+	client, err := New(context.Background(), srv.URL+"/000000000000/test-queue", "us-east-1")
+	require.NoError(t, err)
+	assert.NotNil(t, client)
+
+	_, err = New(context.Background(), srv.URL+"/000000000000/missing-queue", "us-east-1")
+	assert.ErrorContains(t, err, "cannot get SQS queue URL")
+}
@@ -0,0 +1,68 @@
+-- +migrate Up
+CREATE EXTENSION IF NOT EXISTS "btree_gist";
+
+ALTER TABLE products
+    ADD COLUMN currency TEXT NOT NULL DEFAULT 'USD' CHECK (currency ~ '^[A-Z]{3}$');
+
+COMMENT ON COLUMN products.price IS 'Base price in products.currency';
+COMMENT ON COLUMN products.currency IS 'ISO 4217 currency code of the base price';
+
+CREATE TABLE currency_rates (
+                                currency TEXT PRIMARY KEY CHECK (currency ~ '^[A-Z]{3}$'),
+                                rate NUMERIC(18,8) NOT NULL CHECK (rate > 0),
+                                created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+                                updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+COMMENT ON COLUMN currency_rates.currency IS 'ISO 4217 currency code';
+COMMENT ON COLUMN currency_rates.rate IS 'Units of currency per one USD';
+COMMENT ON COLUMN currency_rates.created_at IS 'Creation timestamp';
+COMMENT ON COLUMN currency_rates.updated_at IS 'Last update timestamp';
+
+CREATE TRIGGER currency_rates_set_updated_at
+    BEFORE UPDATE ON currency_rates
+    FOR EACH ROW
+    EXECUTE PROCEDURE trigger_set_updated_at();
+
+INSERT INTO currency_rates (currency, rate) VALUES ('USD', 1);
+
+CREATE TABLE product_prices (
+                                id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+                                product_id UUID NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+                                price NUMERIC(10,2) NOT NULL CHECK (price >= 0),
+                                currency TEXT NOT NULL CHECK (currency ~ '^[A-Z]{3}$'),
+                                effective_from TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+                                effective_to TIMESTAMP WITH TIME ZONE,
+                                created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+                                CONSTRAINT chk_product_prices_period CHECK (effective_to IS NULL OR effective_to > effective_from),
+                                CONSTRAINT ex_product_prices_period EXCLUDE USING gist (
+                                    product_id WITH =,
+                                    tstzrange(effective_from, effective_to) WITH &&
+                                )
+);
+
+COMMENT ON COLUMN product_prices.id IS 'Unique identifier for the price';
+COMMENT ON COLUMN product_prices.product_id IS 'Product the price belongs to';
+COMMENT ON COLUMN product_prices.price IS 'Price in product_prices.currency';
+COMMENT ON COLUMN product_prices.currency IS 'ISO 4217 currency code';
+COMMENT ON COLUMN product_prices.effective_from IS 'Start of the period the price is effective (inclusive)';
+COMMENT ON COLUMN product_prices.effective_to IS 'End of the period the price is effective (exclusive), NULL means open-ended';
+COMMENT ON COLUMN product_prices.created_at IS 'Creation timestamp';
+
+CREATE INDEX idx_product_prices_product_id_effective_from ON product_prices (product_id, effective_from DESC);
+
+INSERT INTO product_prices (product_id, price, currency, effective_from)
+SELECT id, price, currency, created_at FROM products;
+
+-- +migrate Down
+DROP TABLE IF EXISTS product_prices;
+
+DROP TRIGGER IF EXISTS currency_rates_set_updated_at ON currency_rates;
+
+DROP TABLE IF EXISTS currency_rates;
+
+ALTER TABLE products DROP COLUMN IF EXISTS currency;
+
+COMMENT ON COLUMN products.price IS 'Price in USD';
+
+DROP EXTENSION IF EXISTS "btree_gist";
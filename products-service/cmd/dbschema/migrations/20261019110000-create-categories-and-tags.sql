@@ -0,0 +1,54 @@
+-- +migrate Up
+CREATE TABLE categories (
+                            id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+                            parent_id UUID,
+                            name TEXT NOT NULL CHECK (length(trim(name)) > 0 AND length(trim(name)) <= 255),
+                            created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+                            updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+                            CONSTRAINT fk_categories_parent FOREIGN KEY (parent_id) REFERENCES categories (id) ON DELETE RESTRICT,
+                            CONSTRAINT chk_categories_parent CHECK (parent_id IS NULL OR parent_id <> id),
+                            CONSTRAINT uq_categories_parent_name UNIQUE NULLS NOT DISTINCT (parent_id, name)
+);
+
+COMMENT ON COLUMN categories.id IS 'Unique identifier for the category';
+COMMENT ON COLUMN categories.parent_id IS 'Parent category, NULL for root categories';
+COMMENT ON COLUMN categories.name IS 'Name of the category, unique within the parent';
+COMMENT ON COLUMN categories.created_at IS 'Creation timestamp';
+COMMENT ON COLUMN categories.updated_at IS 'Last update timestamp';
+
+CREATE INDEX idx_categories_parent_id ON categories (parent_id);
+
+CREATE TRIGGER categories_set_updated_at
+    BEFORE UPDATE ON categories
+    FOR EACH ROW
+    EXECUTE PROCEDURE trigger_set_updated_at();
+
+CREATE TABLE product_categories (
+                                    product_id UUID NOT NULL,
+                                    category_id UUID NOT NULL,
+                                    CONSTRAINT pk_product_categories PRIMARY KEY (product_id, category_id),
+                                    CONSTRAINT fk_product_categories_product FOREIGN KEY (product_id) REFERENCES products (id) ON DELETE CASCADE,
+                                    CONSTRAINT fk_product_categories_category FOREIGN KEY (category_id) REFERENCES categories (id) ON DELETE RESTRICT
+);
+
+CREATE INDEX idx_product_categories_category_id ON product_categories (category_id);
+
+CREATE TABLE product_tags (
+                              product_id UUID NOT NULL,
+                              tag TEXT NOT NULL CHECK (length(trim(tag)) > 0 AND length(tag) <= 64),
+                              CONSTRAINT pk_product_tags PRIMARY KEY (product_id, tag),
+                              CONSTRAINT fk_product_tags_product FOREIGN KEY (product_id) REFERENCES products (id) ON DELETE CASCADE
+);
+
+COMMENT ON COLUMN product_tags.tag IS 'Free-form tag, stored lower-cased';
+
+CREATE INDEX idx_product_tags_tag ON product_tags (tag);
+
+-- +migrate Down
+DROP TABLE IF EXISTS product_tags;
+
+DROP TABLE IF EXISTS product_categories;
+
+DROP TRIGGER IF EXISTS categories_set_updated_at ON categories;
+
+DROP TABLE IF EXISTS categories;
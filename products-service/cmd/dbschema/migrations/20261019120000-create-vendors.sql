@@ -0,0 +1,94 @@
+-- +migrate Up
+-- +migrate StatementBegin
+CREATE OR REPLACE FUNCTION normalize_vendor_name(name TEXT) RETURNS TEXT AS $$
+SELECT COALESCE(
+    NULLIF(
+        trim(regexp_replace(
+            regexp_replace(
+                lower(trim(name)),
+                '[[:space:],]*\m(incorporated|inc|llc|ltd|limited|corp|corporation|co|company|gmbh)\M\.?$',
+                ''
+            ),
+            '[^[:alnum:]]+', ' ', 'g'
+        )),
+        ''
+    ),
+    lower(trim(name))
+)
+$$ LANGUAGE sql IMMUTABLE;
+-- +migrate StatementEnd
+
+CREATE TABLE vendors (
+                         id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+                         name TEXT NOT NULL CHECK (length(trim(name)) > 0 AND length(trim(name)) <= 255),
+                         normalized_name TEXT GENERATED ALWAYS AS (normalize_vendor_name(name)) STORED,
+                         created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+                         updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+                         CONSTRAINT uq_vendors_normalized_name UNIQUE (normalized_name)
+);
+
+COMMENT ON COLUMN vendors.id IS 'Unique identifier for the vendor';
+COMMENT ON COLUMN vendors.name IS 'Display name of the vendor / manufacturer';
+COMMENT ON COLUMN vendors.normalized_name IS 'Case, punctuation and legal suffix insensitive name used for uniqueness';
+COMMENT ON COLUMN vendors.created_at IS 'Creation timestamp';
+COMMENT ON COLUMN vendors.updated_at IS 'Last update timestamp';
+
+CREATE TRIGGER vendors_set_updated_at
+    BEFORE UPDATE ON vendors
+    FOR EACH ROW
+    EXECUTE PROCEDURE trigger_set_updated_at();
+
+-- the earliest spelling of a vendor becomes its display name
+INSERT INTO vendors (name)
+SELECT DISTINCT ON (normalize_vendor_name(vendor)) trim(vendor)
+FROM products
+ORDER BY normalize_vendor_name(vendor), created_at;
+
+ALTER TABLE products ADD COLUMN vendor_id UUID;
+
+UPDATE products p
+SET vendor_id = v.id
+FROM vendors v
+WHERE v.normalized_name = normalize_vendor_name(p.vendor);
+
+-- products that become duplicates after vendors are merged keep their id suffix in the name
+UPDATE products p
+SET name = left(p.name, 244) || ' (' || left(p.id::text, 8) || ')'
+FROM (
+    SELECT id, row_number() OVER (PARTITION BY name, vendor_id ORDER BY created_at) AS rn
+    FROM products
+) d
+WHERE d.id = p.id AND d.rn > 1;
+
+ALTER TABLE products
+    DROP CONSTRAINT uq_products_name_vendor,
+    DROP COLUMN vendor,
+    ALTER COLUMN vendor_id SET NOT NULL,
+    ADD CONSTRAINT fk_products_vendor FOREIGN KEY (vendor_id) REFERENCES vendors (id) ON DELETE RESTRICT,
+    ADD CONSTRAINT uq_products_name_vendor UNIQUE (name, vendor_id);
+
+COMMENT ON COLUMN products.vendor_id IS 'Product vendor / manufacturer';
+
+-- +migrate Down
+ALTER TABLE products ADD COLUMN vendor TEXT;
+
+UPDATE products p
+SET vendor = v.name
+FROM vendors v
+WHERE v.id = p.vendor_id;
+
+ALTER TABLE products
+    DROP CONSTRAINT uq_products_name_vendor,
+    DROP CONSTRAINT fk_products_vendor,
+    DROP COLUMN vendor_id,
+    ALTER COLUMN vendor SET NOT NULL,
+    ADD CONSTRAINT products_vendor_check CHECK (length(trim(vendor)) > 0 AND length(trim(vendor)) <= 255),
+    ADD CONSTRAINT uq_products_name_vendor UNIQUE (name, vendor);
+
+COMMENT ON COLUMN products.vendor IS 'Product vendor / manufacturer';
+
+DROP TRIGGER IF EXISTS vendors_set_updated_at ON vendors;
+
+DROP TABLE IF EXISTS vendors;
+
+DROP FUNCTION IF EXISTS normalize_vendor_name;
@@ -0,0 +1,51 @@
+-- +migrate Up
+CREATE TABLE stock_levels (
+                              product_id UUID NOT NULL,
+                              warehouse TEXT NOT NULL DEFAULT 'default' CHECK (length(trim(warehouse)) > 0 AND length(warehouse) <= 64),
+                              quantity BIGINT NOT NULL DEFAULT 0 CHECK (quantity >= 0),
+                              low_stock_threshold BIGINT NOT NULL DEFAULT 0 CHECK (low_stock_threshold >= 0),
+                              created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+                              updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+                              CONSTRAINT pk_stock_levels PRIMARY KEY (product_id, warehouse),
+                              CONSTRAINT fk_stock_levels_product FOREIGN KEY (product_id) REFERENCES products (id) ON DELETE CASCADE
+);
+
+COMMENT ON COLUMN stock_levels.product_id IS 'Product the stock belongs to';
+COMMENT ON COLUMN stock_levels.warehouse IS 'Warehouse code, ''default'' when warehouses are not used';
+COMMENT ON COLUMN stock_levels.quantity IS 'Units on hand, reserved units included';
+COMMENT ON COLUMN stock_levels.low_stock_threshold IS 'Available units at or below which the stock is low, 0 disables the alert';
+COMMENT ON COLUMN stock_levels.created_at IS 'Creation timestamp';
+COMMENT ON COLUMN stock_levels.updated_at IS 'Last update timestamp';
+
+CREATE TRIGGER stock_levels_set_updated_at
+    BEFORE UPDATE ON stock_levels
+    FOR EACH ROW
+    EXECUTE PROCEDURE trigger_set_updated_at();
+
+CREATE TABLE stock_reservations (
+                                    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+                                    product_id UUID NOT NULL,
+                                    warehouse TEXT NOT NULL,
+                                    quantity BIGINT NOT NULL CHECK (quantity > 0),
+                                    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+                                    released_at TIMESTAMP WITH TIME ZONE,
+                                    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+                                    CONSTRAINT fk_stock_reservations_stock FOREIGN KEY (product_id, warehouse)
+                                        REFERENCES stock_levels (product_id, warehouse) ON DELETE CASCADE
+);
+
+COMMENT ON COLUMN stock_reservations.id IS 'Unique identifier for the reservation';
+COMMENT ON COLUMN stock_reservations.quantity IS 'Reserved units';
+COMMENT ON COLUMN stock_reservations.expires_at IS 'Moment the reservation stops holding units';
+COMMENT ON COLUMN stock_reservations.released_at IS 'Moment the reservation was released, NULL while it is held';
+COMMENT ON COLUMN stock_reservations.created_at IS 'Creation timestamp';
+
+CREATE INDEX idx_stock_reservations_held ON stock_reservations (product_id, warehouse, expires_at)
+    WHERE released_at IS NULL;
+
+-- +migrate Down
+DROP TABLE IF EXISTS stock_reservations;
+
+DROP TRIGGER IF EXISTS stock_levels_set_updated_at ON stock_levels;
+
+DROP TABLE IF EXISTS stock_levels;
@@ -0,0 +1,24 @@
+-- +migrate Up
+CREATE TABLE product_images (
+                                id UUID PRIMARY KEY,
+                                product_id UUID NOT NULL,
+                                key TEXT NOT NULL,
+                                content_type TEXT NOT NULL,
+                                size_bytes BIGINT NOT NULL CHECK (size_bytes > 0),
+                                checksum TEXT NOT NULL CHECK (checksum ~ '^[0-9a-f]{64}$'),
+                                created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+                                CONSTRAINT fk_product_images_product FOREIGN KEY (product_id) REFERENCES products (id) ON DELETE CASCADE,
+                                CONSTRAINT uq_product_images_key UNIQUE (key)
+);
+
+COMMENT ON COLUMN product_images.id IS 'Unique identifier for the image';
+COMMENT ON COLUMN product_images.key IS 'Object key of the image in the S3 bucket';
+COMMENT ON COLUMN product_images.content_type IS 'MIME type of the image';
+COMMENT ON COLUMN product_images.size_bytes IS 'Size of the image in bytes';
+COMMENT ON COLUMN product_images.checksum IS 'Hex encoded SHA-256 of the image';
+COMMENT ON COLUMN product_images.created_at IS 'Upload timestamp';
+
+CREATE INDEX idx_product_images_product_id ON product_images (product_id, created_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS product_images;
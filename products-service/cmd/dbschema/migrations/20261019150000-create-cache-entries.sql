@@ -0,0 +1,16 @@
+-- +migrate Up
+CREATE UNLOGGED TABLE cache_entries (
+                                key TEXT PRIMARY KEY,
+                                value BYTEA NOT NULL,
+                                expires_at TIMESTAMP WITH TIME ZONE
+);
+
+COMMENT ON TABLE cache_entries IS 'Cache of reads shared by instances of the service, it is not WAL-logged';
+COMMENT ON COLUMN cache_entries.key IS 'Key of the cached value';
+COMMENT ON COLUMN cache_entries.value IS 'Cached value encoded by the cache user';
+COMMENT ON COLUMN cache_entries.expires_at IS 'Expiration timestamp, entries without it never expire';
+
+CREATE INDEX idx_cache_entries_expires_at ON cache_entries (expires_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS cache_entries;
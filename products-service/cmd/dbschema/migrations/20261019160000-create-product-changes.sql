@@ -0,0 +1,43 @@
+-- +migrate Up
+CREATE TABLE product_changes (
+                                 id BIGSERIAL PRIMARY KEY,
+                                 tx_id XID8 NOT NULL DEFAULT pg_current_xact_id(),
+                                 product_id UUID NOT NULL,
+                                 type TEXT NOT NULL CHECK (type IN ('create_product', 'update_product', 'delete_product')),
+                                 changed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+COMMENT ON TABLE product_changes IS 'Feed of committed changes of products, rows are written by triggers of products';
+COMMENT ON COLUMN product_changes.id IS 'Sequence number of the change, changes of a transaction are ordered by it';
+COMMENT ON COLUMN product_changes.tx_id IS 'Transaction of the change, changes are read in order of transactions, which are settled below the xmin of snapshots';
+COMMENT ON COLUMN product_changes.product_id IS 'Changed product, it is not a foreign key, so deletes are kept';
+COMMENT ON COLUMN product_changes.type IS 'Type of the change, same as the type of the event sent to notifications-service';
+COMMENT ON COLUMN product_changes.changed_at IS 'Moment the change was made';
+
+CREATE INDEX idx_product_changes_position ON product_changes (tx_id, id);
+CREATE INDEX idx_product_changes_changed_at ON product_changes (changed_at);
+
+-- +migrate StatementBegin
+CREATE OR REPLACE FUNCTION trigger_record_product_change() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        INSERT INTO product_changes (product_id, type) VALUES (OLD.id, 'delete_product');
+        RETURN OLD;
+    END IF;
+
+    INSERT INTO product_changes (product_id, type)
+    VALUES (NEW.id, CASE TG_OP WHEN 'INSERT' THEN 'create_product' ELSE 'update_product' END);
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+-- +migrate StatementEnd
+
+CREATE TRIGGER products_record_change
+    AFTER INSERT OR UPDATE OR DELETE ON products
+    FOR EACH ROW
+    EXECUTE PROCEDURE trigger_record_product_change();
+
+-- +migrate Down
+DROP TRIGGER IF EXISTS products_record_change ON products;
+DROP FUNCTION IF EXISTS trigger_record_product_change;
+DROP TABLE IF EXISTS product_changes;
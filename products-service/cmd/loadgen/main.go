@@ -0,0 +1,67 @@
+// Command loadgen drives a mix of create, list and delete requests against products-service and reports
+// latency percentiles, errors by errs type and end-to-end lag of events read from notifications-service metrics:
+//
+//	go run ./cmd/loadgen -url http://localhost:10000 -rps 200 -concurrency 50 -duration 1m \
+//		-mix create=2,list=7,delete=1 -metrics-url http://localhost:10001/notifications-api/v1/metrics
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/loadgen"
+)
+
+// usage - usage of the command.
+const usage = `usage: loadgen [flags]
+
+Sends requests at -rps, or by -concurrency workers in a closed loop when -rps is 0, for -duration.
+Products to delete are taken from products created by the load, a product is created when there are none.
+
+flags:
+`
+
+func main() {
+	cfg := loadgen.Config{}
+	mix := flag.String("mix", "create=2,list=7,delete=1", "weights of operations: create, list and delete")
+	flag.StringVar(&cfg.URL, "url", "http://localhost:10000", "base URL of products-service")
+	flag.Float64Var(&cfg.RPS, "rps", 0, "requests per second, workers send requests in a closed loop when 0")
+	flag.IntVar(&cfg.Concurrency, "concurrency", 10, "number of workers, it bounds requests in flight")
+	flag.DurationVar(&cfg.Duration, "duration", 30*time.Second, "how long requests are sent")
+	flag.DurationVar(&cfg.Timeout, "timeout", 10*time.Second, "timeout of requests")
+	flag.Uint64Var(&cfg.Seed, "seed", 1, "seed of generated products and of the order of operations")
+	flag.StringVar(&cfg.MetricsURL, "metrics-url", "",
+		"metrics of notifications-service to measure event lag by, e.g. "+
+			"http://localhost:10001/notifications-api/v1/metrics")
+	flag.DurationVar(&cfg.LagTimeout, "lag-timeout", 30*time.Second,
+		"how long published events are waited for to be handled after the load")
+	flag.Usage = func() {
+		_, _ = fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	var err error
+	if cfg.Mix, err = loadgen.ParseMix(*mix); err != nil {
+		log.Fatal(err)
+	}
+
+	// the load is interrupted by signals, the report of requests sent by then is written
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	report, err := loadgen.Run(ctx, cfg)
+	if writeErr := report.Write(os.Stdout); writeErr != nil && err == nil {
+		err = writeErr
+	}
+	if err != nil {
+		cancel()
+		log.Fatal(err)
+	}
+}
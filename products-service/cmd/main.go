@@ -4,13 +4,14 @@ import (
 	"context"
 	"embed"
 	"flag"
+	"fmt"
 	"log"
 	"os"
 	"os/signal"
 	"syscall"
-	"time"
 
-	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/health"
+	platformconfig "github.com/at-kh/guru-apps-test-services/platform/config"
+	"github.com/at-kh/guru-apps-test-services/platform/health"
 	"github.com/at-kh/guru-apps-test-services/products-service/internal/app"
 	"github.com/at-kh/guru-apps-test-services/products-service/internal/config"
 	_ "github.com/jackc/pgx/v5/stdlib"
@@ -34,12 +35,18 @@ func main() {
 	ctx, cancel := registerGracefulShutdown()
 	defer cancel()
 
-	cfgPath := flag.String("c", config.DefaultPath, "configuration file")
+	var cfgPaths platformconfig.Paths
+	flag.Var(&cfgPaths, "c", "configuration file, repeat to overlay files (default "+config.DefaultPath+")")
+	flag.Usage = func() {
+		_, _ = fmt.Fprintf(flag.CommandLine.Output(),
+			"usage: %s [flags] [migrate <command> | seed | config print]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
 	flag.Parse()
 
-	app.New(
+	a := app.New(
 		app.Meta{
-			ConfigPath: *cfgPath,
+			ConfigPaths: cfgPaths,
 			Info: health.Info{
 				Name:         name,
 				BuildCommit:  commit,
@@ -47,10 +54,33 @@ func main() {
 				BuildVersion: version,
 			},
 		},
-	).WithMigrationFS(dbMigrationFS).Run(ctx)
+	).WithMigrationFS(dbMigrationFS)
+
+	switch flag.Arg(0) {
+	case "":
+		if err := a.Run(ctx); err != nil {
+			log.Fatalf("run: %v", err)
+		}
+	case "migrate":
+		if err := a.Migrate(ctx, flag.Args()[1:]); err != nil {
+			log.Fatalf("migrate: %v", err)
+		}
+	case "seed":
+		if err := a.Seed(ctx, flag.Args()[1:]); err != nil {
+			log.Fatalf("seed: %v", err)
+		}
+	case "config":
+		if err := a.Config(flag.Args()[1:]); err != nil {
+			log.Fatalf("config: %v", err)
+		}
+	default:
+		flag.Usage()
+		log.Fatalf("unknown command %q", flag.Arg(0))
+	}
 }
 
-// registerGracefulShutdown returns a context that is canceled on signals.
+// registerGracefulShutdown returns a context that is canceled on signals, components of the application
+// are stopped by their own deadlines, a second signal forces exit.
 func registerGracefulShutdown() (context.Context, context.CancelFunc) {
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -58,23 +88,14 @@ func registerGracefulShutdown() (context.Context, context.CancelFunc) {
 	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
 
 	go func() {
-		defer signal.Stop(signals)
-
 		sig := <-signals
 		log.Printf("[signal] received=%s action=graceful-shutdown", sig)
 
-		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 10*time.Second)
-
 		cancel()
 
-		select {
-		case sig2 := <-signals:
-			log.Printf("[signal] second received: forcing exit (%s)", sig2)
-			os.Exit(1)
-		case <-shutdownCtx.Done():
-			shutdownCancel()
-			return
-		}
+		sig = <-signals
+		log.Printf("[signal] second received: forcing exit (%s)", sig)
+		os.Exit(1)
 	}()
 
 	return ctx, cancel
@@ -1,21 +1,141 @@
 package delivery
 
-import "github.com/gofiber/fiber/v2"
+import (
+	"time"
+
+	productsv1 "github.com/at-kh/guru-apps-test-services/products-service/pkg/pb/products/v1"
+	"github.com/gofiber/fiber/v2"
+)
 
 type (
 	// HealthHTTPHandler - describes an interface for work with services health over HTTP.
 	HealthHTTPHandler interface {
 		// Health - handler for getting meta information endpoint.
 		Health(ctx *fiber.Ctx) error
+		// Ready - handler for readiness endpoint.
+		Ready(ctx *fiber.Ctx) error
+	}
+
+	// DocsHTTPHandler - describes an interface for serving API documentation over HTTP.
+	DocsHTTPHandler interface {
+		// OpenAPI - handler for getting OpenAPI document endpoint.
+		OpenAPI(ctx *fiber.Ctx) error
+		// UI - handler for getting docs UI endpoint.
+		UI(ctx *fiber.Ctx) error
 	}
 
 	// ProductsHTTPHandler - describes an interface for work with products over HTTP.
 	ProductsHTTPHandler interface {
 		// GetAll - handler for getting all products endpoint.
 		GetAll(ctx *fiber.Ctx) error
+		// GetAllByVendor - handler for getting all products of a vendor endpoint.
+		GetAllByVendor(ctx *fiber.Ctx) error
+		// GetByID - handler for getting product by id endpoint.
+		GetByID(ctx *fiber.Ctx) error
 		// Create - handler for creating product endpoint.
 		Create(ctx *fiber.Ctx) error
+		// Update - handler for updating product endpoint.
+		Update(ctx *fiber.Ctx) error
 		// Delete - handler for deleting product endpoint.
 		Delete(ctx *fiber.Ctx) error
+		// SetCacheMaxAge - sets how long reads may be cached by clients.
+		SetCacheMaxAge(cacheMaxAge time.Duration)
+	}
+
+	// VendorsHTTPHandler - describes an interface for work with vendors over HTTP.
+	VendorsHTTPHandler interface {
+		// GetAll - handler for getting all vendors endpoint.
+		GetAll(ctx *fiber.Ctx) error
+		// GetByID - handler for getting vendor by id endpoint.
+		GetByID(ctx *fiber.Ctx) error
+		// Create - handler for creating vendor endpoint.
+		Create(ctx *fiber.Ctx) error
+		// Update - handler for updating vendor endpoint.
+		Update(ctx *fiber.Ctx) error
+		// Delete - handler for deleting vendor endpoint.
+		Delete(ctx *fiber.Ctx) error
+	}
+
+	// CategoriesHTTPHandler - describes an interface for work with categories over HTTP.
+	CategoriesHTTPHandler interface {
+		// GetAll - handler for getting categories tree endpoint.
+		GetAll(ctx *fiber.Ctx) error
+		// GetByID - handler for getting category by id endpoint.
+		GetByID(ctx *fiber.Ctx) error
+		// Create - handler for creating category endpoint.
+		Create(ctx *fiber.Ctx) error
+		// Update - handler for updating category endpoint.
+		Update(ctx *fiber.Ctx) error
+		// Delete - handler for deleting category endpoint.
+		Delete(ctx *fiber.Ctx) error
+	}
+
+	// InventoryHTTPHandler - describes an interface for work with product stock over HTTP.
+	InventoryHTTPHandler interface {
+		// GetAll - handler for getting stock levels of a product endpoint.
+		GetAll(ctx *fiber.Ctx) error
+		// Adjust - handler for adjusting stock of a product endpoint.
+		Adjust(ctx *fiber.Ctx) error
+		// Reserve - handler for reserving stock of a product endpoint.
+		Reserve(ctx *fiber.Ctx) error
+		// Release - handler for releasing a reservation endpoint.
+		Release(ctx *fiber.Ctx) error
+	}
+
+	// ImagesHTTPHandler - describes an interface for work with product images over HTTP.
+	ImagesHTTPHandler interface {
+		// GetAll - handler for getting images of a product endpoint.
+		GetAll(ctx *fiber.Ctx) error
+		// Upload - handler for uploading image of a product endpoint.
+		Upload(ctx *fiber.Ctx) error
+		// Delete - handler for deleting image of a product endpoint.
+		Delete(ctx *fiber.Ctx) error
+		// SetMaxSizeBytes - sets max size of uploaded images.
+		SetMaxSizeBytes(maxSizeBytes int64)
+	}
+
+	// PricesHTTPHandler - describes an interface for work with product prices over HTTP.
+	PricesHTTPHandler interface {
+		// GetAll - handler for getting price history of a product endpoint.
+		GetAll(ctx *fiber.Ctx) error
+		// Create - handler for scheduling product price endpoint.
+		Create(ctx *fiber.Ctx) error
+	}
+
+	// CurrenciesHTTPHandler - describes an interface for work with currency rates over HTTP.
+	CurrenciesHTTPHandler interface {
+		// GetRates - handler for getting all currency rates endpoint.
+		GetRates(ctx *fiber.Ctx) error
+		// SetRate - handler for setting currency rate endpoint.
+		SetRate(ctx *fiber.Ctx) error
+	}
+
+	// ConfigsHTTPHandler - describes an interface for work with the configuration over HTTP.
+	ConfigsHTTPHandler interface {
+		// Get - handler for getting the effective configuration endpoint.
+		Get(ctx *fiber.Ctx) error
+		// Reload - handler for reloading the configuration endpoint.
+		Reload(ctx *fiber.Ctx) error
+	}
+
+	// EventsHTTPHandler - describes an interface for work with events of products over HTTP.
+	EventsHTTPHandler interface {
+		// Republish - handler for publishing events of products again endpoint.
+		Republish(ctx *fiber.Ctx) error
+	}
+
+	// ChangesHTTPHandler - describes an interface for streaming changes of products over HTTP.
+	ChangesHTTPHandler interface {
+		// Stream - handler for streaming changes of products endpoint.
+		Stream(ctx *fiber.Ctx) error
+		// Close - stops open streams.
+		Close() error
+		// SetMaxStreamsPerClient - sets max concurrent streams of a client, open streams are kept.
+		SetMaxStreamsPerClient(maxStreams int)
+	}
+
+	// ProductsGRPCHandler - describes an interface for work with products over gRPC.
+	ProductsGRPCHandler interface {
+		productsv1.ProductServiceServer
 	}
 )
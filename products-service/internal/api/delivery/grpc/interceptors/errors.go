@@ -0,0 +1,117 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/pgerrs"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// codesByHTTPStatus maps status codes of errs types to gRPC codes.
+var codesByHTTPStatus = map[int]codes.Code{
+	http.StatusBadRequest:              codes.InvalidArgument,
+	http.StatusUnauthorized:            codes.Unauthenticated,
+	http.StatusPaymentRequired:         codes.FailedPrecondition,
+	http.StatusForbidden:               codes.PermissionDenied,
+	http.StatusNotFound:                codes.NotFound,
+	http.StatusMethodNotAllowed:        codes.Unimplemented,
+	http.StatusNotAcceptable:           codes.InvalidArgument,
+	http.StatusRequestTimeout:          codes.DeadlineExceeded,
+	http.StatusConflict:                codes.FailedPrecondition,
+	http.StatusGone:                    codes.NotFound,
+	http.StatusRequestEntityTooLarge:   codes.ResourceExhausted,
+	http.StatusUnsupportedMediaType:    codes.InvalidArgument,
+	http.StatusTooManyRequests:         codes.ResourceExhausted,
+	http.StatusInternalServerError:     codes.Internal,
+	http.StatusNotImplemented:          codes.Unimplemented,
+	http.StatusBadGateway:              codes.Unavailable,
+	http.StatusServiceUnavailable:      codes.Unavailable,
+	http.StatusGatewayTimeout:          codes.DeadlineExceeded,
+	http.StatusHTTPVersionNotSupported: codes.Unimplemented,
+}
+
+// UnaryErrors converts errors of unary handlers to gRPC statuses.
+func UnaryErrors() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		return resp, Status(err)
+	}
+}
+
+// StreamErrors converts errors of streaming handlers to gRPC statuses.
+func StreamErrors() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return Status(handler(srv, ss))
+	}
+}
+
+// Status converts an error to gRPC status error, errs types are converted by their status codes the same way
+// as HTTP responses, field validation errors are detailed with errdetails.BadRequest. Conflicts are told apart
+// by their causes: duplicates are AlreadyExists and transactions to be retried are Aborted.
+func Status(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+
+	var validation errs.FieldsValidation
+	if errors.As(err, &validation) {
+		return validationStatus(validation)
+	}
+
+	if errors.As(err, &errs.AlreadyExists{}) {
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+	if errors.As(err, &pgerrs.TransactionConflict{}) {
+		return status.Error(codes.Aborted, err.Error())
+	}
+
+	var httpErr errs.HTTPError
+	if errors.As(err, &httpErr) {
+		code, ok := codesByHTTPStatus[httpErr.StatusCode()]
+		if !ok {
+			code = codes.Unknown
+		}
+
+		return status.Error(code, httpErr.Error())
+	}
+
+	return status.Error(codes.Internal, errs.Internal{Cause: err.Error()}.Error())
+}
+
+// validationStatus returns InvalidArgument status with a violation per invalid field.
+func validationStatus(err errs.FieldsValidation) error {
+	fields := err.Fields()
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(fields))
+	for _, f := range fields {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       f.Field,
+			Description: strings.TrimPrefix(f.String(), f.Field+"::"),
+			Reason:      f.Code,
+		})
+	}
+
+	st, detailsErr := status.New(codes.InvalidArgument, err.Error()).
+		WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if detailsErr != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return st.Err()
+}
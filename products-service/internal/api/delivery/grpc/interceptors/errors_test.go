@@ -0,0 +1,62 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/pgerrs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestStatus tests conversion of errors to gRPC statuses.
+func TestStatus(t *testing.T) {
+	cases := []struct {
+		err  error
+		code codes.Code
+	}{
+		{errs.BadRequest{Cause: "invalid product id"}, codes.InvalidArgument},
+		{errs.NotFound{What: "product"}, codes.NotFound},
+		{fmt.Errorf("get product: %w", errs.NotFound{What: "product"}), codes.NotFound},
+		{errs.Empty{}, codes.NotFound},
+		{errs.Conflict{What: "insufficient stock"}, codes.FailedPrecondition},
+		{fmt.Errorf("create product: %w", errs.AlreadyExists{What: "product already exists"}), codes.AlreadyExists},
+		{pgerrs.TransactionConflict{Cause: errors.New("deadlock detected")}, codes.Aborted},
+		{errs.TooManyRequests{}, codes.ResourceExhausted},
+		{errs.ServiceUnavailable{}, codes.Unavailable},
+		{errs.Internal{Cause: "boom"}, codes.Internal},
+		{errors.New("boom"), codes.Internal},
+		{context.Canceled, codes.Canceled},
+		{status.Error(codes.Aborted, "aborted"), codes.Aborted},
+	}
+
+	for _, c := range cases {
+		t.Run(c.err.Error(), func(t *testing.T) {
+			st, ok := status.FromError(Status(c.err))
+			require.True(t, ok)
+			assert.Equal(t, c.code, st.Code())
+		})
+	}
+
+	assert.NoError(t, Status(nil))
+}
+
+// TestStatusFieldsValidation tests that validation errors are detailed with field violations.
+func TestStatusFieldsValidation(t *testing.T) {
+	st, ok := status.FromError(Status(errs.FieldsValidation{Errors: []string{"name::is_required"}}))
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+
+	require.Len(t, st.Details(), 1)
+	details, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, details.GetFieldViolations(), 1)
+	assert.Equal(t, "name", details.GetFieldViolations()[0].GetField())
+	assert.Equal(t, "is_required", details.GetFieldViolations()[0].GetDescription())
+}
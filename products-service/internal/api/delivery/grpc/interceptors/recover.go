@@ -0,0 +1,37 @@
+package interceptors
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryRecover converts panics of unary handlers to Internal status, so a handler cannot stop the server.
+func UnaryRecover(log *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (resp any, err error) {
+		defer recoverPanic(log, info.FullMethod, &err)
+		return handler(ctx, req)
+	}
+}
+
+// StreamRecover converts panics of streaming handlers to Internal status.
+func StreamRecover(log *zap.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer recoverPanic(log, info.FullMethod, &err)
+		return handler(srv, ss)
+	}
+}
+
+// recoverPanic recovers a panic of a handler and sets err.
+func recoverPanic(log *zap.Logger, method string, err *error) {
+	if r := recover(); r != nil {
+		log.Error("gRPC handler panic", zap.String("method", method), zap.Any("panic", r), zap.Stack("stack"))
+		*err = status.Error(codes.Internal, fmt.Sprintf("panic: %v", r))
+	}
+}
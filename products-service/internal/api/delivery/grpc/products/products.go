@@ -0,0 +1,132 @@
+package products
+
+import (
+	"context"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery"
+	dproducts "github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/services"
+	productsv1 "github.com/at-kh/guru-apps-test-services/products-service/pkg/pb/products/v1"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var _ delivery.ProductsGRPCHandler = &Handler{}
+
+type (
+	// Handler defines a Handler for gRPC requests for working with products.
+	Handler struct {
+		productsv1.UnimplementedProductServiceServer
+
+		service services.ProductsService
+		log     *zap.Logger
+	}
+)
+
+// NewHandler defines a handler constructor.
+func NewHandler(service services.ProductsService, log *zap.Logger) *Handler {
+	return &Handler{
+		service: service,
+		log:     log,
+	}
+}
+
+// Create - create new product.
+func (h *Handler) Create(ctx context.Context, req *productsv1.CreateProductRequest) (*productsv1.Product, error) {
+	product, errsList := createToDomain(req)
+	if len(errsList) != 0 {
+		return nil, errs.FieldsValidation{Errors: errsList}
+	}
+
+	product, err := h.service.Create(ctx, product)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromDomain(product), nil
+}
+
+// Get - get product by id.
+func (h *Handler) Get(ctx context.Context, req *productsv1.GetProductRequest) (*productsv1.Product, error) {
+	productID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, errs.BadRequest{Cause: "invalid product id"}
+	}
+
+	currency, errsList := parseCurrency(req.GetCurrency())
+	if len(errsList) != 0 {
+		return nil, errs.FieldsValidation{Errors: errsList}
+	}
+
+	product, err := h.service.GetByID(ctx, productID, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromDomain(product), nil
+}
+
+// List - stream products matching the filter page by page, all of them when limit is 0.
+func (h *Handler) List(req *productsv1.ListProductsRequest, stream productsv1.ProductService_ListServer) error {
+	filter, currency, errsList := listToDomain(req)
+	if len(errsList) != 0 {
+		return errs.FieldsValidation{Errors: errsList}
+	}
+
+	ctx := stream.Context()
+	offset, remaining := req.GetOffset(), req.GetLimit()
+	for {
+		pageSize := uint64(dproducts.MaxLimit)
+		if req.GetLimit() > 0 {
+			pageSize = min(pageSize, remaining)
+		}
+
+		list, err := h.service.GetAll(ctx, pageSize, offset, filter, currency)
+		if err != nil {
+			return err
+		}
+
+		for _, product := range list.Products {
+			if err = stream.Send(fromDomain(product)); err != nil {
+				return err
+			}
+		}
+
+		offset += uint64(len(list.Products))
+		remaining -= min(remaining, uint64(len(list.Products)))
+		if uint64(len(list.Products)) < pageSize || offset >= list.Total || (req.GetLimit() > 0 && remaining == 0) {
+			return nil
+		}
+	}
+}
+
+// Update - update product by id, categories and tags are replaced with the given ones.
+func (h *Handler) Update(ctx context.Context, req *productsv1.UpdateProductRequest) (*productsv1.Product, error) {
+	product, errsList := updateToDomain(req)
+	if len(errsList) != 0 {
+		return nil, errs.FieldsValidation{Errors: errsList}
+	}
+
+	product, err := h.service.Update(ctx, product)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromDomain(product), nil
+}
+
+// Delete - delete product by id.
+func (h *Handler) Delete(ctx context.Context, req *productsv1.DeleteProductRequest) (*emptypb.Empty, error) {
+	productID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, errs.BadRequest{Cause: "invalid product id"}
+	}
+
+	if err = h.service.Delete(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
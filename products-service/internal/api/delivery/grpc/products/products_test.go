@@ -0,0 +1,139 @@
+package products
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery/grpc/interceptors"
+	dproducts "github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	productsv1 "github.com/at-kh/guru-apps-test-services/products-service/pkg/pb/products/v1"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeService is an in-memory products service.
+type fakeService struct {
+	products dproducts.Products
+}
+
+func (s *fakeService) Create(_ context.Context, e dproducts.Product) (dproducts.Product, error) {
+	e.ID = uuid.New()
+	s.products = append(s.products, e)
+	return e, nil
+}
+
+func (s *fakeService) Update(_ context.Context, e dproducts.Product) (dproducts.Product, error) {
+	return e, nil
+}
+
+func (s *fakeService) GetByID(_ context.Context, id uuid.UUID, _ string) (dproducts.Product, error) {
+	for _, p := range s.products {
+		if p.ID == id {
+			return p, nil
+		}
+	}
+	return dproducts.Product{}, errs.NotFound{What: "product"}
+}
+
+func (s *fakeService) GetAll(
+	_ context.Context, limit, offset uint64, _ dproducts.Filter, _ string,
+) (dproducts.ProductList, error) {
+	total := uint64(len(s.products))
+	start, end := min(offset, total), min(offset+limit, total)
+	return dproducts.ProductList{Total: total, Products: s.products[start:end]}, nil
+}
+
+func (s *fakeService) Delete(_ context.Context, _ uuid.UUID) error { return nil }
+
+// newTestClient serves the handler over an in-memory connection.
+func newTestClient(t *testing.T, service *fakeService) productsv1.ProductServiceClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(interceptors.UnaryErrors()),
+		grpc.ChainStreamInterceptor(interceptors.StreamErrors()),
+	)
+	productsv1.RegisterProductServiceServer(server, NewHandler(service, zap.NewNop()))
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return productsv1.NewProductServiceClient(conn)
+}
+
+// TestHandler tests create, get and validation and not found errors.
+func TestHandler(t *testing.T) {
+	client := newTestClient(t, &fakeService{})
+	ctx := context.Background()
+
+	created, err := client.Create(ctx, &productsv1.CreateProductRequest{
+		Name: "Laptop", Vendor: "Acme", Price: "1299.99", Currency: "eur", Tags: []string{" Sale "},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1299.99", created.GetPrice())
+	assert.Equal(t, "EUR", created.GetCurrency())
+	assert.Equal(t, []string{"sale"}, created.GetTags())
+
+	got, err := client.Get(ctx, &productsv1.GetProductRequest{Id: created.GetId()})
+	require.NoError(t, err)
+	assert.Equal(t, created.GetId(), got.GetId())
+
+	_, err = client.Get(ctx, &productsv1.GetProductRequest{Id: uuid.NewString()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = client.Create(ctx, &productsv1.CreateProductRequest{Name: " ", Price: "abc"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "name::is_required")
+	assert.Contains(t, status.Convert(err).Message(), "price::invalid_format")
+	assert.Contains(t, status.Convert(err).Message(), "vendor::is_required")
+
+	_, err = client.Create(ctx, &productsv1.CreateProductRequest{Name: "Laptop", Vendor: "Acme", Price: "1.999"})
+	assert.Contains(t, status.Convert(err).Message(), "price::max_decimals_is::2")
+}
+
+// TestHandlerList tests that products are streamed page by page.
+func TestHandlerList(t *testing.T) {
+	service := &fakeService{}
+	for range dproducts.MaxLimit + 5 {
+		service.products = append(service.products, dproducts.Product{ID: uuid.New(), Price: decimal.NewFromInt(1)})
+	}
+	client := newTestClient(t, service)
+
+	count := func(req *productsv1.ListProductsRequest) int {
+		stream, err := client.List(context.Background(), req)
+		require.NoError(t, err)
+
+		var n int
+		for {
+			_, err = stream.Recv()
+			if err == io.EOF {
+				return n
+			}
+			require.NoError(t, err)
+			n++
+		}
+	}
+
+	assert.Equal(t, dproducts.MaxLimit+5, count(&productsv1.ListProductsRequest{}))
+	assert.Equal(t, 3, count(&productsv1.ListProductsRequest{Limit: 3}))
+	assert.Equal(t, 5, count(&productsv1.ListProductsRequest{Offset: dproducts.MaxLimit}))
+	assert.Equal(t, dproducts.MaxLimit+2, count(&productsv1.ListProductsRequest{Limit: dproducts.MaxLimit + 2}))
+}
@@ -0,0 +1,193 @@
+package products
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/currencies"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	productsv1 "github.com/at-kh/guru-apps-test-services/products-service/pkg/pb/products/v1"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Limits of fields, the same as of the HTTP API.
+const (
+	maxNameLength        = 255
+	maxDescriptionLength = 10000
+)
+
+// maxPrice is the max price of a product, the same as of the HTTP API.
+var maxPrice = decimal.NewFromInt(1000000)
+
+// createToDomain validates a create request and converts it to domain model.
+func createToDomain(req *productsv1.CreateProductRequest) (products.Product, []string) {
+	p := products.Product{
+		Name:        strings.TrimSpace(req.GetName()),
+		Vendor:      strings.TrimSpace(req.GetVendor()),
+		Description: strings.TrimSpace(req.GetDescription()),
+		Tags:        products.NormalizeTags(req.GetTags()),
+	}
+
+	errs := validateCommon(p.Name, p.Vendor, p.Description, req.GetTags())
+
+	var vendorErrs []string
+	p.VendorID, vendorErrs = parseVendorID(req.GetVendorId(), p.Vendor)
+	errs = append(errs, vendorErrs...)
+
+	var categoryErrs []string
+	p.CategoryIDs, categoryErrs = parseCategoryIDs(req.GetCategoryIds())
+	errs = append(errs, categoryErrs...)
+
+	price, err := decimal.NewFromString(req.GetPrice())
+	switch {
+	case req.GetPrice() == "":
+		errs = append(errs, "price::is_required")
+	case err != nil:
+		errs = append(errs, "price::invalid_format")
+	case price.IsNegative():
+		errs = append(errs, "price::min_value_is::0")
+	case price.GreaterThan(maxPrice):
+		errs = append(errs, "price::max_value_is::"+maxPrice.String())
+	case !price.Equal(price.Truncate(products.PriceDecimals)):
+		errs = append(errs, "price::max_decimals_is::"+strconv.Itoa(products.PriceDecimals))
+	}
+	p.Price = price
+
+	var currencyErrs []string
+	p.Currency, currencyErrs = parseCurrency(req.GetCurrency())
+	errs = append(errs, currencyErrs...)
+
+	return p, errs
+}
+
+// updateToDomain validates an update request and converts it to domain model.
+func updateToDomain(req *productsv1.UpdateProductRequest) (products.Product, []string) {
+	p := products.Product{
+		Name:        strings.TrimSpace(req.GetName()),
+		Vendor:      strings.TrimSpace(req.GetVendor()),
+		Description: strings.TrimSpace(req.GetDescription()),
+		Tags:        products.NormalizeTags(req.GetTags()),
+	}
+
+	var errs []string
+	var err error
+	if p.ID, err = uuid.Parse(req.GetId()); err != nil {
+		errs = append(errs, "id::invalid_format")
+	}
+
+	errs = append(errs, validateCommon(p.Name, p.Vendor, p.Description, req.GetTags())...)
+
+	var vendorErrs []string
+	p.VendorID, vendorErrs = parseVendorID(req.GetVendorId(), p.Vendor)
+	errs = append(errs, vendorErrs...)
+
+	var categoryErrs []string
+	p.CategoryIDs, categoryErrs = parseCategoryIDs(req.GetCategoryIds())
+	errs = append(errs, categoryErrs...)
+
+	return p, errs
+}
+
+// listToDomain validates a list request and converts it to domain filter.
+func listToDomain(req *productsv1.ListProductsRequest) (products.Filter, string, []string) {
+	var (
+		filter products.Filter
+		errs   []string
+	)
+
+	if req.GetCategoryId() != "" {
+		id, err := uuid.Parse(req.GetCategoryId())
+		if err != nil {
+			errs = append(errs, "category_id::invalid_format")
+		}
+		filter.CategoryID = &id
+	}
+	if req.GetVendorId() != "" {
+		id, err := uuid.Parse(req.GetVendorId())
+		if err != nil {
+			errs = append(errs, "vendor_id::invalid_format")
+		}
+		filter.VendorID = &id
+	}
+	filter.Tag = products.NormalizeTag(req.GetTag())
+
+	currency, currencyErrs := parseCurrency(req.GetCurrency())
+	errs = append(errs, currencyErrs...)
+
+	return filter, currency, errs
+}
+
+// parseCurrency returns normalized currency, empty currency means no conversion.
+func parseCurrency(code string) (string, []string) {
+	currency := currencies.Normalize(code)
+	if currency != "" && !currencies.IsValidCode(currency) {
+		return "", []string{"currency::invalid_format"}
+	}
+
+	return currency, nil
+}
+
+// validateCommon validates fields shared by create and update requests.
+func validateCommon(name, vendor, description string, tags []string) []string {
+	var errs []string
+	if name == "" {
+		errs = append(errs, "name::is_required")
+	}
+	if utf8.RuneCountInString(name) > maxNameLength {
+		errs = append(errs, "name::max_length_is::"+strconv.Itoa(maxNameLength))
+	}
+	if utf8.RuneCountInString(vendor) > maxNameLength {
+		errs = append(errs, "vendor::max_length_is::"+strconv.Itoa(maxNameLength))
+	}
+	if utf8.RuneCountInString(description) > maxDescriptionLength {
+		errs = append(errs, "description::max_length_is::"+strconv.Itoa(maxDescriptionLength))
+	}
+
+	if len(tags) > products.MaxTags {
+		errs = append(errs, "tags::max_items_is::"+strconv.Itoa(products.MaxTags))
+	}
+	for _, tag := range tags {
+		tag = products.NormalizeTag(tag)
+		if tag == "" {
+			errs = append(errs, "tags::item_is_required")
+		}
+		if utf8.RuneCountInString(tag) > products.MaxTagLength {
+			errs = append(errs, "tags::item_max_length_is::"+strconv.Itoa(products.MaxTagLength))
+		}
+	}
+
+	return errs
+}
+
+// parseVendorID parses vendor id, uuid.Nil means the vendor is referenced by name.
+func parseVendorID(id, name string) (uuid.UUID, []string) {
+	if id == "" {
+		if strings.TrimSpace(name) == "" {
+			return uuid.Nil, []string{"vendor::is_required"}
+		}
+		return uuid.Nil, nil
+	}
+
+	vendorID, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, []string{"vendor_id::invalid_format"}
+	}
+
+	return vendorID, nil
+}
+
+// parseCategoryIDs parses category ids.
+func parseCategoryIDs(ids []string) ([]uuid.UUID, []string) {
+	result := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		categoryID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, []string{"category_ids::invalid_format"}
+		}
+		result = append(result, categoryID)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,41 @@
+package products
+
+import (
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	productsv1 "github.com/at-kh/guru-apps-test-services/products-service/pkg/pb/products/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fromDomain converts domain model to response message.
+func fromDomain(p products.Product) *productsv1.Product {
+	categoryIDs := make([]string, 0, len(p.CategoryIDs))
+	for _, id := range p.CategoryIDs {
+		categoryIDs = append(categoryIDs, id.String())
+	}
+
+	imgs := make([]*productsv1.Image, 0, len(p.Images))
+	for _, img := range p.Images {
+		imgs = append(imgs, &productsv1.Image{
+			Id:          img.ID.String(),
+			ContentType: img.ContentType,
+			Size:        img.Size,
+			Checksum:    img.Checksum,
+			Url:         img.URL,
+		})
+	}
+
+	return &productsv1.Product{
+		Id:          p.ID.String(),
+		Name:        p.Name,
+		VendorId:    p.VendorID.String(),
+		Vendor:      p.Vendor,
+		Description: p.Description,
+		Price:       p.Price.String(),
+		Currency:    p.Currency,
+		CategoryIds: categoryIDs,
+		Tags:        p.Tags,
+		Images:      imgs,
+		CreatedAt:   timestamppb.New(p.CreatedAt),
+		UpdatedAt:   timestamppb.New(p.UpdatedAt),
+	}
+}
@@ -0,0 +1,129 @@
+package categories
+
+import (
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/platform/responder"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/services"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ delivery.CategoriesHTTPHandler = &Handler{}
+
+type (
+	// Handler defines a Handler for HTTP requests for categories.
+	Handler struct {
+		responder.Responder
+
+		service services.CategoriesService
+		log     *zap.Logger
+	}
+)
+
+// NewHandler - create new handler.
+func NewHandler(responder responder.Responder, service services.CategoriesService, log *zap.Logger) *Handler {
+	return &Handler{
+		Responder: responder,
+		service:   service,
+		log:       log.With(zap.String("http_handler", "categories")),
+	}
+}
+
+// Create - create new category:
+//   - POST /categories
+func (h Handler) Create(ctx *fiber.Ctx) error {
+	var req categoryRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return errs.BadRequest{Cause: "invalid JSON body"}
+	}
+
+	if errsList := req.Validate(); len(errsList) != 0 {
+		return errs.FieldsValidation{Errors: errsList}
+	}
+
+	category, err := h.service.Create(ctx.Context(), req.toDomain(uuid.Nil))
+	if err != nil {
+		return err
+	}
+
+	return h.Respond(ctx, fiber.StatusCreated, fromDomain(category))
+}
+
+// GetAll - get categories as a tree:
+//   - GET /categories
+func (h Handler) GetAll(ctx *fiber.Ctx) error {
+	list, err := h.service.GetAll(ctx.Context())
+	if err != nil {
+		return err
+	}
+
+	return h.Respond(ctx, fiber.StatusOK, fromDomainTree(list))
+}
+
+// GetByID - get category by id:
+//   - GET /categories/:id
+func (h Handler) GetByID(ctx *fiber.Ctx) error {
+	categoryID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return errs.BadRequest{Cause: "invalid category id"}
+	}
+
+	category, err := h.service.GetByID(ctx.Context(), categoryID)
+	if err != nil {
+		return err
+	}
+
+	return h.Respond(ctx, fiber.StatusOK, fromDomain(category))
+}
+
+// Update - update name and parent of a category:
+//   - PUT /categories/:id
+func (h Handler) Update(ctx *fiber.Ctx) error {
+	categoryID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return errs.BadRequest{Cause: "invalid category id"}
+	}
+
+	var req categoryRequest
+	if err = ctx.BodyParser(&req); err != nil {
+		return errs.BadRequest{Cause: "invalid JSON body"}
+	}
+
+	if errsList := req.Validate(); len(errsList) != 0 {
+		return errs.FieldsValidation{Errors: errsList}
+	}
+
+	category, err := h.service.Update(ctx.Context(), req.toDomain(categoryID))
+	if err != nil {
+		return err
+	}
+
+	return h.Respond(ctx, fiber.StatusOK, fromDomain(category))
+}
+
+// Delete - delete category by id, products and subcategories are moved to reassign_to when it is set:
+//   - DELETE /categories/:id
+//   - DELETE /categories/:id?reassign_to=9b1deb4d-3b7d-4bad-9bdd-2b0d7b3dcb6d
+func (h Handler) Delete(ctx *fiber.Ctx) error {
+	categoryID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return errs.BadRequest{Cause: "invalid category id"}
+	}
+
+	var reassignTo *uuid.UUID
+	if to := ctx.Query("reassign_to"); to != "" {
+		toID, parseErr := uuid.Parse(to)
+		if parseErr != nil {
+			return errs.BadRequest{Cause: "invalid reassign_to category id"}
+		}
+		reassignTo = &toID
+	}
+
+	if err = h.service.Delete(ctx.Context(), categoryID, reassignTo); err != nil {
+		return err
+	}
+
+	return h.RespondEmpty(ctx, fiber.StatusNoContent)
+}
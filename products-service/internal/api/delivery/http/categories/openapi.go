@@ -0,0 +1,46 @@
+package categories
+
+import (
+	"net/http"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/openapi"
+	"github.com/google/uuid"
+)
+
+// Endpoints describes handlers of categories for the OpenAPI document.
+var Endpoints = map[string]openapi.Endpoint{
+	"GetAll": {
+		Summary:  "Get categories tree",
+		Status:   http.StatusOK,
+		Response: categoryTreeResponse{},
+	},
+	"GetByID": {
+		Summary:  "Get a category",
+		Status:   http.StatusOK,
+		Response: categoryResponse{},
+		Errors:   []int{http.StatusBadRequest, http.StatusNotFound},
+	},
+	"Create": {
+		Summary:  "Create a category",
+		Request:  categoryRequest{},
+		Status:   http.StatusCreated,
+		Response: categoryResponse{},
+		Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict},
+	},
+	"Update": {
+		Summary:  "Rename or move a category",
+		Request:  categoryRequest{},
+		Status:   http.StatusOK,
+		Response: categoryResponse{},
+		Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict},
+	},
+	"Delete": {
+		Summary:     "Delete a category",
+		Description: "A category with products or subcategories can be deleted only with `reassign_to`.",
+		Query: []openapi.Parameter{
+			openapi.QueryParam("reassign_to", "Category to move products and subcategories to", uuid.UUID{}),
+		},
+		Status: http.StatusNoContent,
+		Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict},
+	},
+}
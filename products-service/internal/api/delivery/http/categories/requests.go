@@ -0,0 +1,25 @@
+package categories
+
+import (
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/categories"
+	"github.com/google/uuid"
+)
+
+//go:generate go-validator
+
+type (
+	// categoryRequest - request model for creation and update.
+	categoryRequest struct {
+		Name     string     `json:"name" valid:"required,max=255"`
+		ParentID *uuid.UUID `json:"parent_id"`
+	}
+)
+
+// toDomain converts request model to domain model.
+func (r categoryRequest) toDomain(id uuid.UUID) categories.Category {
+	return categories.Category{
+		ID:       id,
+		ParentID: r.ParentID,
+		Name:     r.Name,
+	}
+}
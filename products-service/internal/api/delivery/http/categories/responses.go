@@ -0,0 +1,66 @@
+package categories
+
+import (
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/categories"
+	"github.com/google/uuid"
+)
+
+type (
+	// categoryResponse is a response for a category.
+	categoryResponse struct {
+		ID        uuid.UUID          `json:"id"`
+		ParentID  *uuid.UUID         `json:"parent_id"`
+		Name      string             `json:"name"`
+		CreatedAt time.Time          `json:"created_at"`
+		UpdatedAt time.Time          `json:"updated_at"`
+		Children  []categoryResponse `json:"children,omitempty"`
+	}
+
+	// categoryTreeResponse is a response for the categories tree.
+	categoryTreeResponse struct {
+		Categories []categoryResponse `json:"categories"`
+	}
+)
+
+// fromDomain converts domain model to response model.
+func fromDomain(c categories.Category) categoryResponse {
+	return categoryResponse{
+		ID:        c.ID,
+		ParentID:  c.ParentID,
+		Name:      c.Name,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
+
+// fromDomainTree converts flat list of categories to the tree of root categories with nested children.
+func fromDomainTree(list categories.Categories) categoryTreeResponse {
+	children := make(map[uuid.UUID]categories.Categories, len(list))
+	roots := make(categories.Categories, 0, len(list))
+	for _, c := range list {
+		if c.ParentID == nil {
+			roots = append(roots, c)
+			continue
+		}
+		children[*c.ParentID] = append(children[*c.ParentID], c)
+	}
+
+	var build func(c categories.Category) categoryResponse
+	build = func(c categories.Category) categoryResponse {
+		node := fromDomain(c)
+		for _, child := range children[c.ID] {
+			node.Children = append(node.Children, build(child))
+		}
+
+		return node
+	}
+
+	result := make([]categoryResponse, 0, len(roots))
+	for _, root := range roots {
+		result = append(result, build(root))
+	}
+
+	return categoryTreeResponse{Categories: result}
+}
@@ -0,0 +1,256 @@
+package changes
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/platform/responder"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/changes"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/services"
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/openapi"
+	"github.com/fasthttp/websocket"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"github.com/valyala/fasthttp"
+	"go.uber.org/zap"
+)
+
+var _ delivery.ChangesHTTPHandler = &Handler{}
+
+const (
+	// HeaderLastEventID - header of the id of the last event received by a reconnecting client.
+	HeaderLastEventID = "Last-Event-ID"
+	// QueryLastEventID - query parameter of the id of the last event, e.g. for WebSocket clients, which
+	// can't set headers.
+	QueryLastEventID = "last_event_id"
+)
+
+// retryDelay - reconnection delay suggested to clients of Server-Sent Events.
+const retryDelay = 3 * time.Second
+
+type (
+	// Handler defines a Handler for HTTP requests for the feed of changes of products.
+	Handler struct {
+		responder.Responder
+
+		service  services.ChangesService
+		opts     Options
+		limiter  *limiter
+		upgrader *websocket.FastHTTPUpgrader
+		done     chan struct{} // closed when streams are stopped
+		stop     *sync.Once
+		log      *zap.Logger
+	}
+
+	// Options - options of streams of changes.
+	Options struct {
+		PollInterval        time.Duration // interval of checks of new changes
+		HeartbeatInterval   time.Duration // interval of heartbeats, which detect closed connections
+		WriteTimeout        time.Duration // deadline of a write of an event
+		MaxStreamsPerClient int           // concurrent streams of a client IP
+	}
+)
+
+// NewHandler - create new handler.
+func NewHandler(
+	responder responder.Responder,
+	service services.ChangesService,
+	opts Options,
+	log *zap.Logger,
+) *Handler {
+	return &Handler{
+		Responder: responder,
+		service:   service,
+		opts:      opts,
+		limiter:   newLimiter(opts.MaxStreamsPerClient),
+		upgrader: &websocket.FastHTTPUpgrader{
+			// the feed is as public as the catalog, so pages of any origin may follow it
+			CheckOrigin: func(*fasthttp.RequestCtx) bool { return true },
+		},
+		done: make(chan struct{}),
+		stop: &sync.Once{},
+		log:  log.With(zap.String("http_handler", "changes")),
+	}
+}
+
+// SetMaxStreamsPerClient sets max concurrent streams of a client, open streams are kept.
+func (h Handler) SetMaxStreamsPerClient(maxStreams int) { h.limiter.setMax(maxStreams) }
+
+// Stream - stream committed changes of products as Server-Sent Events, or as WebSocket messages when
+// the connection is upgraded:
+//   - GET /products/events
+func (h Handler) Stream(ctx *fiber.Ctx) error {
+	after, err := h.position(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the address forwarded by trusted proxies when the proxy header is configured, so clients behind them
+	// don't share the limit of the proxy. It is copied as it points into the request buffer, which is reused
+	// before streams end and release it
+	client := utils.CopyString(ctx.IP())
+	if !h.limiter.acquire(client) {
+		return errs.TooManyRequests{Cause: "too many change streams of the client"}
+	}
+
+	fctx := ctx.Context()
+	if websocket.FastHTTPIsWebSocketUpgrade(fctx) {
+		err = h.upgrader.Upgrade(fctx, func(conn *websocket.Conn) {
+			defer h.limiter.release(client)
+			h.streamMessages(conn, after)
+		})
+		if err != nil {
+			h.limiter.release(client)
+			return errs.BadRequest{Cause: err.Error()}
+		}
+
+		return nil
+	}
+
+	// the stream writer outlives the request context, so the connection is captured beforehand
+	conn := fctx.Conn()
+	ctx.Set(fiber.HeaderContentType, openapi.MIMETextEventStream)
+	ctx.Set(fiber.HeaderCacheControl, "no-cache")
+	ctx.Set("X-Accel-Buffering", "no") // proxies must not buffer the stream
+	fctx.SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer h.limiter.release(client)
+		h.streamEvents(conn, w, after)
+	})
+
+	return nil
+}
+
+// Close stops streams, clients resume them by ids of their last events, e.g. from other instances.
+func (h Handler) Close() error {
+	h.stop.Do(func() { close(h.done) })
+	return nil
+}
+
+// position returns the position the stream starts after: the id of the last event received by the client, or
+// the latest position, so only changes committed from now on are streamed.
+func (h Handler) position(ctx *fiber.Ctx) (changes.Position, error) {
+	lastEventID := ctx.Get(HeaderLastEventID)
+	if lastEventID == "" {
+		lastEventID = ctx.Query(QueryLastEventID)
+	}
+
+	if lastEventID == "" {
+		return h.service.Latest(ctx.Context())
+	}
+
+	position, err := changes.ParsePosition(lastEventID)
+	if err != nil {
+		return changes.Position{}, errs.BadRequest{Cause: "invalid last event id"}
+	}
+
+	return position, nil
+}
+
+// streamEvents writes changes as Server-Sent Events until the stream is stopped or the connection fails.
+func (h Handler) streamEvents(conn net.Conn, w *bufio.Writer, after changes.Position) {
+	ctx, cancel := h.streamContext()
+	defer cancel()
+
+	s := eventSink{conn: conn, w: w, timeout: h.opts.WriteTimeout}
+	if err := s.retry(retryDelay); err != nil {
+		return
+	}
+
+	h.follow(ctx, after, s)
+}
+
+// streamMessages writes changes as WebSocket messages until the stream is stopped or the connection fails.
+func (h Handler) streamMessages(conn *websocket.Conn, after changes.Position) {
+	defer func() { _ = conn.Close() }()
+
+	ctx, cancel := h.streamContext()
+	defer cancel()
+
+	// messages of clients are not expected, they are read to handle pongs and closes
+	go func() {
+		defer cancel()
+
+		extend := func(string) error {
+			return conn.SetReadDeadline(time.Now().Add(2*h.opts.HeartbeatInterval + h.opts.WriteTimeout))
+		}
+		_ = extend("")
+		conn.SetPongHandler(extend)
+		conn.SetReadLimit(512)
+
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	h.follow(ctx, after, messageSink{conn: conn, timeout: h.opts.WriteTimeout})
+
+	closing := websocket.FormatCloseMessage(websocket.CloseGoingAway, "resume from the last event id")
+	_ = conn.WriteControl(websocket.CloseMessage, closing, time.Now().Add(h.opts.WriteTimeout))
+}
+
+// streamContext returns a context of a stream, it is canceled when streams are stopped.
+func (h Handler) streamContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		select {
+		case <-h.done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return ctx, cancel
+}
+
+// follow sends changes after a position to a sink as they are committed until ctx is done or the sink fails.
+// Heartbeats are sent every heartbeat interval, so closed connections are detected by failed writes.
+func (h Handler) follow(ctx context.Context, after changes.Position, s sink) {
+	poll := time.NewTicker(h.opts.PollInterval)
+	defer poll.Stop()
+
+	lastHeartbeat := time.Now()
+	for {
+		items, err := h.service.GetAfter(ctx, after)
+		if err != nil {
+			if ctx.Err() == nil {
+				h.log.Error("change stream is stopped by failed read of changes", zap.Error(err))
+			}
+			return
+		}
+
+		for _, c := range items {
+			if err = s.send(c); err != nil {
+				return
+			}
+			after = c.Position
+		}
+
+		if time.Since(lastHeartbeat) >= h.opts.HeartbeatInterval {
+			if err = s.heartbeat(); err != nil {
+				return
+			}
+			lastHeartbeat = time.Now()
+		}
+
+		if len(items) == changes.MaxBatch {
+			// more changes are waiting
+			if ctx.Err() != nil {
+				return
+			}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-poll.C:
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package changes
+
+import "sync"
+
+// limiter - limits concurrent streams of clients.
+type limiter struct {
+	mu      sync.Mutex
+	max     int
+	streams map[string]int // open streams by clients
+}
+
+// newLimiter creates a limiter of max streams per client.
+func newLimiter(maxStreams int) *limiter {
+	return &limiter{max: maxStreams, streams: make(map[string]int)}
+}
+
+// setMax sets max streams per client, streams open above it are kept until they are released.
+func (l *limiter) setMax(maxStreams int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.max = maxStreams
+}
+
+// acquire reserves a stream of a client, it reports false when the client has max streams open.
+func (l *limiter) acquire(client string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.streams[client] >= l.max {
+		return false
+	}
+	l.streams[client]++
+
+	return true
+}
+
+// release frees a stream of a client.
+func (l *limiter) release(client string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.streams[client]--; l.streams[client] <= 0 {
+		delete(l.streams, client)
+	}
+}
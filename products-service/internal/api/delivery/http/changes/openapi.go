@@ -0,0 +1,28 @@
+package changes
+
+import (
+	"net/http"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/openapi"
+)
+
+// Endpoints describes handlers of changes of products for the OpenAPI document.
+var Endpoints = map[string]openapi.Endpoint{
+	"Stream": {
+		Summary: "Stream changes of products",
+		Description: "Server-Sent Events of products created, updated and deleted, sent as they are committed. " +
+			"Events are named by types of changes (`create_product`, `update_product`, `delete_product`), their " +
+			"data is the change with the current state of the product, which is omitted when it is deleted since. " +
+			"Streams start with changes committed after the connection unless `Last-Event-ID` (or `last_event_id`) " +
+			"is set, then they resume after that event. The same messages are sent over WebSocket when the " +
+			"connection is upgraded. Clients may open a limited number of concurrent streams.",
+		Query: []openapi.Parameter{
+			openapi.HeaderParam(HeaderLastEventID, "Id of the last received event to resume after", ""),
+			openapi.QueryParam(QueryLastEventID, "Same as `Last-Event-ID` for clients which can't set it", ""),
+		},
+		Status:       http.StatusOK,
+		Response:     changeResponse{},
+		ResponseType: openapi.MIMETextEventStream,
+		Errors:       []int{http.StatusBadRequest, http.StatusTooManyRequests},
+	},
+}
@@ -0,0 +1,69 @@
+package changes
+
+import (
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/changes"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type (
+	// changeResponse is a response for a change of a product, it is the data of an event of the stream.
+	changeResponse struct {
+		ID        string           `json:"id"`
+		Type      string           `json:"type"`
+		ProductID uuid.UUID        `json:"product_id"`
+		ChangedAt time.Time        `json:"changed_at"`
+		Product   *productResponse `json:"product,omitempty"`
+	}
+
+	// productResponse is a response for the current state of a changed product, images are not included.
+	productResponse struct {
+		ID          uuid.UUID       `json:"id"`
+		Name        string          `json:"name"`
+		VendorID    uuid.UUID       `json:"vendor_id"`
+		Vendor      string          `json:"vendor"`
+		Description string          `json:"description"`
+		Price       decimal.Decimal `json:"price"`
+		Currency    string          `json:"currency"`
+		CategoryIDs []uuid.UUID     `json:"category_ids"`
+		Tags        []string        `json:"tags"`
+		CreatedAt   time.Time       `json:"created_at"`
+		UpdatedAt   time.Time       `json:"updated_at"`
+	}
+)
+
+// fromDomain converts domain model to response model.
+func fromDomain(c changes.Change) changeResponse {
+	resp := changeResponse{
+		ID:        c.Position.String(),
+		Type:      c.Type,
+		ProductID: c.ProductID,
+		ChangedAt: c.ChangedAt,
+	}
+
+	if c.Product != nil {
+		resp.Product = fromDomainProduct(*c.Product)
+	}
+
+	return resp
+}
+
+// fromDomainProduct converts domain model to response model.
+func fromDomainProduct(p products.Product) *productResponse {
+	return &productResponse{
+		ID:          p.ID,
+		Name:        p.Name,
+		VendorID:    p.VendorID,
+		Vendor:      p.Vendor,
+		Description: p.Description,
+		Price:       p.Price,
+		Currency:    p.Currency,
+		CategoryIDs: p.CategoryIDs,
+		Tags:        p.Tags,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+	}
+}
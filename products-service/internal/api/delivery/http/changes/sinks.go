@@ -0,0 +1,81 @@
+package changes
+
+import (
+	"bufio"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/changes"
+	"github.com/fasthttp/websocket"
+	"github.com/goccy/go-json"
+)
+
+type (
+	// sink - writes changes and heartbeats to a stream.
+	sink interface {
+		send(c changes.Change) error
+		heartbeat() error
+	}
+
+	// eventSink - writes Server-Sent Events, ids of events are positions of changes and their names are
+	// types of changes.
+	eventSink struct {
+		conn    net.Conn // the connection of w, its write deadline is set before writes
+		w       *bufio.Writer
+		timeout time.Duration
+	}
+
+	// messageSink - writes WebSocket text messages of changes in JSON, heartbeats are pings.
+	messageSink struct {
+		conn    *websocket.Conn
+		timeout time.Duration
+	}
+)
+
+// send writes an event of a change.
+func (s eventSink) send(c changes.Change) error {
+	data, err := json.Marshal(fromDomain(c))
+	if err != nil {
+		return err
+	}
+
+	return s.write("id: " + c.Position.String() + "\nevent: " + c.Type + "\ndata: " + string(data) + "\n\n")
+}
+
+// heartbeat writes a comment, which is ignored by clients.
+func (s eventSink) heartbeat() error {
+	return s.write(": heartbeat\n\n")
+}
+
+// retry writes the reconnection delay of clients.
+func (s eventSink) retry(delay time.Duration) error {
+	return s.write("retry: " + strconv.FormatInt(delay.Milliseconds(), 10) + "\n\n")
+}
+
+// write writes a chunk of the stream and flushes it to the client.
+func (s eventSink) write(chunk string) error {
+	if err := s.conn.SetWriteDeadline(time.Now().Add(s.timeout)); err != nil {
+		return err
+	}
+
+	if _, err := s.w.WriteString(chunk); err != nil {
+		return err
+	}
+
+	return s.w.Flush()
+}
+
+// send writes a message of a change.
+func (s messageSink) send(c changes.Change) error {
+	if err := s.conn.SetWriteDeadline(time.Now().Add(s.timeout)); err != nil {
+		return err
+	}
+
+	return s.conn.WriteJSON(fromDomain(c))
+}
+
+// heartbeat writes a ping, the client responds by a pong, which extends the read deadline.
+func (s messageSink) heartbeat() error {
+	return s.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(s.timeout))
+}
@@ -0,0 +1,28 @@
+// Package configs describes handlers of the configuration of platform/reload for the OpenAPI document.
+package configs
+
+import (
+	"net/http"
+
+	"github.com/at-kh/guru-apps-test-services/platform/reload"
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/openapi"
+)
+
+// Endpoints describes handlers of the configuration for the OpenAPI document.
+var Endpoints = map[string]openapi.Endpoint{
+	"Get": {
+		Summary:     "Get the effective configuration",
+		Description: "Secrets are redacted. The last reload is omitted until the configuration is reloaded.",
+		Status:      http.StatusOK,
+		Response:    reload.StateResponse{},
+	},
+	"Reload": {
+		Summary: "Reload the configuration",
+		Description: "Log level, replica max lag, max image size, max-age of cached product reads and max change " +
+			"streams per client are applied without restart. Changes of other settings are reported as rejected " +
+			"and ignored until restart.",
+		Status:   http.StatusOK,
+		Response: reload.ReloadResponse{},
+		Errors:   []int{http.StatusBadRequest},
+	},
+}
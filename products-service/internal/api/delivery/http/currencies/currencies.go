@@ -0,0 +1,68 @@
+package currencies
+
+import (
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/platform/responder"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/currencies"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/services"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+var _ delivery.CurrenciesHTTPHandler = &Handler{}
+
+type (
+	// Handler defines a Handler for HTTP requests for currency rates.
+	Handler struct {
+		responder.Responder
+
+		service services.CurrenciesService
+		log     *zap.Logger
+	}
+)
+
+// NewHandler - create new handler.
+func NewHandler(responder responder.Responder, service services.CurrenciesService, log *zap.Logger) *Handler {
+	return &Handler{
+		Responder: responder,
+		service:   service,
+		log:       log.With(zap.String("http_handler", "currencies")),
+	}
+}
+
+// GetRates - get all currency rates:
+//   - GET /admin/currency-rates
+func (h Handler) GetRates(ctx *fiber.Ctx) error {
+	rates, err := h.service.GetRates(ctx.Context())
+	if err != nil {
+		return err
+	}
+
+	return h.Respond(ctx, fiber.StatusOK, fromDomainList(rates))
+}
+
+// SetRate - create or update currency rate:
+//   - PUT /admin/currency-rates/:currency
+func (h Handler) SetRate(ctx *fiber.Ctx) error {
+	currency := currencies.Normalize(ctx.Params("currency"))
+	if !currencies.IsValidCode(currency) {
+		return errs.BadRequest{Cause: "invalid currency code"}
+	}
+
+	var req setRateRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return errs.BadRequest{Cause: "invalid JSON body"}
+	}
+
+	if errsList := req.Validate(); len(errsList) != 0 {
+		return errs.FieldsValidation{Errors: errsList}
+	}
+
+	rate, err := h.service.SetRate(ctx.Context(), req.toDomain(currency))
+	if err != nil {
+		return err
+	}
+
+	return h.Respond(ctx, fiber.StatusOK, fromDomain(rate))
+}
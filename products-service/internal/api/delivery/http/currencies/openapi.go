@@ -0,0 +1,24 @@
+package currencies
+
+import (
+	"net/http"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/openapi"
+)
+
+// Endpoints describes handlers of currency rates for the OpenAPI document.
+var Endpoints = map[string]openapi.Endpoint{
+	"GetRates": {
+		Summary:  "Get currency rates",
+		Status:   http.StatusOK,
+		Response: rateListResponse{},
+	},
+	"SetRate": {
+		Summary:     "Create or update a currency rate",
+		Description: "The rate is units of the currency per one unit of the base currency.",
+		Request:     setRateRequest{},
+		Status:      http.StatusOK,
+		Response:    rateResponse{},
+		Errors:      []int{http.StatusBadRequest, http.StatusConflict},
+	},
+}
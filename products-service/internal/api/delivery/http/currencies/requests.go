@@ -0,0 +1,23 @@
+package currencies
+
+import (
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/currencies"
+	"github.com/shopspring/decimal"
+)
+
+//go:generate go-validator
+
+type (
+	// setRateRequest - request model for setting a currency rate.
+	setRateRequest struct {
+		Rate decimal.Decimal `json:"rate" valid:"required,min=0"`
+	}
+)
+
+// toDomain converts request model to domain model.
+func (r setRateRequest) toDomain(currency string) currencies.Rate {
+	return currencies.Rate{
+		Currency: currency,
+		Rate:     r.Rate,
+	}
+}
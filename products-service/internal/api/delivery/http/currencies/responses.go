@@ -0,0 +1,45 @@
+package currencies
+
+import (
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/currencies"
+	"github.com/shopspring/decimal"
+)
+
+type (
+	// rateResponse is a response for a currency rate.
+	rateResponse struct {
+		Currency  string          `json:"currency"`
+		Rate      decimal.Decimal `json:"rate"`
+		UpdatedAt time.Time       `json:"updated_at"`
+	}
+
+	// rateListResponse is a response for a list of currency rates.
+	rateListResponse struct {
+		Base  string         `json:"base"`
+		Rates []rateResponse `json:"rates"`
+	}
+)
+
+// fromDomain converts domain model to response model.
+func fromDomain(r currencies.Rate) rateResponse {
+	return rateResponse{
+		Currency:  r.Currency,
+		Rate:      r.Rate,
+		UpdatedAt: r.UpdatedAt,
+	}
+}
+
+// fromDomainList converts domain model to response model.
+func fromDomainList(list currencies.Rates) rateListResponse {
+	result := make([]rateResponse, 0, len(list))
+	for _, r := range list {
+		result = append(result, fromDomain(r))
+	}
+
+	return rateListResponse{
+		Base:  currencies.Base,
+		Rates: result,
+	}
+}
@@ -0,0 +1,37 @@
+package docs
+
+import (
+	_ "embed"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery"
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/openapi"
+	"github.com/gofiber/fiber/v2"
+)
+
+var _ delivery.DocsHTTPHandler = &Handler{}
+
+// uiHTML is a self-contained page rendering the OpenAPI document, it is served without external assets.
+//
+//go:embed ui/index.html
+var uiHTML []byte
+
+type (
+	// Handler defines a Handler for HTTP requests for API documentation.
+	Handler struct {
+		document openapi.Document
+	}
+)
+
+// NewHandler defines a handler constructor.
+func NewHandler(document openapi.Document) *Handler {
+	return &Handler{document: document}
+}
+
+// OpenAPI - handler for getting OpenAPI document endpoint.
+func (h Handler) OpenAPI(ctx *fiber.Ctx) error { return ctx.JSON(h.document) }
+
+// UI - handler for getting docs UI endpoint.
+func (h Handler) UI(ctx *fiber.Ctx) error {
+	ctx.Set(fiber.HeaderContentType, fiber.MIMETextHTMLCharsetUTF8)
+	return ctx.Send(uiHTML)
+}
@@ -0,0 +1,22 @@
+package docs
+
+import (
+	"net/http"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/openapi"
+)
+
+// Endpoints describes handlers of documentation for the OpenAPI document.
+var Endpoints = map[string]openapi.Endpoint{
+	"OpenAPI": {
+		Summary:  "Get OpenAPI document",
+		Status:   http.StatusOK,
+		Response: map[string]any{},
+	},
+	"UI": {
+		Summary:      "Get docs UI",
+		Status:       http.StatusOK,
+		Response:     "",
+		ResponseType: openapi.MIMETextHTML,
+	},
+}
@@ -0,0 +1,47 @@
+package events
+
+import (
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/platform/responder"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/services"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+var _ delivery.EventsHTTPHandler = &Handler{}
+
+type (
+	// Handler defines a Handler for HTTP requests for events of products.
+	Handler struct {
+		responder.Responder
+
+		service services.EventsService
+		log     *zap.Logger
+	}
+)
+
+// NewHandler - create new handler.
+func NewHandler(responder responder.Responder, service services.EventsService, log *zap.Logger) *Handler {
+	return &Handler{
+		Responder: responder,
+		service:   service,
+		log:       log.With(zap.String("http_handler", "events")),
+	}
+}
+
+// Republish - publish again events of a product or of products changed within a time range:
+//   - POST /admin/events/republish
+func (h Handler) Republish(ctx *fiber.Ctx) error {
+	var req republishRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return errs.BadRequest{Cause: "invalid JSON body"}
+	}
+
+	result, err := h.service.Republish(ctx.Context(), req.toDomain())
+	if err != nil {
+		return err
+	}
+
+	return h.Respond(ctx, fiber.StatusOK, fromDomainResult(result))
+}
@@ -0,0 +1,22 @@
+package events
+
+import (
+	"net/http"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/openapi"
+)
+
+// Endpoints describes handlers of events of products for the OpenAPI document.
+var Endpoints = map[string]openapi.Endpoint{
+	"Republish": {
+		Summary: "Publish events of products again",
+		Description: "Events are derived from stored products: `create_product` when the product was created " +
+			"within [`from`, `to`) and `update_product` when it was last updated within it. Either `product_id` " +
+			"or `from` is required, `to` is not bounded when omitted. Deletes are not stored, so they are not " +
+			"published again.",
+		Request:  republishRequest{},
+		Status:   http.StatusOK,
+		Response: republishResponse{},
+		Errors:   []int{http.StatusBadRequest, http.StatusNotFound},
+	},
+}
@@ -0,0 +1,30 @@
+package events
+
+import (
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/events"
+	"github.com/google/uuid"
+)
+
+type (
+	// republishRequest - request model for publishing events again, either product_id or from is required.
+	republishRequest struct {
+		ProductID *uuid.UUID `json:"product_id"`
+		From      *time.Time `json:"from"`
+		To        *time.Time `json:"to"`
+	}
+)
+
+// toDomain converts request model to domain model.
+func (r republishRequest) toDomain() events.Republish {
+	e := events.Republish{ProductID: r.ProductID}
+	if r.From != nil {
+		e.From = *r.From
+	}
+	if r.To != nil {
+		e.To = *r.To
+	}
+
+	return e
+}
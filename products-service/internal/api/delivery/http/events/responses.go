@@ -0,0 +1,21 @@
+package events
+
+import "github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/events"
+
+type (
+	// republishResponse is a response for events published again.
+	republishResponse struct {
+		Products int `json:"products"`
+		Created  int `json:"created"`
+		Updated  int `json:"updated"`
+	}
+)
+
+// fromDomainResult converts domain model to response model.
+func fromDomainResult(r events.RepublishResult) republishResponse {
+	return republishResponse{
+		Products: r.Products,
+		Created:  r.Created,
+		Updated:  r.Updated,
+	}
+}
@@ -0,0 +1,26 @@
+// Package health describes handlers of liveness and readiness of platform/health for the OpenAPI document.
+package health
+
+import (
+	"net/http"
+
+	platformhealth "github.com/at-kh/guru-apps-test-services/platform/health"
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/openapi"
+)
+
+// Endpoints describes handlers of health for the OpenAPI document.
+var Endpoints = map[string]openapi.Endpoint{
+	"Health": {
+		Summary:  "Health check",
+		Status:   http.StatusOK,
+		Response: platformhealth.InfoResponse{},
+	},
+	"Ready": {
+		Summary: "Readiness check",
+		Description: "Fails while the application starts or shuts down, so load balancers route requests only " +
+			"to instances which accept them.",
+		Status:   http.StatusOK,
+		Response: platformhealth.ReadinessResponse{},
+		Errors:   []int{http.StatusServiceUnavailable},
+	},
+}
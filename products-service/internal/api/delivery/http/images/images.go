@@ -0,0 +1,147 @@
+package images
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/platform/responder"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/images"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/services"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ delivery.ImagesHTTPHandler = &Handler{}
+
+// formFile is a name of multipart form field with image content.
+const formFile = "file"
+
+type (
+	// Handler defines a Handler for HTTP requests for product images.
+	Handler struct {
+		responder.Responder
+
+		service      services.ImagesService
+		maxSizeBytes *atomic.Int64
+		log          *zap.Logger
+	}
+)
+
+// NewHandler - create new handler, images larger than maxSizeBytes are rejected.
+func NewHandler(
+	responder responder.Responder,
+	service services.ImagesService,
+	maxSizeBytes int64,
+	log *zap.Logger,
+) *Handler {
+	h := &Handler{
+		Responder:    responder,
+		service:      service,
+		maxSizeBytes: &atomic.Int64{},
+		log:          log.With(zap.String("http_handler", "images")),
+	}
+	h.SetMaxSizeBytes(maxSizeBytes)
+
+	return h
+}
+
+// SetMaxSizeBytes sets max size of uploaded images.
+func (h *Handler) SetMaxSizeBytes(maxSizeBytes int64) { h.maxSizeBytes.Store(maxSizeBytes) }
+
+// Upload - upload an image of a product as multipart form field "file":
+//   - POST /products/:id/images
+func (h Handler) Upload(ctx *fiber.Ctx) error {
+	productID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return errs.BadRequest{Cause: "invalid product id"}
+	}
+
+	if !strings.HasPrefix(ctx.Get(fiber.HeaderContentType), fiber.MIMEMultipartForm) {
+		return errs.UnsupportedMediaType{Cause: "multipart/form-data is expected"}
+	}
+
+	fileHeader, err := ctx.FormFile(formFile)
+	if err != nil {
+		return errs.BadRequest{Cause: "file is required"}
+	}
+
+	maxSizeBytes := h.maxSizeBytes.Load()
+	if fileHeader.Size > maxSizeBytes {
+		return errs.PayloadTooLarge{Cause: "image is larger than " + strconv.FormatInt(maxSizeBytes, 10) + " bytes"}
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return errs.BadRequest{Cause: "invalid file"}
+	}
+	defer func() { _ = file.Close() }()
+
+	content, err := io.ReadAll(io.LimitReader(file, maxSizeBytes+1))
+	if err != nil {
+		return errs.BadRequest{Cause: "invalid file"}
+	}
+	if int64(len(content)) > maxSizeBytes {
+		return errs.PayloadTooLarge{Cause: "image is larger than " + strconv.FormatInt(maxSizeBytes, 10) + " bytes"}
+	}
+	if len(content) == 0 {
+		return errs.BadRequest{Cause: "file is empty"}
+	}
+
+	// the declared content type is not trusted, the type is detected by the content
+	contentType, _, _ := strings.Cut(http.DetectContentType(content), ";")
+	if !images.IsSupportedContentType(contentType) {
+		return errs.UnsupportedMediaType{Cause: "unsupported image type " + contentType}
+	}
+
+	image, err := h.service.Upload(ctx.Context(), images.Image{
+		ProductID:   productID,
+		ContentType: contentType,
+	}, content)
+	if err != nil {
+		return err
+	}
+
+	return h.Respond(ctx, fiber.StatusCreated, fromDomain(image))
+}
+
+// GetAll - get images of a product with presigned download URLs:
+//   - GET /products/:id/images
+func (h Handler) GetAll(ctx *fiber.Ctx) error {
+	productID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return errs.BadRequest{Cause: "invalid product id"}
+	}
+
+	list, err := h.service.GetByProductID(ctx.Context(), productID)
+	if err != nil {
+		return err
+	}
+
+	return h.Respond(ctx, fiber.StatusOK, fromDomainList(list))
+}
+
+// Delete - delete an image of a product:
+//   - DELETE /products/:id/images/:image_id
+func (h Handler) Delete(ctx *fiber.Ctx) error {
+	productID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return errs.BadRequest{Cause: "invalid product id"}
+	}
+
+	imageID, err := uuid.Parse(ctx.Params("image_id"))
+	if err != nil {
+		return errs.BadRequest{Cause: "invalid image id"}
+	}
+
+	if err = h.service.Delete(ctx.Context(), productID, imageID); err != nil {
+		return err
+	}
+
+	return h.RespondEmpty(ctx, fiber.StatusNoContent)
+}
@@ -0,0 +1,124 @@
+package images
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/at-kh/guru-apps-test-services/platform/responder"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/images"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// pngContent - the signature of PNG images, which is enough to detect the type.
+var pngContent = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+// serviceStub - a service of images recording uploaded images.
+type serviceStub struct {
+	uploaded []images.Image
+}
+
+func (s *serviceStub) Upload(_ context.Context, e images.Image, content []byte) (images.Image, error) {
+	e.ID = uuid.New()
+	e.Size = int64(len(content))
+	s.uploaded = append(s.uploaded, e)
+
+	return e, nil
+}
+
+func (s *serviceStub) GetByProductID(context.Context, uuid.UUID) (images.Images, error) {
+	return nil, nil
+}
+
+func (s *serviceStub) Delete(context.Context, uuid.UUID, uuid.UUID) error { return nil }
+
+// multipartBody returns a multipart form with content as the file field and its content type.
+func multipartBody(t *testing.T, content []byte) (*bytes.Buffer, string) {
+	t.Helper()
+
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	part, err := w.CreateFormFile(formFile, "image.png")
+	require.NoError(t, err)
+	_, err = part.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	return &body, w.FormDataContentType()
+}
+
+func TestHandler_Upload(t *testing.T) {
+	const maxSizeBytes = 64
+
+	tests := []struct {
+		name        string
+		content     []byte
+		contentType string // of the request, the multipart form when empty
+		wantStatus  int
+	}{
+		{name: "png image", content: pngContent, wantStatus: http.StatusCreated},
+		{name: "image of max size", content: append(pngContent, make([]byte, maxSizeBytes-len(pngContent))...),
+			wantStatus: http.StatusCreated},
+		{name: "too large image", content: append(pngContent, make([]byte, maxSizeBytes)...),
+			wantStatus: http.StatusRequestEntityTooLarge},
+		{name: "unsupported type", content: []byte("plain text"), wantStatus: http.StatusUnsupportedMediaType},
+		{name: "not a form", content: pngContent, contentType: "image/png",
+			wantStatus: http.StatusUnsupportedMediaType},
+		{name: "empty file", content: []byte{}, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &serviceStub{}
+			h := NewHandler(responder.New(), service, maxSizeBytes, zap.NewNop())
+
+			app := fiber.New(fiber.Config{ErrorHandler: h.HandleError})
+			app.Post("/products/:id/images", h.Upload)
+
+			body, contentType := multipartBody(t, tt.content)
+			if tt.contentType != "" {
+				body, contentType = bytes.NewBuffer(tt.content), tt.contentType
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/products/"+uuid.NewString()+"/images", body)
+			req.Header.Set(fiber.HeaderContentType, contentType)
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+			_ = resp.Body.Close()
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+
+			if tt.wantStatus != http.StatusCreated {
+				assert.Empty(t, service.uploaded, "rejected images are not uploaded")
+				return
+			}
+			require.Len(t, service.uploaded, 1)
+			assert.Equal(t, "image/png", service.uploaded[0].ContentType, "the type is detected by the content")
+		})
+	}
+}
+
+func TestHandler_SetMaxSizeBytes(t *testing.T) {
+	service := &serviceStub{}
+	h := NewHandler(responder.New(), service, 1<<20, zap.NewNop())
+	h.SetMaxSizeBytes(int64(len(pngContent) - 1))
+
+	app := fiber.New(fiber.Config{ErrorHandler: h.HandleError})
+	app.Post("/products/:id/images", h.Upload)
+
+	body, contentType := multipartBody(t, pngContent)
+	req := httptest.NewRequest(http.MethodPost, "/products/"+uuid.NewString()+"/images", body)
+	req.Header.Set(fiber.HeaderContentType, contentType)
+
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusRequestEntityTooLarge, resp.StatusCode, "the reloaded limit is applied")
+}
@@ -0,0 +1,37 @@
+package images
+
+import (
+	"net/http"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/openapi"
+)
+
+// uploadImageForm - multipart form of image upload, documentation only.
+type uploadImageForm struct {
+	File openapi.Binary `json:"file" valid:"required"`
+}
+
+// Endpoints describes handlers of product images for the OpenAPI document.
+var Endpoints = map[string]openapi.Endpoint{
+	"GetAll": {
+		Summary:  "Get images of a product",
+		Status:   http.StatusOK,
+		Response: imageListResponse{},
+		Errors:   []int{http.StatusBadRequest, http.StatusNotFound},
+	},
+	"Upload": {
+		Summary:     "Upload an image of a product",
+		Description: "JPEG, PNG, GIF and WebP images are accepted, the type is detected by the content.",
+		Request:     uploadImageForm{},
+		RequestType: openapi.MIMEMultipartForm,
+		Status:      http.StatusCreated,
+		Response:    imageResponse{},
+		Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusRequestEntityTooLarge,
+			http.StatusUnsupportedMediaType},
+	},
+	"Delete": {
+		Summary: "Delete an image of a product",
+		Status:  http.StatusNoContent,
+		Errors:  []int{http.StatusBadRequest, http.StatusNotFound},
+	},
+}
@@ -0,0 +1,51 @@
+package images
+
+import (
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/images"
+	"github.com/google/uuid"
+)
+
+type (
+	// imageResponse is a response for an image.
+	imageResponse struct {
+		ID          uuid.UUID `json:"id"`
+		ProductID   uuid.UUID `json:"product_id"`
+		Key         string    `json:"key"`
+		ContentType string    `json:"content_type"`
+		Size        int64     `json:"size"`
+		Checksum    string    `json:"checksum"`
+		URL         string    `json:"url"`
+		CreatedAt   time.Time `json:"created_at"`
+	}
+
+	// imageListResponse is a response for images of a product.
+	imageListResponse struct {
+		Images []imageResponse `json:"images"`
+	}
+)
+
+// fromDomain converts domain model to response model.
+func fromDomain(i images.Image) imageResponse {
+	return imageResponse{
+		ID:          i.ID,
+		ProductID:   i.ProductID,
+		Key:         i.Key,
+		ContentType: i.ContentType,
+		Size:        i.Size,
+		Checksum:    i.Checksum,
+		URL:         i.URL,
+		CreatedAt:   i.CreatedAt,
+	}
+}
+
+// fromDomainList converts domain model to response model.
+func fromDomainList(list images.Images) imageListResponse {
+	result := make([]imageResponse, 0, len(list))
+	for _, i := range list {
+		result = append(result, fromDomain(i))
+	}
+
+	return imageListResponse{Images: result}
+}
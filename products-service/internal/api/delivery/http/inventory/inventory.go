@@ -0,0 +1,127 @@
+package inventory
+
+import (
+	"strings"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/platform/responder"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/services"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ delivery.InventoryHTTPHandler = &Handler{}
+
+type (
+	// Handler defines a Handler for HTTP requests for product stock.
+	Handler struct {
+		responder.Responder
+
+		service services.InventoryService
+		log     *zap.Logger
+	}
+)
+
+// NewHandler - create new handler.
+func NewHandler(responder responder.Responder, service services.InventoryService, log *zap.Logger) *Handler {
+	return &Handler{
+		Responder: responder,
+		service:   service,
+		log:       log.With(zap.String("http_handler", "inventory")),
+	}
+}
+
+// GetAll - get stock levels of a product in all warehouses:
+//   - GET /products/:id/stock
+func (h Handler) GetAll(ctx *fiber.Ctx) error {
+	productID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return errs.BadRequest{Cause: "invalid product id"}
+	}
+
+	stocks, err := h.service.GetByProductID(ctx.Context(), productID)
+	if err != nil {
+		return err
+	}
+
+	return h.Respond(ctx, fiber.StatusOK, fromDomainList(productID, stocks))
+}
+
+// Adjust - add or remove units on hand of a product, optionally set the low stock threshold:
+//   - POST /products/:id/stock/adjustments
+func (h Handler) Adjust(ctx *fiber.Ctx) error {
+	productID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return errs.BadRequest{Cause: "invalid product id"}
+	}
+
+	var req adjustmentRequest
+	if err = ctx.BodyParser(&req); err != nil {
+		return errs.BadRequest{Cause: "invalid JSON body"}
+	}
+
+	req.Warehouse = strings.TrimSpace(req.Warehouse)
+	errsList := req.Validate()
+	if req.Delta == 0 && req.LowStockThreshold == nil {
+		errsList = append(errsList, "delta::is_required")
+	}
+	if len(errsList) != 0 {
+		return errs.FieldsValidation{Errors: errsList}
+	}
+
+	stock, err := h.service.Adjust(ctx.Context(), req.toDomain(productID))
+	if err != nil {
+		return err
+	}
+
+	return h.Respond(ctx, fiber.StatusOK, fromDomain(stock))
+}
+
+// Reserve - reserve units of a product until the reservation expires or is released:
+//   - POST /products/:id/reservations
+func (h Handler) Reserve(ctx *fiber.Ctx) error {
+	productID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return errs.BadRequest{Cause: "invalid product id"}
+	}
+
+	var req reservationRequest
+	if err = ctx.BodyParser(&req); err != nil {
+		return errs.BadRequest{Cause: "invalid JSON body"}
+	}
+
+	req.Warehouse = strings.TrimSpace(req.Warehouse)
+	if errsList := req.Validate(); len(errsList) != 0 {
+		return errs.FieldsValidation{Errors: errsList}
+	}
+
+	reservation, err := h.service.Reserve(ctx.Context(), req.toDomain(productID, time.Now()))
+	if err != nil {
+		return err
+	}
+
+	return h.Respond(ctx, fiber.StatusCreated, fromDomainReservation(reservation))
+}
+
+// Release - release units held by a reservation:
+//   - DELETE /products/:id/reservations/:reservation_id
+func (h Handler) Release(ctx *fiber.Ctx) error {
+	productID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return errs.BadRequest{Cause: "invalid product id"}
+	}
+
+	reservationID, err := uuid.Parse(ctx.Params("reservation_id"))
+	if err != nil {
+		return errs.BadRequest{Cause: "invalid reservation id"}
+	}
+
+	if err = h.service.Release(ctx.Context(), productID, reservationID); err != nil {
+		return err
+	}
+
+	return h.RespondEmpty(ctx, fiber.StatusNoContent)
+}
@@ -0,0 +1,36 @@
+package inventory
+
+import (
+	"net/http"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/openapi"
+)
+
+// Endpoints describes handlers of product stock for the OpenAPI document.
+var Endpoints = map[string]openapi.Endpoint{
+	"GetAll": {
+		Summary:  "Get stock levels of a product per warehouse",
+		Status:   http.StatusOK,
+		Response: stockListResponse{},
+		Errors:   []int{http.StatusBadRequest, http.StatusNotFound},
+	},
+	"Adjust": {
+		Summary:  "Add or remove units of a product",
+		Request:  adjustmentRequest{},
+		Status:   http.StatusOK,
+		Response: stockResponse{},
+		Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict},
+	},
+	"Reserve": {
+		Summary:  "Reserve units of a product",
+		Request:  reservationRequest{},
+		Status:   http.StatusCreated,
+		Response: reservationResponse{},
+		Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict},
+	},
+	"Release": {
+		Summary: "Release a reservation",
+		Status:  http.StatusNoContent,
+		Errors:  []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict},
+	},
+}
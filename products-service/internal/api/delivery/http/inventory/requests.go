@@ -0,0 +1,51 @@
+package inventory
+
+import (
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/inventory"
+	"github.com/google/uuid"
+)
+
+//go:generate go-validator
+
+type (
+	// adjustmentRequest - request model for stock adjustment, negative delta removes units.
+	adjustmentRequest struct {
+		Warehouse         string `json:"warehouse" valid:"max=64"`
+		Delta             int64  `json:"delta" valid:"min=-1000000,max=1000000"`
+		LowStockThreshold *int64 `json:"low_stock_threshold" valid:"min=0,max=1000000"`
+	}
+
+	// reservationRequest - request model for reservation, ttl_seconds defaults to 15 minutes.
+	reservationRequest struct {
+		Warehouse  string `json:"warehouse" valid:"max=64"`
+		Quantity   int64  `json:"quantity" valid:"required,min=1,max=1000000"`
+		TTLSeconds int64  `json:"ttl_seconds" valid:"min=0,max=86400"`
+	}
+)
+
+// toDomain converts request model to domain model.
+func (r adjustmentRequest) toDomain(productID uuid.UUID) inventory.Adjustment {
+	return inventory.Adjustment{
+		ProductID:         productID,
+		Warehouse:         r.Warehouse,
+		Delta:             r.Delta,
+		LowStockThreshold: r.LowStockThreshold,
+	}
+}
+
+// toDomain converts request model to domain model.
+func (r reservationRequest) toDomain(productID uuid.UUID, now time.Time) inventory.Reservation {
+	ttl := inventory.DefaultReservationTTL
+	if r.TTLSeconds > 0 {
+		ttl = time.Duration(r.TTLSeconds) * time.Second
+	}
+
+	return inventory.Reservation{
+		ProductID: productID,
+		Warehouse: r.Warehouse,
+		Quantity:  r.Quantity,
+		ExpiresAt: now.Add(ttl),
+	}
+}
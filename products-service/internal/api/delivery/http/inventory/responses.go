@@ -0,0 +1,79 @@
+package inventory
+
+import (
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/inventory"
+	"github.com/google/uuid"
+)
+
+type (
+	// stockResponse is a response for a stock level in a warehouse.
+	stockResponse struct {
+		ProductID         uuid.UUID `json:"product_id"`
+		Warehouse         string    `json:"warehouse"`
+		Quantity          int64     `json:"quantity"`
+		Reserved          int64     `json:"reserved"`
+		Available         int64     `json:"available"`
+		LowStockThreshold int64     `json:"low_stock_threshold"`
+		UpdatedAt         time.Time `json:"updated_at"`
+	}
+
+	// stockListResponse is a response for stock levels of a product.
+	stockListResponse struct {
+		ProductID  uuid.UUID       `json:"product_id"`
+		Available  int64           `json:"available"`
+		Warehouses []stockResponse `json:"warehouses"`
+	}
+
+	// reservationResponse is a response for a reservation.
+	reservationResponse struct {
+		ID         uuid.UUID  `json:"id"`
+		ProductID  uuid.UUID  `json:"product_id"`
+		Warehouse  string     `json:"warehouse"`
+		Quantity   int64      `json:"quantity"`
+		ExpiresAt  time.Time  `json:"expires_at"`
+		ReleasedAt *time.Time `json:"released_at"`
+		CreatedAt  time.Time  `json:"created_at"`
+	}
+)
+
+// fromDomain converts domain model to response model.
+func fromDomain(s inventory.Stock) stockResponse {
+	return stockResponse{
+		ProductID:         s.ProductID,
+		Warehouse:         s.Warehouse,
+		Quantity:          s.Quantity,
+		Reserved:          s.Reserved,
+		Available:         s.Available(),
+		LowStockThreshold: s.LowStockThreshold,
+		UpdatedAt:         s.UpdatedAt,
+	}
+}
+
+// fromDomainList converts domain model to response model.
+func fromDomainList(productID uuid.UUID, list inventory.Stocks) stockListResponse {
+	result := make([]stockResponse, 0, len(list))
+	for _, s := range list {
+		result = append(result, fromDomain(s))
+	}
+
+	return stockListResponse{
+		ProductID:  productID,
+		Available:  list.Available(),
+		Warehouses: result,
+	}
+}
+
+// fromDomainReservation converts domain model to response model.
+func fromDomainReservation(r inventory.Reservation) reservationResponse {
+	return reservationResponse{
+		ID:         r.ID,
+		ProductID:  r.ProductID,
+		Warehouse:  r.Warehouse,
+		Quantity:   r.Quantity,
+		ExpiresAt:  r.ExpiresAt,
+		ReleasedAt: r.ReleasedAt,
+		CreatedAt:  r.CreatedAt,
+	}
+}
@@ -0,0 +1,25 @@
+package prices
+
+import (
+	"net/http"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/openapi"
+)
+
+// Endpoints describes handlers of prices for the OpenAPI document.
+var Endpoints = map[string]openapi.Endpoint{
+	"GetAll": {
+		Summary:  "Get price history of a product",
+		Query:    []openapi.Parameter{openapi.QueryParam("currency", "ISO 4217 code to convert prices to", "")},
+		Status:   http.StatusOK,
+		Response: priceListResponse{},
+		Errors:   []int{http.StatusBadRequest, http.StatusNotFound},
+	},
+	"Create": {
+		Summary:  "Schedule a price for a product",
+		Request:  createPriceRequest{},
+		Status:   http.StatusCreated,
+		Response: priceResponse{},
+		Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict},
+	},
+}
@@ -0,0 +1,100 @@
+package prices
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/platform/responder"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/currencies"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/services"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ delivery.PricesHTTPHandler = &Handler{}
+
+type (
+	// Handler defines a Handler for HTTP requests for product prices.
+	Handler struct {
+		responder.Responder
+
+		service services.PricesService
+		log     *zap.Logger
+	}
+)
+
+// NewHandler - create new handler.
+func NewHandler(responder responder.Responder, service services.PricesService, log *zap.Logger) *Handler {
+	return &Handler{
+		Responder: responder,
+		service:   service,
+		log:       log.With(zap.String("http_handler", "prices")),
+	}
+}
+
+// Create - schedule new price for a product:
+//   - POST /products/:id/prices
+func (h Handler) Create(ctx *fiber.Ctx) error {
+	productID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return errs.BadRequest{Cause: "invalid product id"}
+	}
+
+	var req createPriceRequest
+	if err = ctx.BodyParser(&req); err != nil {
+		return errs.BadRequest{Cause: "invalid JSON body"}
+	}
+
+	req.Currency = currencies.Normalize(req.Currency)
+	if req.EffectiveFrom == nil {
+		now := time.Now()
+		req.EffectiveFrom = &now
+	}
+
+	errsList := req.Validate()
+	if req.Currency != "" && !currencies.IsValidCode(req.Currency) {
+		errsList = append(errsList, "currency::invalid_format")
+	}
+	if req.Price != nil && !req.Price.Equal(req.Price.Truncate(products.PriceDecimals)) {
+		errsList = append(errsList, "price::max_decimals_is::"+strconv.Itoa(products.PriceDecimals))
+	}
+	if req.EffectiveTo != nil && !req.EffectiveTo.After(*req.EffectiveFrom) {
+		errsList = append(errsList, "effective_to::must_be_after::effective_from")
+	}
+	if len(errsList) != 0 {
+		return errs.FieldsValidation{Errors: errsList}
+	}
+
+	price, err := h.service.Create(ctx.Context(), req.toDomain(productID))
+	if err != nil {
+		return err
+	}
+
+	return h.Respond(ctx, fiber.StatusCreated, fromDomain(price))
+}
+
+// GetAll - get price history of a product, the latest first:
+//   - GET /products/:id/prices
+//   - GET /products/:id/prices?currency=EUR
+func (h Handler) GetAll(ctx *fiber.Ctx) error {
+	productID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return errs.BadRequest{Cause: "invalid product id"}
+	}
+
+	currency := currencies.Normalize(ctx.Query("currency"))
+	if currency != "" && !currencies.IsValidCode(currency) {
+		return errs.BadRequest{Cause: "invalid currency code"}
+	}
+
+	list, err := h.service.GetByProductID(ctx.Context(), productID, currency)
+	if err != nil {
+		return err
+	}
+
+	return h.Respond(ctx, fiber.StatusOK, fromDomainList(list))
+}
@@ -0,0 +1,30 @@
+package prices
+
+import (
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/prices"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type (
+	// createPriceRequest - request model for scheduling a price.
+	createPriceRequest struct {
+		Price         *decimal.Decimal `json:"price" valid:"required,min=0,max=1000000"`
+		Currency      string           `json:"currency"`
+		EffectiveFrom *time.Time       `json:"effective_from"`
+		EffectiveTo   *time.Time       `json:"effective_to"`
+	}
+)
+
+// toDomain converts request model to domain model.
+func (r createPriceRequest) toDomain(productID uuid.UUID) prices.Price {
+	return prices.Price{
+		ProductID:     productID,
+		Amount:        *r.Price,
+		Currency:      r.Currency,
+		EffectiveFrom: *r.EffectiveFrom,
+		EffectiveTo:   r.EffectiveTo,
+	}
+}
@@ -0,0 +1,50 @@
+package prices
+
+import (
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/prices"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type (
+	// priceResponse is a response for a product price.
+	priceResponse struct {
+		ID            uuid.UUID       `json:"id"`
+		ProductID     uuid.UUID       `json:"product_id"`
+		Price         decimal.Decimal `json:"price"`
+		Currency      string          `json:"currency"`
+		EffectiveFrom time.Time       `json:"effective_from"`
+		EffectiveTo   *time.Time      `json:"effective_to,omitempty"`
+		CreatedAt     time.Time       `json:"created_at"`
+	}
+
+	// priceListResponse is a response for a price history of a product.
+	priceListResponse struct {
+		Prices []priceResponse `json:"prices"`
+	}
+)
+
+// fromDomain converts domain model to response model.
+func fromDomain(p prices.Price) priceResponse {
+	return priceResponse{
+		ID:            p.ID,
+		ProductID:     p.ProductID,
+		Price:         p.Amount,
+		Currency:      p.Currency,
+		EffectiveFrom: p.EffectiveFrom,
+		EffectiveTo:   p.EffectiveTo,
+		CreatedAt:     p.CreatedAt,
+	}
+}
+
+// fromDomainList converts domain model to response model.
+func fromDomainList(list prices.Prices) priceListResponse {
+	result := make([]priceResponse, 0, len(list))
+	for _, p := range list {
+		result = append(result, fromDomain(p))
+	}
+
+	return priceListResponse{Prices: result}
+}
@@ -0,0 +1,23 @@
+// Package prices contains models and validation code
+package prices
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// Validate validates struct accordingly to fields tags, the price is a pointer, so an omitted price is
+// told apart from a zero one.
+func (c createPriceRequest) Validate() []string {
+	var errs []string
+	if c.Price == nil {
+		errs = append(errs, "price::is_required")
+	}
+	if c.Price != nil && c.Price.LessThan(decimal.NewFromFloat(0)) {
+		errs = append(errs, "price::min_value_is::0")
+	}
+	if c.Price != nil && c.Price.GreaterThan(decimal.NewFromFloat(1000000)) {
+		errs = append(errs, "price::max_value_is::1000000")
+	}
+
+	return errs
+}
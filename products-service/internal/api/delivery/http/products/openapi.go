@@ -0,0 +1,63 @@
+package products
+
+import (
+	"net/http"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/openapi"
+	"github.com/google/uuid"
+)
+
+// listQuery - query parameters of product lists.
+var listQuery = []openapi.Parameter{
+	openapi.QueryParam("limit", "Max number of products, 10 by default, 100 at most", uint64(0)),
+	openapi.QueryParam("offset", "Number of products to skip", uint64(0)),
+	openapi.QueryParam("currency", "ISO 4217 code to convert prices to", ""),
+	openapi.QueryParam("category", "Category id, products of its subcategories are included", uuid.UUID{}),
+	openapi.QueryParam("tag", "Tag of products", ""),
+}
+
+// Endpoints describes handlers of products for the OpenAPI document.
+var Endpoints = map[string]openapi.Endpoint{
+	"GetAll": {
+		Summary:  "Get products",
+		Query:    listQuery,
+		Status:   http.StatusOK,
+		Response: productListResponse{},
+		Errors:   []int{http.StatusBadRequest},
+	},
+	"GetAllByVendor": {
+		Summary:  "Get products of a vendor",
+		Query:    listQuery,
+		Status:   http.StatusOK,
+		Response: productListResponse{},
+		Errors:   []int{http.StatusBadRequest, http.StatusNotFound},
+	},
+	"GetByID": {
+		Summary:  "Get a product",
+		Query:    []openapi.Parameter{openapi.QueryParam("currency", "ISO 4217 code to convert price to", "")},
+		Status:   http.StatusOK,
+		Response: productResponse{},
+		Errors:   []int{http.StatusBadRequest, http.StatusNotFound},
+	},
+	"Create": {
+		Summary:     "Create a product",
+		Description: "The vendor is referenced by `vendor_id` or found or created by `vendor` name.",
+		Request:     createProductRequest{},
+		Status:      http.StatusCreated,
+		Response:    productResponse{},
+		Errors:      []int{http.StatusBadRequest, http.StatusConflict},
+	},
+	"Update": {
+		Summary:     "Update a product",
+		Description: "Categories and tags are replaced with the given ones.",
+		Request:     updateProductRequest{},
+		Status:      http.StatusOK,
+		Response:    productResponse{},
+		Errors:      []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict},
+	},
+	"Delete": {
+		Summary: "Delete a product",
+		Status:  http.StatusNoContent,
+		Errors:  []int{http.StatusBadRequest, http.StatusNotFound},
+	},
+}
@@ -1,11 +1,20 @@
 package products
 
 import (
+	"bytes"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/platform/responder"
 	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/currencies"
 	dproducts "github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
 	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/services"
-	"github.com/at-kh/guru-apps-test-services/products-service/pkg/errs"
-	"github.com/at-kh/guru-apps-test-services/products-service/pkg/responder"
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/dbrouter"
+	"github.com/goccy/go-json"
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 
@@ -19,28 +28,70 @@ type (
 	Handler struct {
 		responder.Responder
 
-		service services.ProductsService
-		log     *zap.Logger
+		service      services.ProductsService
+		cacheControl *atomic.Pointer[string] // Cache-Control of reads
+		log          *zap.Logger
 	}
 )
 
-// NewHandler - create new handler.
-func NewHandler(responder responder.Responder, service services.ProductsService, log *zap.Logger) *Handler {
-	return &Handler{
-		Responder: responder,
-		service:   service,
-		log:       log.With(zap.String("http_handler", "products")),
+// NewHandler - create new handler, reads may be cached by clients for cacheMaxAge.
+func NewHandler(
+	responder responder.Responder,
+	service services.ProductsService,
+	cacheMaxAge time.Duration,
+	log *zap.Logger,
+) *Handler {
+	h := &Handler{
+		Responder:    responder,
+		service:      service,
+		cacheControl: &atomic.Pointer[string]{},
+		log:          log.With(zap.String("http_handler", "products")),
+	}
+	h.SetCacheMaxAge(cacheMaxAge)
+
+	return h
+}
+
+// SetCacheMaxAge sets how long reads may be cached by clients, 0 disables caching.
+func (h *Handler) SetCacheMaxAge(cacheMaxAge time.Duration) {
+	cacheControl := "no-cache"
+	if seconds := int(cacheMaxAge.Seconds()); seconds > 0 {
+		cacheControl = "public, max-age=" + strconv.Itoa(seconds)
+	}
+
+	h.cacheControl.Store(&cacheControl)
+}
+
+// setCacheControl sets Cache-Control of a read. Reads of clients sending their last write must observe it,
+// so they aren't cached, and caches keep reads with and without the last write apart.
+func (h Handler) setCacheControl(ctx *fiber.Ctx) {
+	ctx.Vary(dbrouter.HeaderLastWriteLSN)
+
+	if ctx.Get(dbrouter.HeaderLastWriteLSN) != "" || ctx.Cookies(dbrouter.CookieLastWriteLSN) != "" {
+		ctx.Set(fiber.HeaderCacheControl, "private, no-cache")
+		return
 	}
+
+	ctx.Set(fiber.HeaderCacheControl, *h.cacheControl.Load())
 }
 
 // Create - create new product.
 func (h Handler) Create(ctx *fiber.Ctx) error {
 	var req createProductRequest
-	if err := ctx.BodyParser(&req); err != nil {
-		return errs.BadRequest{Cause: "invalid JSON body"}
+	if err := parseBody(ctx, &req); err != nil {
+		return err
 	}
 
-	if errsList := req.Validate(); len(errsList) != 0 {
+	req.normalize()
+
+	errsList := req.Validate()
+	errsList = append(errsList, validatePricePrecision(req.Price)...)
+	if req.Currency != "" && !currencies.IsValidCode(req.Currency) {
+		errsList = append(errsList, "currency::invalid_format")
+	}
+	errsList = append(errsList, validateVendor(req.VendorID, req.Vendor)...)
+	errsList = append(errsList, validateTags(req.Tags)...)
+	if len(errsList) != 0 {
 		return errs.FieldsValidation{Errors: errsList}
 	}
 
@@ -52,10 +103,73 @@ func (h Handler) Create(ctx *fiber.Ctx) error {
 	return h.Respond(ctx, fiber.StatusCreated, fromDomain(product))
 }
 
+// Update - update product by id, categories and tags are replaced with the given ones:
+//   - PUT /products/:id
+func (h Handler) Update(ctx *fiber.Ctx) error {
+	productID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return errs.BadRequest{Cause: "invalid product id"}
+	}
+
+	var req updateProductRequest
+	if err = parseBody(ctx, &req); err != nil {
+		return err
+	}
+
+	req.normalize()
+
+	errsList := req.Validate()
+	errsList = append(errsList, validateVendor(req.VendorID, req.Vendor)...)
+	errsList = append(errsList, validateTags(req.Tags)...)
+	if len(errsList) != 0 {
+		return errs.FieldsValidation{Errors: errsList}
+	}
+
+	product, err := h.service.Update(ctx.Context(), req.toDomain(productID))
+	if err != nil {
+		return err
+	}
+
+	return h.Respond(ctx, fiber.StatusOK, fromDomain(product))
+}
+
 // GetAll - get all products:
 //   - GET /products
 //   - GET /products?limit=2&offset=1
+//   - GET /products?currency=EUR
+//   - GET /products?category=9b1deb4d-3b7d-4bad-9bdd-2b0d7b3dcb6d&tag=laptop
 func (h Handler) GetAll(ctx *fiber.Ctx) error {
+	return h.list(ctx, dproducts.Filter{})
+}
+
+// GetAllByVendor - get all products of a vendor, accepts the same query parameters as GetAll:
+//   - GET /vendors/:id/products
+//   - GET /vendors/:id/products?limit=2&offset=1&tag=laptop
+func (h Handler) GetAllByVendor(ctx *fiber.Ctx) error {
+	vendorID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return errs.BadRequest{Cause: "invalid vendor id"}
+	}
+
+	return h.list(ctx, dproducts.Filter{VendorID: &vendorID})
+}
+
+// list responds with products matching filter and query parameters.
+func (h Handler) list(ctx *fiber.Ctx, filter dproducts.Filter) error {
+	currency, err := queryCurrency(ctx)
+	if err != nil {
+		return err
+	}
+
+	if category := ctx.Query("category"); category != "" {
+		categoryID, parseErr := uuid.Parse(category)
+		if parseErr != nil {
+			return errs.BadRequest{Cause: "invalid category id"}
+		}
+		filter.CategoryID = &categoryID
+	}
+	filter.Tag = dproducts.NormalizeTag(ctx.Query("tag"))
+
 	limit := uint64(ctx.QueryInt("limit", dproducts.DefaultLimit))
 	if limit == 0 {
 		limit = dproducts.DefaultLimit
@@ -70,14 +184,40 @@ func (h Handler) GetAll(ctx *fiber.Ctx) error {
 	}
 	offset := uint64(offsetInt)
 
-	list, err := h.service.GetAll(ctx.Context(), limit, offset)
+	list, err := h.service.GetAll(ctx.Context(), limit, offset, filter, currency)
 	if err != nil {
 		return err
 	}
 
+	h.setCacheControl(ctx)
+
 	return h.Respond(ctx, fiber.StatusOK, fromDomainList(list, limit, offset))
 }
 
+// GetByID - get product by id:
+//   - GET /products/:id
+//   - GET /products/:id?currency=EUR
+func (h Handler) GetByID(ctx *fiber.Ctx) error {
+	productID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return errs.BadRequest{Cause: "invalid product id"}
+	}
+
+	currency, err := queryCurrency(ctx)
+	if err != nil {
+		return err
+	}
+
+	product, err := h.service.GetByID(ctx.Context(), productID, currency)
+	if err != nil {
+		return err
+	}
+
+	h.setCacheControl(ctx)
+
+	return h.Respond(ctx, fiber.StatusOK, fromDomain(product))
+}
+
 // Delete - delete product by id.
 func (h Handler) Delete(ctx *fiber.Ctx) error {
 	idStr := ctx.Params("id")
@@ -96,3 +236,29 @@ func (h Handler) Delete(ctx *fiber.Ctx) error {
 
 	return h.RespondEmpty(ctx, fiber.StatusNoContent)
 }
+
+// queryCurrency returns normalized currency from query, empty currency means no conversion.
+func queryCurrency(ctx *fiber.Ctx) (string, error) {
+	currency := currencies.Normalize(ctx.Query("currency"))
+	if currency != "" && !currencies.IsValidCode(currency) {
+		return "", errs.BadRequest{Cause: "invalid currency code"}
+	}
+
+	return currency, nil
+}
+
+// parseBody decodes JSON body into req, unknown fields are rejected, so misspelled fields are not ignored.
+func parseBody(ctx *fiber.Ctx, req any) error {
+	decoder := json.NewDecoder(bytes.NewReader(ctx.Body()))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(req); err != nil {
+		if field, ok := strings.CutPrefix(err.Error(), `json: unknown field "`); ok {
+			return errs.FieldsValidation{Errors: []string{strings.TrimSuffix(field, `"`) + "::is_unknown"}}
+		}
+
+		return errs.BadRequest{Cause: "invalid JSON body"}
+	}
+
+	return nil
+}
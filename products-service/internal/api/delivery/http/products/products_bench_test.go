@@ -0,0 +1,152 @@
+package products
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/platform/responder"
+	dproducts "github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/seed"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/valyala/fasthttp"
+	"go.uber.org/zap"
+)
+
+// benchProducts - number of products served by the service of benchmarks.
+const benchProducts = 1000
+
+// benchService - service of products serving generated products from memory, so benchmarks measure routing,
+// decoding, validation and encoding of handlers rather than services and databases.
+type benchService struct {
+	products dproducts.Products
+	byID     map[uuid.UUID]dproducts.Product
+}
+
+func newBenchService(n int) *benchService {
+	s := &benchService{byID: make(map[uuid.UUID]dproducts.Product, n)}
+	for _, p := range seed.NewGenerator(1).Products(n) {
+		p.ID, p.VendorID = uuid.New(), uuid.New()
+		p.CategoryIDs = []uuid.UUID{}
+		p.CreatedAt, p.UpdatedAt = time.Now().UTC(), time.Now().UTC()
+		s.products = append(s.products, p)
+		s.byID[p.ID] = p
+	}
+
+	return s
+}
+
+func (s *benchService) Create(_ context.Context, e dproducts.Product) (dproducts.Product, error) {
+	e.ID, e.VendorID = uuid.New(), uuid.New()
+	e.CreatedAt, e.UpdatedAt = time.Now().UTC(), time.Now().UTC()
+	return e, nil
+}
+
+func (s *benchService) Update(_ context.Context, e dproducts.Product) (dproducts.Product, error) {
+	return e, nil
+}
+
+func (s *benchService) GetByID(_ context.Context, id uuid.UUID, _ string) (dproducts.Product, error) {
+	p, ok := s.byID[id]
+	if !ok {
+		return dproducts.Product{}, errs.NotFound{What: "product"}
+	}
+	return p, nil
+}
+
+func (s *benchService) GetAll(
+	_ context.Context, limit, offset uint64, _ dproducts.Filter, _ string,
+) (dproducts.ProductList, error) {
+	total := uint64(len(s.products))
+	start, end := min(offset, total), min(offset+limit, total)
+	return dproducts.ProductList{Total: total, Products: s.products[start:end]}, nil
+}
+
+func (s *benchService) Delete(context.Context, uuid.UUID) error { return nil }
+
+// newBenchHandler returns the fasthttp handler of product routes and ids of products of their service.
+func newBenchHandler() (fasthttp.RequestHandler, []uuid.UUID) {
+	service := newBenchService(benchProducts)
+	h := NewHandler(responder.New(), service, time.Minute, zap.NewNop())
+
+	app := fiber.New(fiber.Config{DisableStartupMessage: true})
+	app.Post("/products", h.Create)
+	app.Get("/products", h.GetAll)
+	app.Get("/products/:id", h.GetByID)
+
+	ids := make([]uuid.UUID, 0, len(service.products))
+	for _, p := range service.products {
+		ids = append(ids, p.ID)
+	}
+
+	return app.Handler(), ids
+}
+
+// serve serves req by handler with ctx and fails the benchmark unless it is responded with status.
+func serve(b *testing.B, handler fasthttp.RequestHandler, ctx *fasthttp.RequestCtx, req *fasthttp.Request,
+	status int,
+) {
+	ctx.Init(req, nil, nil)
+	ctx.Response.Reset()
+
+	handler(ctx)
+	if ctx.Response.StatusCode() != status {
+		b.Fatalf("unexpected status %d: %s", ctx.Response.StatusCode(), ctx.Response.Body())
+	}
+}
+
+func BenchmarkHandler_Create(b *testing.B) {
+	handler, _ := newBenchHandler()
+
+	var (
+		ctx fasthttp.RequestCtx
+		req fasthttp.Request
+	)
+	req.Header.SetMethod(fasthttp.MethodPost)
+	req.SetRequestURI("/products")
+	req.Header.SetContentType(fiber.MIMEApplicationJSON)
+
+	b.ReportAllocs()
+	for i := 0; b.Loop(); i++ {
+		req.SetBodyString(`{"name":"Laptop ` + strconv.Itoa(i) + `","vendor":"Acme",` +
+			`"description":"Aluminium laptop","price":1299.99,"currency":"USD","tags":["electronics","new"]}`)
+
+		serve(b, handler, &ctx, &req, fiber.StatusCreated)
+	}
+}
+
+func BenchmarkHandler_GetAll(b *testing.B) {
+	handler, _ := newBenchHandler()
+
+	var (
+		ctx fasthttp.RequestCtx
+		req fasthttp.Request
+	)
+	req.Header.SetMethod(fasthttp.MethodGet)
+	req.SetRequestURI("/products?limit=20&offset=40")
+
+	b.ReportAllocs()
+	for b.Loop() {
+		serve(b, handler, &ctx, &req, fiber.StatusOK)
+	}
+}
+
+func BenchmarkHandler_GetByID(b *testing.B) {
+	handler, ids := newBenchHandler()
+
+	var (
+		ctx fasthttp.RequestCtx
+		req fasthttp.Request
+	)
+	req.Header.SetMethod(fasthttp.MethodGet)
+
+	b.ReportAllocs()
+	for i := 0; b.Loop(); i++ {
+		req.SetRequestURI("/products/" + ids[i%len(ids)].String())
+
+		serve(b, handler, &ctx, &req, fiber.StatusOK)
+	}
+}
@@ -0,0 +1,56 @@
+package products
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/platform/responder"
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/dbrouter"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// TestHandlerCacheControl tests that reads of clients sending their last write are not cached.
+func TestHandlerCacheControl(t *testing.T) {
+	service := newBenchService(1)
+	h := NewHandler(responder.New(), service, time.Minute, zap.NewNop())
+
+	app := fiber.New(fiber.Config{ErrorHandler: h.HandleError})
+	app.Get("/products", h.GetAll)
+	app.Get("/products/:id", h.GetByID)
+
+	tests := map[string]struct {
+		header, cookie   string
+		wantCacheControl string
+	}{
+		"no last write":          {wantCacheControl: "public, max-age=60"},
+		"last write in a header": {header: "0/16B3748", wantCacheControl: "private, no-cache"},
+		"last write in a cookie": {cookie: "0/16B3748", wantCacheControl: "private, no-cache"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			for _, target := range []string{"/products", "/products/" + service.products[0].ID.String()} {
+				req := httptest.NewRequest(http.MethodGet, target, http.NoBody)
+				if tt.header != "" {
+					req.Header.Set(dbrouter.HeaderLastWriteLSN, tt.header)
+				}
+				if tt.cookie != "" {
+					req.AddCookie(&http.Cookie{Name: dbrouter.CookieLastWriteLSN, Value: tt.cookie})
+				}
+
+				resp, err := app.Test(req)
+				require.NoError(t, err)
+				_ = resp.Body.Close()
+
+				require.Equal(t, http.StatusOK, resp.StatusCode, target)
+				assert.Equal(t, tt.wantCacheControl, resp.Header.Get(fiber.HeaderCacheControl), target)
+				assert.Equal(t, dbrouter.HeaderLastWriteLSN, resp.Header.Get(fiber.HeaderVary), target)
+			}
+		})
+	}
+}
@@ -1,7 +1,11 @@
 package products
 
 import (
+	"strings"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/currencies"
 	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 )
 
@@ -10,19 +14,74 @@ import (
 type (
 	// createProductRequest - request model for creation.
 	createProductRequest struct {
-		Name        string          `json:"name" valid:"required,max=255"`
-		Vendor      string          `json:"vendor" valid:"required,max=255"`
-		Description string          `json:"description" valid:"max=10000"`
-		Price       decimal.Decimal `json:"price" valid:"min=0,max=1000000"`
+		Name        string           `json:"name" valid:"required,max=255"`
+		VendorID    *uuid.UUID       `json:"vendor_id"`
+		Vendor      string           `json:"vendor" valid:"max=255"`
+		Description string           `json:"description" valid:"max=10000"`
+		Price       *decimal.Decimal `json:"price" valid:"required,min=0,max=1000000"`
+		Currency    string           `json:"currency"`
+		CategoryIDs []uuid.UUID      `json:"category_ids"`
+		Tags        []string         `json:"tags"`
+	}
+
+	// updateProductRequest - request model for update.
+	updateProductRequest struct {
+		Name        string      `json:"name" valid:"required,max=255"`
+		VendorID    *uuid.UUID  `json:"vendor_id"`
+		Vendor      string      `json:"vendor" valid:"max=255"`
+		Description string      `json:"description" valid:"max=10000"`
+		CategoryIDs []uuid.UUID `json:"category_ids"`
+		Tags        []string    `json:"tags"`
 	}
 )
 
+// normalize trims strings of the request, so blank strings are empty.
+func (r *createProductRequest) normalize() {
+	r.Name = strings.TrimSpace(r.Name)
+	r.Vendor = strings.TrimSpace(r.Vendor)
+	r.Description = strings.TrimSpace(r.Description)
+	r.Currency = currencies.Normalize(r.Currency)
+}
+
+// normalize trims strings of the request, so blank strings are empty.
+func (r *updateProductRequest) normalize() {
+	r.Name = strings.TrimSpace(r.Name)
+	r.Vendor = strings.TrimSpace(r.Vendor)
+	r.Description = strings.TrimSpace(r.Description)
+}
+
 // toDomain converts request model to domain model.
 func (r createProductRequest) toDomain() products.Product {
 	return products.Product{
 		Name:        r.Name,
+		VendorID:    vendorID(r.VendorID),
 		Vendor:      r.Vendor,
 		Description: r.Description,
-		Price:       r.Price,
+		Price:       *r.Price,
+		Currency:    r.Currency,
+		CategoryIDs: r.CategoryIDs,
+		Tags:        products.NormalizeTags(r.Tags),
 	}
 }
+
+// toDomain converts request model to domain model.
+func (r updateProductRequest) toDomain(id uuid.UUID) products.Product {
+	return products.Product{
+		ID:          id,
+		Name:        r.Name,
+		VendorID:    vendorID(r.VendorID),
+		Vendor:      r.Vendor,
+		Description: r.Description,
+		CategoryIDs: r.CategoryIDs,
+		Tags:        products.NormalizeTags(r.Tags),
+	}
+}
+
+// vendorID returns vendor id of a request, uuid.Nil means the vendor is referenced by name.
+func vendorID(id *uuid.UUID) uuid.UUID {
+	if id == nil {
+		return uuid.Nil
+	}
+
+	return *id
+}
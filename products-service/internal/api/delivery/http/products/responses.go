@@ -20,13 +20,27 @@ type (
 	productResponse struct {
 		ID          uuid.UUID       `json:"id"`
 		Name        string          `json:"name"`
+		VendorID    uuid.UUID       `json:"vendor_id"`
 		Vendor      string          `json:"vendor"`
 		Description string          `json:"description"`
 		Price       decimal.Decimal `json:"price"`
+		Currency    string          `json:"currency"`
+		CategoryIDs []uuid.UUID     `json:"category_ids"`
+		Tags        []string        `json:"tags"`
+		Images      []imageResponse `json:"images"`
 		CreatedAt   time.Time       `json:"created_at"`
 		UpdatedAt   time.Time       `json:"updated_at"`
 	}
 
+	// imageResponse is a response for an image of a product.
+	imageResponse struct {
+		ID          uuid.UUID `json:"id"`
+		ContentType string    `json:"content_type"`
+		Size        int64     `json:"size"`
+		Checksum    string    `json:"checksum"`
+		URL         string    `json:"url"`
+	}
+
 	// productListResponse is a response for a list of products.
 	productListResponse struct {
 		Pagination paginationResponse `json:"pagination"`
@@ -36,12 +50,28 @@ type (
 
 // fromDomain converts domain model to response model.
 func fromDomain(p products.Product) productResponse {
+	imgs := make([]imageResponse, 0, len(p.Images))
+	for _, img := range p.Images {
+		imgs = append(imgs, imageResponse{
+			ID:          img.ID,
+			ContentType: img.ContentType,
+			Size:        img.Size,
+			Checksum:    img.Checksum,
+			URL:         img.URL,
+		})
+	}
+
 	return productResponse{
 		ID:          p.ID,
 		Name:        p.Name,
+		VendorID:    p.VendorID,
 		Vendor:      p.Vendor,
 		Description: p.Description,
 		Price:       p.Price,
+		Currency:    p.Currency,
+		CategoryIDs: p.CategoryIDs,
+		Tags:        p.Tags,
+		Images:      imgs,
 		CreatedAt:   p.CreatedAt,
 		UpdatedAt:   p.UpdatedAt,
 	}
@@ -49,7 +79,7 @@ func fromDomain(p products.Product) productResponse {
 
 // fromDomainList converts domain model to response model.
 func fromDomainList(list products.ProductList, limit, offset uint64) productListResponse {
-	result := make([]productResponse, 0, list.Total)
+	result := make([]productResponse, 0, len(list.Products))
 	for _, msg := range list.Products {
 		result = append(result, fromDomain(msg))
 	}
@@ -0,0 +1,51 @@
+package products
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Checks of requests which field tags can't express, so go-validator doesn't generate them into validate.go.
+
+// validateVendor validates that a product references a vendor either by id or by name.
+func validateVendor(id *uuid.UUID, name string) []string {
+	if id == nil && strings.TrimSpace(name) == "" {
+		return []string{"vendor::is_required"}
+	}
+
+	return nil
+}
+
+// validatePricePrecision validates that a price has no more decimal places than prices are stored with.
+func validatePricePrecision(price *decimal.Decimal) []string {
+	if price != nil && !price.Equal(price.Truncate(products.PriceDecimals)) {
+		return []string{"price::max_decimals_is::" + strconv.Itoa(products.PriceDecimals)}
+	}
+
+	return nil
+}
+
+// validateTags validates tags of a product.
+func validateTags(tags []string) []string {
+	var errs []string
+	if len(tags) > products.MaxTags {
+		errs = append(errs, "tags::max_items_is::"+strconv.Itoa(products.MaxTags))
+	}
+
+	for _, tag := range tags {
+		tag = products.NormalizeTag(tag)
+		if tag == "" {
+			errs = append(errs, "tags::item_is_required")
+		}
+		if utf8.RuneCountInString(tag) > products.MaxTagLength {
+			errs = append(errs, "tags::item_max_length_is::"+strconv.Itoa(products.MaxTagLength))
+		}
+	}
+
+	return errs
+}
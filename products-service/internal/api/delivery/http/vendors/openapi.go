@@ -0,0 +1,46 @@
+package vendors
+
+import (
+	"net/http"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/openapi"
+)
+
+// Endpoints describes handlers of vendors for the OpenAPI document.
+var Endpoints = map[string]openapi.Endpoint{
+	"GetAll": {
+		Summary: "Get vendors",
+		Query: []openapi.Parameter{
+			openapi.QueryParam("limit", "Max number of vendors, 10 by default, 100 at most", uint64(0)),
+			openapi.QueryParam("offset", "Number of vendors to skip", uint64(0)),
+		},
+		Status:   http.StatusOK,
+		Response: vendorListResponse{},
+	},
+	"GetByID": {
+		Summary:  "Get a vendor",
+		Status:   http.StatusOK,
+		Response: vendorResponse{},
+		Errors:   []int{http.StatusBadRequest, http.StatusNotFound},
+	},
+	"Create": {
+		Summary:     "Create a vendor",
+		Description: "Names differing only in case, punctuation or legal suffix are the same vendor.",
+		Request:     vendorRequest{},
+		Status:      http.StatusCreated,
+		Response:    vendorResponse{},
+		Errors:      []int{http.StatusBadRequest, http.StatusConflict},
+	},
+	"Update": {
+		Summary:  "Rename a vendor",
+		Request:  vendorRequest{},
+		Status:   http.StatusOK,
+		Response: vendorResponse{},
+		Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict},
+	},
+	"Delete": {
+		Summary: "Delete a vendor without products",
+		Status:  http.StatusNoContent,
+		Errors:  []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict},
+	},
+}
@@ -0,0 +1,23 @@
+package vendors
+
+import (
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/vendors"
+	"github.com/google/uuid"
+)
+
+//go:generate go-validator
+
+type (
+	// vendorRequest - request model for creation and update.
+	vendorRequest struct {
+		Name string `json:"name" valid:"required,max=255"`
+	}
+)
+
+// toDomain converts request model to domain model.
+func (r vendorRequest) toDomain(id uuid.UUID) vendors.Vendor {
+	return vendors.Vendor{
+		ID:   id,
+		Name: r.Name,
+	}
+}
@@ -0,0 +1,58 @@
+package vendors
+
+import (
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/vendors"
+	"github.com/google/uuid"
+)
+
+type (
+	// paginationResponse is a model to store pagination parameters.
+	paginationResponse struct {
+		Offset uint64 `json:"offset"`
+		Limit  uint64 `json:"limit"`
+		Total  uint64 `json:"total"`
+	}
+
+	// vendorResponse is a response for a vendor.
+	vendorResponse struct {
+		ID        uuid.UUID `json:"id"`
+		Name      string    `json:"name"`
+		CreatedAt time.Time `json:"created_at"`
+		UpdatedAt time.Time `json:"updated_at"`
+	}
+
+	// vendorListResponse is a response for a list of vendors.
+	vendorListResponse struct {
+		Pagination paginationResponse `json:"pagination"`
+		Vendors    []vendorResponse   `json:"vendors"`
+	}
+)
+
+// fromDomain converts domain model to response model.
+func fromDomain(v vendors.Vendor) vendorResponse {
+	return vendorResponse{
+		ID:        v.ID,
+		Name:      v.Name,
+		CreatedAt: v.CreatedAt,
+		UpdatedAt: v.UpdatedAt,
+	}
+}
+
+// fromDomainList converts domain model to response model.
+func fromDomainList(list vendors.VendorList, limit, offset uint64) vendorListResponse {
+	result := make([]vendorResponse, 0, len(list.Vendors))
+	for _, v := range list.Vendors {
+		result = append(result, fromDomain(v))
+	}
+
+	return vendorListResponse{
+		Pagination: paginationResponse{
+			Offset: offset,
+			Limit:  limit,
+			Total:  list.Total,
+		},
+		Vendors: result,
+	}
+}
@@ -0,0 +1,135 @@
+package vendors
+
+import (
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/platform/responder"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery"
+	dproducts "github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/services"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ delivery.VendorsHTTPHandler = &Handler{}
+
+type (
+	// Handler defines a Handler for HTTP requests for vendors.
+	Handler struct {
+		responder.Responder
+
+		service services.VendorsService
+		log     *zap.Logger
+	}
+)
+
+// NewHandler - create new handler.
+func NewHandler(responder responder.Responder, service services.VendorsService, log *zap.Logger) *Handler {
+	return &Handler{
+		Responder: responder,
+		service:   service,
+		log:       log.With(zap.String("http_handler", "vendors")),
+	}
+}
+
+// Create - create new vendor, names differing only in case or legal suffix are the same vendor:
+//   - POST /vendors
+func (h Handler) Create(ctx *fiber.Ctx) error {
+	var req vendorRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return errs.BadRequest{Cause: "invalid JSON body"}
+	}
+
+	if errsList := req.Validate(); len(errsList) != 0 {
+		return errs.FieldsValidation{Errors: errsList}
+	}
+
+	vendor, err := h.service.Create(ctx.Context(), req.toDomain(uuid.Nil))
+	if err != nil {
+		return err
+	}
+
+	return h.Respond(ctx, fiber.StatusCreated, fromDomain(vendor))
+}
+
+// GetAll - get all vendors:
+//   - GET /vendors
+//   - GET /vendors?limit=2&offset=1
+func (h Handler) GetAll(ctx *fiber.Ctx) error {
+	limit := uint64(ctx.QueryInt("limit", dproducts.DefaultLimit))
+	if limit == 0 {
+		limit = dproducts.DefaultLimit
+	}
+	if limit > dproducts.MaxLimit {
+		limit = dproducts.MaxLimit
+	}
+
+	offsetInt := ctx.QueryInt("offset", dproducts.DefaultOffset)
+	if offsetInt < 0 {
+		offsetInt = dproducts.DefaultOffset
+	}
+	offset := uint64(offsetInt)
+
+	list, err := h.service.GetAll(ctx.Context(), limit, offset)
+	if err != nil {
+		return err
+	}
+
+	return h.Respond(ctx, fiber.StatusOK, fromDomainList(list, limit, offset))
+}
+
+// GetByID - get vendor by id:
+//   - GET /vendors/:id
+func (h Handler) GetByID(ctx *fiber.Ctx) error {
+	vendorID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return errs.BadRequest{Cause: "invalid vendor id"}
+	}
+
+	vendor, err := h.service.GetByID(ctx.Context(), vendorID)
+	if err != nil {
+		return err
+	}
+
+	return h.Respond(ctx, fiber.StatusOK, fromDomain(vendor))
+}
+
+// Update - rename a vendor:
+//   - PUT /vendors/:id
+func (h Handler) Update(ctx *fiber.Ctx) error {
+	vendorID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return errs.BadRequest{Cause: "invalid vendor id"}
+	}
+
+	var req vendorRequest
+	if err = ctx.BodyParser(&req); err != nil {
+		return errs.BadRequest{Cause: "invalid JSON body"}
+	}
+
+	if errsList := req.Validate(); len(errsList) != 0 {
+		return errs.FieldsValidation{Errors: errsList}
+	}
+
+	vendor, err := h.service.Update(ctx.Context(), req.toDomain(vendorID))
+	if err != nil {
+		return err
+	}
+
+	return h.Respond(ctx, fiber.StatusOK, fromDomain(vendor))
+}
+
+// Delete - delete vendor by id, vendors with products can't be deleted:
+//   - DELETE /vendors/:id
+func (h Handler) Delete(ctx *fiber.Ctx) error {
+	vendorID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return errs.BadRequest{Cause: "invalid vendor id"}
+	}
+
+	if err = h.service.Delete(ctx.Context(), vendorID); err != nil {
+		return err
+	}
+
+	return h.RespondEmpty(ctx, fiber.StatusNoContent)
+}
@@ -0,0 +1,21 @@
+package categories
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// Category struct represents a node of the categories tree.
+	Category struct {
+		ID        uuid.UUID
+		ParentID  *uuid.UUID // nil for root categories
+		Name      string
+		CreatedAt time.Time
+		UpdatedAt time.Time
+	}
+
+	// Categories describe a list of Categories.
+	Categories []Category
+)
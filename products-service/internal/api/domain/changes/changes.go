@@ -0,0 +1,74 @@
+package changes
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	"github.com/google/uuid"
+)
+
+// Types of changes, same as types of events sent to notifications-service.
+const (
+	TypeCreate = "create_product"
+	TypeUpdate = "update_product"
+	TypeDelete = "delete_product"
+)
+
+// MaxBatch - max number of changes read at once.
+const MaxBatch = 100
+
+type (
+	// Position - position of a change in the feed. Changes are ordered by their transactions and then by
+	// their ids, so a change committed later is never placed before a change which was already read.
+	Position struct {
+		TxID uint64
+		ID   int64
+	}
+
+	// Change - committed change of a product.
+	Change struct {
+		Position  Position
+		ProductID uuid.UUID
+		Type      string // one of Type* values
+		ChangedAt time.Time
+		// Product - current state of the product for creations and updates, it may be newer than the change,
+		// nil when the product is deleted since.
+		Product *products.Product
+	}
+
+	// Changes - list of changes.
+	Changes []Change
+)
+
+// String returns the position as an id of events, e.g. "1042-17".
+func (p Position) String() string {
+	return strconv.FormatUint(p.TxID, 10) + "-" + strconv.FormatInt(p.ID, 10)
+}
+
+// After reports whether the position is after another one.
+func (p Position) After(other Position) bool {
+	return p.TxID > other.TxID || p.TxID == other.TxID && p.ID > other.ID
+}
+
+// ParsePosition parses a position formatted by Position.String.
+func ParsePosition(s string) (Position, error) {
+	tx, id, ok := strings.Cut(s, "-")
+	if !ok {
+		return Position{}, fmt.Errorf("invalid position %q", s)
+	}
+
+	txID, err := strconv.ParseUint(tx, 10, 64)
+	if err != nil {
+		return Position{}, fmt.Errorf("invalid position %q: %w", s, err)
+	}
+
+	changeID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil || changeID < 0 {
+		return Position{}, fmt.Errorf("invalid position %q", s)
+	}
+
+	return Position{TxID: txID, ID: changeID}, nil
+}
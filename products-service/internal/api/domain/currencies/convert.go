@@ -0,0 +1,43 @@
+package currencies
+
+import (
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/shopspring/decimal"
+)
+
+// pricePrecision defines number of decimal places for converted prices.
+const pricePrecision = 2
+
+// Convert converts amount from one currency to another through the Base currency.
+func (r Rates) Convert(amount decimal.Decimal, from, to string) (decimal.Decimal, error) {
+	if from == to {
+		return amount, nil
+	}
+
+	fromRate, ok := r.find(from)
+	if !ok {
+		return decimal.Decimal{}, errs.BadRequest{Cause: "no exchange rate for currency " + from}
+	}
+
+	toRate, ok := r.find(to)
+	if !ok {
+		return decimal.Decimal{}, errs.BadRequest{Cause: "no exchange rate for currency " + to}
+	}
+
+	return amount.Div(fromRate).Mul(toRate).Round(pricePrecision), nil
+}
+
+// find returns rate for currency.
+func (r Rates) find(currency string) (decimal.Decimal, bool) {
+	if currency == Base {
+		return decimal.NewFromInt(1), true
+	}
+
+	for _, rate := range r {
+		if rate.Currency == currency {
+			return rate.Rate, true
+		}
+	}
+
+	return decimal.Decimal{}, false
+}
@@ -0,0 +1,47 @@
+package currencies
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+// TestRates_Convert tests conversion of amounts between currencies.
+func TestRates_Convert(t *testing.T) {
+	rates := Rates{
+		{Currency: "EUR", Rate: decimal.RequireFromString("0.9")},
+		{Currency: "UAH", Rate: decimal.RequireFromString("41.5")},
+	}
+
+	tests := []struct {
+		name    string
+		amount  string
+		from    string
+		to      string
+		want    string
+		wantErr bool
+	}{
+		{name: "same currency", amount: "10.5", from: "EUR", to: "EUR", want: "10.5"},
+		{name: "from base", amount: "100", from: Base, to: "EUR", want: "90"},
+		{name: "to base", amount: "90", from: "EUR", to: Base, want: "100"},
+		{name: "cross rate", amount: "9", from: "EUR", to: "UAH", want: "415"},
+		{name: "rounded to cents", amount: "1", from: "UAH", to: "EUR", want: "0.02"},
+		{name: "unknown source", amount: "1", from: "GBP", to: Base, wantErr: true},
+		{name: "unknown target", amount: "1", from: Base, to: "GBP", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := rates.Convert(decimal.RequireFromString(tt.amount), tt.from, tt.to)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Convert() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !got.Equal(decimal.RequireFromString(tt.want)) {
+				t.Errorf("Convert() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
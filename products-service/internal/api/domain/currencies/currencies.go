@@ -0,0 +1,33 @@
+package currencies
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Base defines the base currency, every rate is expressed as units of currency per one Base.
+const Base = "USD"
+
+// codeRegexp matches ISO 4217 alphabetic currency codes.
+var codeRegexp = regexp.MustCompile(`^[A-Z]{3}$`)
+
+type (
+	// Rate describes an exchange rate of a currency against the Base currency.
+	Rate struct {
+		Currency  string
+		Rate      decimal.Decimal
+		UpdatedAt time.Time
+	}
+
+	// Rates describe a list of Rates.
+	Rates []Rate
+)
+
+// Normalize returns upper-cased and trimmed currency code.
+func Normalize(code string) string { return strings.ToUpper(strings.TrimSpace(code)) }
+
+// IsValidCode reports whether code is a well-formed ISO 4217 currency code.
+func IsValidCode(code string) bool { return codeRegexp.MatchString(code) }
@@ -0,0 +1,31 @@
+package events
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// Republish describes events of products to publish again, e.g. after notifications were lost. Events of
+	// the product of ProductID, or of all products changed within the range, are published: create_product when
+	// the product was created within the range and update_product when it was last updated within the range.
+	// Zero bounds of the range are not bounds, deletes are not stored, so their events are not published.
+	Republish struct {
+		ProductID *uuid.UUID
+		From      time.Time
+		To        time.Time
+	}
+
+	// RepublishResult describes published events.
+	RepublishResult struct {
+		Products int // products found
+		Created  int // create_product events published
+		Updated  int // update_product events published
+	}
+)
+
+// Within reports whether a time is within the range of the request.
+func (r Republish) Within(t time.Time) bool {
+	return (r.From.IsZero() || !t.Before(r.From)) && (r.To.IsZero() || t.Before(r.To))
+}
@@ -0,0 +1,48 @@
+package images
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// extensions - supported content types of images with their file extensions.
+var extensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+type (
+	// Image describes a product image stored in the object storage.
+	Image struct {
+		ID          uuid.UUID
+		ProductID   uuid.UUID
+		Key         string // object key in the bucket
+		ContentType string
+		Size        int64
+		Checksum    string // hex encoded SHA-256 of the content
+		URL         string // presigned GET URL, set on read
+		CreatedAt   time.Time
+	}
+
+	// Images describe a list of Images.
+	Images []Image
+)
+
+// IsSupportedContentType reports whether images of the content type can be uploaded.
+func IsSupportedContentType(contentType string) bool {
+	_, ok := extensions[contentType]
+	return ok
+}
+
+// ProductPrefix returns the object key prefix of all images of a product.
+func ProductPrefix(productID uuid.UUID) string {
+	return "products/" + productID.String() + "/"
+}
+
+// Key returns the object key of an image.
+func Key(productID, id uuid.UUID, contentType string) string {
+	return ProductPrefix(productID) + id.String() + extensions[contentType]
+}
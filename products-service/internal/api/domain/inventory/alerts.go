@@ -0,0 +1,61 @@
+package inventory
+
+import "github.com/google/uuid"
+
+// Alert types sent to notifications services.
+const (
+	AlertStockLow   = "stock_low"
+	AlertOutOfStock = "out_of_stock"
+)
+
+// level describes how much of a stock is available, the higher the worse.
+type level int
+
+const (
+	levelInStock level = iota
+	levelLow
+	levelOut
+)
+
+// Alert describes a stock that became low or ran out.
+type Alert struct {
+	Type      string
+	ProductID uuid.UUID
+	Warehouse string
+	Available int64
+	Threshold int64
+}
+
+// level returns availability level of a stock.
+func (s Stock) level() level {
+	switch available := s.Available(); {
+	case available == 0:
+		return levelOut
+	case available <= s.LowStockThreshold:
+		return levelLow
+	}
+
+	return levelInStock
+}
+
+// NewAlert returns an alert when a stock became worse from before to after,
+// so every drop is reported once instead of on every change.
+func NewAlert(before, after Stock) (Alert, bool) {
+	lvl := after.level()
+	if lvl <= before.level() {
+		return Alert{}, false
+	}
+
+	alert := Alert{
+		Type:      AlertStockLow,
+		ProductID: after.ProductID,
+		Warehouse: after.Warehouse,
+		Available: after.Available(),
+		Threshold: after.LowStockThreshold,
+	}
+	if lvl == levelOut {
+		alert.Type = AlertOutOfStock
+	}
+
+	return alert, true
+}
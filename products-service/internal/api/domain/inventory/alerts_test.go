@@ -0,0 +1,59 @@
+package inventory
+
+import "testing"
+
+// TestNewAlert tests that alerts are raised only when a stock gets worse.
+func TestNewAlert(t *testing.T) {
+	tests := []struct {
+		name   string
+		before Stock
+		after  Stock
+		want   string
+	}{
+		{
+			name:   "still in stock",
+			before: Stock{Quantity: 20, LowStockThreshold: 5},
+			after:  Stock{Quantity: 10, LowStockThreshold: 5},
+		},
+		{
+			name:   "became low",
+			before: Stock{Quantity: 10, LowStockThreshold: 5},
+			after:  Stock{Quantity: 10, Reserved: 5, LowStockThreshold: 5},
+			want:   AlertStockLow,
+		},
+		{
+			name:   "still low",
+			before: Stock{Quantity: 5, LowStockThreshold: 5},
+			after:  Stock{Quantity: 4, LowStockThreshold: 5},
+		},
+		{
+			name:   "ran out",
+			before: Stock{Quantity: 5, LowStockThreshold: 5},
+			after:  Stock{Quantity: 5, Reserved: 5, LowStockThreshold: 5},
+			want:   AlertOutOfStock,
+		},
+		{
+			name:   "ran out without threshold",
+			before: Stock{Quantity: 3},
+			after:  Stock{},
+			want:   AlertOutOfStock,
+		},
+		{
+			name:   "restocked",
+			before: Stock{},
+			after:  Stock{Quantity: 3, LowStockThreshold: 5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alert, ok := NewAlert(tt.before, tt.after)
+			if ok != (tt.want != "") {
+				t.Fatalf("NewAlert() ok = %v, want alert %q", ok, tt.want)
+			}
+			if alert.Type != tt.want {
+				t.Errorf("NewAlert() type = %q, want %q", alert.Type, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package inventory
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultWarehouse is used when stock is not tracked per warehouse.
+	DefaultWarehouse = "default"
+	// MaxWarehouseLength is max length of a warehouse code.
+	MaxWarehouseLength = 64
+
+	// DefaultReservationTTL is used when a reservation is created without ttl.
+	DefaultReservationTTL = 15 * time.Minute
+	// MaxReservationTTL is max time a reservation can hold units.
+	MaxReservationTTL = 24 * time.Hour
+)
+
+type (
+	// Stock describes units of a product in a warehouse.
+	Stock struct {
+		ProductID         uuid.UUID
+		Warehouse         string
+		Quantity          int64 // units on hand, reserved units included
+		Reserved          int64 // units held by not released and not expired reservations
+		LowStockThreshold int64 // 0 disables stock_low alerts
+		UpdatedAt         time.Time
+	}
+
+	// Stocks describe a list of Stocks.
+	Stocks []Stock
+
+	// Adjustment describes a change of units on hand.
+	Adjustment struct {
+		ProductID         uuid.UUID
+		Warehouse         string
+		Delta             int64
+		LowStockThreshold *int64 // nil keeps the current threshold
+	}
+
+	// Reservation describes units held for a while, e.g. until an order is paid.
+	Reservation struct {
+		ID         uuid.UUID
+		ProductID  uuid.UUID
+		Warehouse  string
+		Quantity   int64
+		ExpiresAt  time.Time
+		ReleasedAt *time.Time
+		CreatedAt  time.Time
+	}
+)
+
+// Available returns units that can be reserved.
+func (s Stock) Available() int64 {
+	return max(s.Quantity-s.Reserved, 0)
+}
+
+// Available returns units that can be reserved in all warehouses.
+func (s Stocks) Available() int64 {
+	var total int64
+	for _, stock := range s {
+		total += stock.Available()
+	}
+
+	return total
+}
@@ -12,5 +12,8 @@ type (
 	Metrics struct {
 		ProductCreatedCounter prometheus.Counter
 		ProductDeletedCounter prometheus.Counter
+		CacheHitsCounter      *prometheus.CounterVec // labeled by cache
+		CacheMissesCounter    *prometheus.CounterVec // labeled by cache
+		ConfigReloadsCounter  *prometheus.CounterVec // labeled by result
 	}
 )
@@ -0,0 +1,24 @@
+package prices
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type (
+	// Price describes a product price effective within a period of time.
+	Price struct {
+		ID            uuid.UUID
+		ProductID     uuid.UUID
+		Amount        decimal.Decimal
+		Currency      string
+		EffectiveFrom time.Time
+		EffectiveTo   *time.Time // nil means the price is effective until the next one is scheduled
+		CreatedAt     time.Time
+	}
+
+	// Prices describe a list of Prices.
+	Prices []Price
+)
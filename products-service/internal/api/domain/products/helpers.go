@@ -1,8 +1,37 @@
 package products
 
+import (
+	"slices"
+	"strings"
+)
+
 // Constants for pagination.
 const (
 	MaxLimit      = 100
 	DefaultLimit  = 10
 	DefaultOffset = 0
 )
+
+// PriceDecimals is the max number of decimal places of prices, the precision of prices in the database.
+const PriceDecimals = 2
+
+// Constants for tags.
+const (
+	MaxTags      = 20
+	MaxTagLength = 64
+)
+
+// NormalizeTag returns lower-cased and trimmed tag.
+func NormalizeTag(tag string) string { return strings.ToLower(strings.TrimSpace(tag)) }
+
+// NormalizeTags returns sorted list of unique normalized tags.
+func NormalizeTags(tags []string) []string {
+	result := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		result = append(result, NormalizeTag(tag))
+	}
+
+	slices.Sort(result)
+
+	return slices.Compact(result)
+}
@@ -0,0 +1,26 @@
+package products
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	tests := []struct {
+		name string
+		tags []string
+		want []string
+	}{
+		{name: "none", tags: nil, want: []string{}},
+		{name: "trimmed and lower-cased", tags: []string{" Sale ", "NEW"}, want: []string{"new", "sale"}},
+		{name: "duplicates after normalization", tags: []string{"sale", "Sale", " SALE"}, want: []string{"sale"}},
+		{name: "sorted", tags: []string{"b", "c", "a"}, want: []string{"a", "b", "c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NormalizeTags(tt.tags))
+		})
+	}
+}
@@ -3,6 +3,7 @@ package products
 import (
 	"time"
 
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/images"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 )
@@ -12,9 +13,14 @@ type (
 	Product struct {
 		ID          uuid.UUID
 		Name        string
-		Vendor      string
+		VendorID    uuid.UUID
+		Vendor      string // vendor name, used to find or create the vendor when VendorID is not set
 		Description string
 		Price       decimal.Decimal
+		Currency    string
+		CategoryIDs []uuid.UUID
+		Tags        []string
+		Images      images.Images
 		CreatedAt   time.Time
 		UpdatedAt   time.Time
 	}
@@ -27,4 +33,26 @@ type (
 		Total    uint64
 		Products Products
 	}
+
+	// Filter describes optional conditions for listing products.
+	Filter struct {
+		CategoryID *uuid.UUID // products of the category and all its descendants
+		VendorID   *uuid.UUID
+		Tag        string
+		// products created or updated at ChangedFrom or later and before ChangedTo, zero values are not bounds
+		ChangedFrom time.Time
+		ChangedTo   time.Time
+		After       *Key // products after the key in the order of lists, to read pages by keys instead of offsets
+	}
+
+	// Key describes the position of a product in lists, which are ordered by creation time and id, newest first.
+	Key struct {
+		CreatedAt time.Time
+		ID        uuid.UUID
+	}
 )
+
+// Key returns the position of the product in lists.
+func (p Product) Key() Key {
+	return Key{CreatedAt: p.CreatedAt, ID: p.ID}
+}
@@ -0,0 +1,26 @@
+package vendors
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// Vendor struct represents a product vendor / manufacturer.
+	Vendor struct {
+		ID        uuid.UUID
+		Name      string
+		CreatedAt time.Time
+		UpdatedAt time.Time
+	}
+
+	// Vendors describe a list of Vendors.
+	Vendors []Vendor
+
+	// VendorList describes a list of Vendors with their total number.
+	VendorList struct {
+		Total   uint64
+		Vendors Vendors
+	}
+)
@@ -0,0 +1,283 @@
+package categories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/categories"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/pgerrs"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+var _ repositories.CategoriesRepository = &Repository{}
+
+// subtreeQuery selects ids of a category ($1) and all its descendants, UNION ends the recursion even on a cycle.
+const subtreeQuery = `
+	WITH RECURSIVE tree AS (
+		SELECT id FROM categories WHERE id = $1
+		UNION
+		SELECT c.id FROM categories c JOIN tree t ON c.parent_id = t.id
+	)
+	SELECT id FROM tree
+	`
+
+type (
+	// Repository - defines a repositories.
+	Repository struct {
+		db     sqlx.ExtContext
+		logger *zap.Logger
+	}
+)
+
+// NewRepository creates a new repositories.
+func NewRepository(db sqlx.ExtContext, logger *zap.Logger) repositories.CategoriesRepository {
+	return &Repository{db: db, logger: logger.With(zap.String("repositories", "categories"))}
+}
+
+// Create creates a new category.
+func (r *Repository) Create(ctx context.Context, e categories.Category) (categories.Category, error) {
+	if ctx.Err() != nil {
+		return categories.Category{}, ctx.Err()
+	}
+
+	query := `
+	INSERT INTO categories (parent_id, name)
+	VALUES ($1, $2)
+	RETURNING id, parent_id, name, created_at, updated_at;
+	`
+
+	var dbc dbCategory
+	if err := sqlx.GetContext(ctx, r.db, &dbc, query, e.ParentID, e.Name); err != nil {
+		return categories.Category{}, mapError(err)
+	}
+
+	return dbc.toDomain(), nil
+}
+
+// GetAll returns all categories ordered by name.
+func (r *Repository) GetAll(ctx context.Context) (categories.Categories, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	query := `SELECT id, parent_id, name, created_at, updated_at FROM categories ORDER BY name;`
+
+	var dbItems []dbCategory
+	if err := sqlx.SelectContext(ctx, r.db, &dbItems, query); err != nil {
+		return nil, pgerrs.Map(err)
+	}
+
+	items := make(categories.Categories, len(dbItems))
+	for i, dbc := range dbItems {
+		items[i] = dbc.toDomain()
+	}
+
+	return items, nil
+}
+
+// GetByID returns a category by id.
+func (r *Repository) GetByID(ctx context.Context, id uuid.UUID) (categories.Category, error) {
+	if ctx.Err() != nil {
+		return categories.Category{}, ctx.Err()
+	}
+
+	query := `SELECT id, parent_id, name, created_at, updated_at FROM categories WHERE id = $1;`
+
+	var dbc dbCategory
+	if err := sqlx.GetContext(ctx, r.db, &dbc, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return categories.Category{}, errs.NotFound{What: "category"}
+		}
+
+		return categories.Category{}, pgerrs.Map(err)
+	}
+
+	return dbc.toDomain(), nil
+}
+
+// Update updates name and parent of a category, a category can't be moved under its own subtree.
+// Should be called inside a transaction.
+func (r *Repository) Update(ctx context.Context, e categories.Category) (categories.Category, error) {
+	if ctx.Err() != nil {
+		return categories.Category{}, ctx.Err()
+	}
+
+	if e.ParentID != nil {
+		if err := r.lockMove(ctx, e.ID, *e.ParentID); err != nil {
+			return categories.Category{}, err
+		}
+
+		inSubtree, err := r.inSubtree(ctx, e.ID, *e.ParentID)
+		if err != nil {
+			return categories.Category{}, err
+		}
+		if inSubtree {
+			return categories.Category{}, errs.BadRequest{Cause: "category can't be moved under itself or its descendant"}
+		}
+	}
+
+	query := `
+	UPDATE categories
+	SET parent_id = $2, name = $3
+	WHERE id = $1
+	RETURNING id, parent_id, name, created_at, updated_at;
+	`
+
+	var dbc dbCategory
+	if err := sqlx.GetContext(ctx, r.db, &dbc, query, e.ID, e.ParentID, e.Name); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return categories.Category{}, errs.NotFound{What: "category"}
+		}
+
+		return categories.Category{}, mapError(err)
+	}
+
+	return dbc.toDomain(), nil
+}
+
+// Delete removes a category. When reassignTo is set, products and subcategories of the category
+// are moved to it first, otherwise deleting a category with products or subcategories is a conflict.
+// Should be called inside a transaction.
+func (r *Repository) Delete(ctx context.Context, id uuid.UUID, reassignTo *uuid.UUID) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	if reassignTo != nil {
+		if err := r.reassign(ctx, id, *reassignTo); err != nil {
+			return err
+		}
+	}
+
+	var attached struct {
+		Products      bool `db:"products"`
+		Subcategories bool `db:"subcategories"`
+	}
+
+	query := `
+	SELECT EXISTS (SELECT 1 FROM product_categories WHERE category_id = $1) AS products,
+	       EXISTS (SELECT 1 FROM categories WHERE parent_id = $1) AS subcategories;
+	`
+
+	if err := sqlx.GetContext(ctx, r.db, &attached, query, id); err != nil {
+		return pgerrs.Map(err)
+	}
+
+	switch {
+	case attached.Products:
+		return errs.Conflict{What: "category has products, reassignment is required"}
+	case attached.Subcategories:
+		return errs.Conflict{What: "category has subcategories, reassignment is required"}
+	}
+
+	res, err := r.db.ExecContext(ctx, `DELETE FROM categories WHERE id = $1`, id)
+	if err != nil {
+		return pgerrs.Map(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return pgerrs.Map(err)
+	}
+	if affected == 0 {
+		return errs.NotFound{What: "category"}
+	}
+
+	return nil
+}
+
+// reassign moves products and subcategories of a category to another category.
+func (r *Repository) reassign(ctx context.Context, id, to uuid.UUID) error {
+	if _, err := r.GetByID(ctx, id); err != nil {
+		return err
+	}
+
+	if _, err := r.GetByID(ctx, to); err != nil {
+		if errors.As(err, &errs.NotFound{}) {
+			return errs.BadRequest{Cause: "category to reassign to not found"}
+		}
+
+		return err
+	}
+
+	if err := r.lockMove(ctx, id, to); err != nil {
+		return err
+	}
+
+	inSubtree, err := r.inSubtree(ctx, id, to)
+	if err != nil {
+		return err
+	}
+	if inSubtree {
+		return errs.BadRequest{Cause: "category can't be reassigned to itself or its descendant"}
+	}
+
+	queries := []string{
+		`INSERT INTO product_categories (product_id, category_id)
+		SELECT product_id, $2 FROM product_categories WHERE category_id = $1
+		ON CONFLICT DO NOTHING;`,
+		`DELETE FROM product_categories WHERE category_id = $1;`,
+		`UPDATE categories SET parent_id = $2 WHERE parent_id = $1;`,
+	}
+
+	for _, query := range queries {
+		if _, err = r.db.ExecContext(ctx, query, id, to); err != nil {
+			return mapError(err)
+		}
+	}
+
+	return nil
+}
+
+// lockMove locks a category moved under a parent, and the parent with its ancestors, until the transaction ends.
+// A concurrent move linking the ancestors of the parent into the subtree of the category updates one of these rows,
+// so moves which would make a cycle together wait for each other and the later one sees the cycle.
+func (r *Repository) lockMove(ctx context.Context, id, parentID uuid.UUID) error {
+	query := `
+	WITH RECURSIVE ancestors AS (
+		SELECT id, parent_id FROM categories WHERE id = $2
+		UNION
+		SELECT c.id, c.parent_id FROM categories c JOIN ancestors a ON c.id = a.parent_id
+	)
+	SELECT id FROM categories
+	WHERE id = $1 OR id IN (SELECT id FROM ancestors)
+	ORDER BY id
+	FOR UPDATE;
+	`
+
+	var locked []uuid.UUID
+	if err := sqlx.SelectContext(ctx, r.db, &locked, query, id, parentID); err != nil {
+		return pgerrs.Map(err)
+	}
+
+	return nil
+}
+
+// inSubtree reports whether candidate is the category root or one of its descendants.
+func (r *Repository) inSubtree(ctx context.Context, root, candidate uuid.UUID) (bool, error) {
+	query := `SELECT $2 IN (` + subtreeQuery + `);`
+
+	var found bool
+	if err := sqlx.GetContext(ctx, r.db, &found, query, root, candidate); err != nil {
+		return false, pgerrs.Map(err)
+	}
+
+	return found, nil
+}
+
+// mapError maps database errors of categories to application errors.
+func mapError(err error) error {
+	switch pgerrs.Constraint(err) {
+	case "uq_categories_parent_name":
+		return errs.AlreadyExists{What: "category with the same name already exists in the parent"}
+	case "fk_categories_parent":
+		return errs.BadRequest{Cause: "parent category not found"}
+	}
+
+	return pgerrs.Map(err)
+}
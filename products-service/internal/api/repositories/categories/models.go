@@ -0,0 +1,35 @@
+package categories
+
+import (
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/categories"
+	"github.com/google/uuid"
+)
+
+type (
+	// dbCategory - defines a category in the database.
+	dbCategory struct {
+		ID        uuid.UUID     `db:"id"`
+		ParentID  uuid.NullUUID `db:"parent_id"`
+		Name      string        `db:"name"`
+		CreatedAt time.Time     `db:"created_at"`
+		UpdatedAt time.Time     `db:"updated_at"`
+	}
+)
+
+// toDomain converts dbCategory -> Category
+func (d dbCategory) toDomain() categories.Category {
+	var parentID *uuid.UUID
+	if d.ParentID.Valid {
+		parentID = &d.ParentID.UUID
+	}
+
+	return categories.Category{
+		ID:        d.ID,
+		ParentID:  parentID,
+		Name:      d.Name,
+		CreatedAt: d.CreatedAt,
+		UpdatedAt: d.UpdatedAt,
+	}
+}
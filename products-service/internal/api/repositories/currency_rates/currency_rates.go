@@ -0,0 +1,68 @@
+package currency_rates
+
+import (
+	"context"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/currencies"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/pgerrs"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+var _ repositories.CurrencyRatesRepository = &Repository{}
+
+type (
+	// Repository - defines a repositories.
+	Repository struct {
+		db     sqlx.ExtContext
+		logger *zap.Logger
+	}
+)
+
+// NewRepository creates a new repositories.
+func NewRepository(db sqlx.ExtContext, logger *zap.Logger) repositories.CurrencyRatesRepository {
+	return &Repository{db: db, logger: logger.With(zap.String("repositories", "currency_rates"))}
+}
+
+// GetAll returns all known currency rates.
+func (r *Repository) GetAll(ctx context.Context) (currencies.Rates, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	query := `SELECT currency, rate, updated_at FROM currency_rates ORDER BY currency;`
+
+	var dbItems []dbRate
+	if err := sqlx.SelectContext(ctx, r.db, &dbItems, query); err != nil {
+		return nil, pgerrs.Map(err)
+	}
+
+	items := make(currencies.Rates, len(dbItems))
+	for i, dbr := range dbItems {
+		items[i] = dbr.toDomain()
+	}
+
+	return items, nil
+}
+
+// Upsert creates or updates a currency rate.
+func (r *Repository) Upsert(ctx context.Context, e currencies.Rate) (currencies.Rate, error) {
+	if ctx.Err() != nil {
+		return currencies.Rate{}, ctx.Err()
+	}
+
+	query := `
+	INSERT INTO currency_rates (currency, rate)
+	VALUES ($1, $2)
+	ON CONFLICT (currency) DO UPDATE SET rate = EXCLUDED.rate
+	RETURNING currency, rate, updated_at;
+	`
+
+	var dbr dbRate
+	if err := sqlx.GetContext(ctx, r.db, &dbr, query, e.Currency, e.Rate); err != nil {
+		return currencies.Rate{}, pgerrs.Map(err)
+	}
+
+	return dbr.toDomain(), nil
+}
@@ -0,0 +1,26 @@
+package currency_rates
+
+import (
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/currencies"
+	"github.com/shopspring/decimal"
+)
+
+type (
+	// dbRate - defines a currency rate in the database.
+	dbRate struct {
+		Currency  string          `db:"currency"`
+		Rate      decimal.Decimal `db:"rate"`
+		UpdatedAt time.Time       `db:"updated_at"`
+	}
+)
+
+// toDomain converts dbRate -> Rate
+func (d dbRate) toDomain() currencies.Rate {
+	return currencies.Rate{
+		Currency:  d.Currency,
+		Rate:      d.Rate,
+		UpdatedAt: d.UpdatedAt,
+	}
+}
@@ -0,0 +1,115 @@
+package images
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/images"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/pgerrs"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+var _ repositories.ImagesRepository = &Repository{}
+
+type (
+	// Repository - defines a repositories.
+	Repository struct {
+		db     sqlx.ExtContext
+		logger *zap.Logger
+	}
+)
+
+// NewRepository creates a new repositories.
+func NewRepository(db sqlx.ExtContext, logger *zap.Logger) repositories.ImagesRepository {
+	return &Repository{db: db, logger: logger.With(zap.String("repositories", "images"))}
+}
+
+// Create stores metadata of an uploaded image.
+func (r *Repository) Create(ctx context.Context, e images.Image) (images.Image, error) {
+	if ctx.Err() != nil {
+		return images.Image{}, ctx.Err()
+	}
+
+	query := `
+	INSERT INTO product_images (id, product_id, key, content_type, size_bytes, checksum)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	RETURNING id, product_id, key, content_type, size_bytes, checksum, created_at;
+	`
+
+	var dbi dbImage
+	err := sqlx.GetContext(ctx, r.db, &dbi, query, e.ID, e.ProductID, e.Key, e.ContentType, e.Size, e.Checksum)
+	if err != nil {
+		if pgerrs.Constraint(err) == "fk_product_images_product" {
+			return images.Image{}, errs.NotFound{What: "product"}
+		}
+
+		return images.Image{}, pgerrs.Map(err)
+	}
+
+	return dbi.toDomain(), nil
+}
+
+// GetByProductIDs returns images of products, the oldest first.
+func (r *Repository) GetByProductIDs(ctx context.Context, productIDs []uuid.UUID) (images.Images, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	if len(productIDs) == 0 {
+		return images.Images{}, nil
+	}
+
+	ids := make(pq.StringArray, len(productIDs))
+	for i, id := range productIDs {
+		ids[i] = id.String()
+	}
+
+	query := `
+	SELECT id, product_id, key, content_type, size_bytes, checksum, created_at
+	FROM product_images
+	WHERE product_id = ANY($1::uuid[])
+	ORDER BY product_id, created_at;
+	`
+
+	var dbItems []dbImage
+	if err := sqlx.SelectContext(ctx, r.db, &dbItems, query, ids); err != nil {
+		return nil, pgerrs.Map(err)
+	}
+
+	items := make(images.Images, len(dbItems))
+	for i, dbi := range dbItems {
+		items[i] = dbi.toDomain()
+	}
+
+	return items, nil
+}
+
+// Delete removes metadata of an image of a product and returns it.
+func (r *Repository) Delete(ctx context.Context, productID, id uuid.UUID) (images.Image, error) {
+	if ctx.Err() != nil {
+		return images.Image{}, ctx.Err()
+	}
+
+	query := `
+	DELETE FROM product_images
+	WHERE id = $1 AND product_id = $2
+	RETURNING id, product_id, key, content_type, size_bytes, checksum, created_at;
+	`
+
+	var dbi dbImage
+	if err := sqlx.GetContext(ctx, r.db, &dbi, query, id, productID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return images.Image{}, errs.NotFound{What: "image"}
+		}
+
+		return images.Image{}, pgerrs.Map(err)
+	}
+
+	return dbi.toDomain(), nil
+}
@@ -0,0 +1,34 @@
+package images
+
+import (
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/images"
+	"github.com/google/uuid"
+)
+
+type (
+	// dbImage - defines a product image in the database.
+	dbImage struct {
+		ID          uuid.UUID `db:"id"`
+		ProductID   uuid.UUID `db:"product_id"`
+		Key         string    `db:"key"`
+		ContentType string    `db:"content_type"`
+		Size        int64     `db:"size_bytes"`
+		Checksum    string    `db:"checksum"`
+		CreatedAt   time.Time `db:"created_at"`
+	}
+)
+
+// toDomain converts dbImage -> Image
+func (d dbImage) toDomain() images.Image {
+	return images.Image{
+		ID:          d.ID,
+		ProductID:   d.ProductID,
+		Key:         d.Key,
+		ContentType: d.ContentType,
+		Size:        d.Size,
+		Checksum:    d.Checksum,
+		CreatedAt:   d.CreatedAt,
+	}
+}
@@ -0,0 +1,201 @@
+package inventory
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/inventory"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/pgerrs"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+var _ repositories.InventoryRepository = &Repository{}
+
+// reservedQuery sums units held by reservations of a stock level aliased as s.
+const reservedQuery = `
+	COALESCE((
+		SELECT SUM(r.quantity) FROM stock_reservations r
+		WHERE r.product_id = s.product_id AND r.warehouse = s.warehouse
+		  AND r.released_at IS NULL AND r.expires_at > now()
+	), 0) AS reserved`
+
+type (
+	// Repository - defines a repositories.
+	Repository struct {
+		db     sqlx.ExtContext
+		logger *zap.Logger
+	}
+)
+
+// NewRepository creates a new repositories.
+func NewRepository(db sqlx.ExtContext, logger *zap.Logger) repositories.InventoryRepository {
+	return &Repository{db: db, logger: logger.With(zap.String("repositories", "inventory"))}
+}
+
+// GetByProductID returns stock levels of a product in all warehouses.
+func (r *Repository) GetByProductID(ctx context.Context, productID uuid.UUID) (inventory.Stocks, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	query := `
+	SELECT s.product_id, s.warehouse, s.quantity, s.low_stock_threshold, s.updated_at,` + reservedQuery + `
+	FROM stock_levels s
+	WHERE s.product_id = $1
+	ORDER BY s.warehouse;
+	`
+
+	var dbItems []dbStock
+	if err := sqlx.SelectContext(ctx, r.db, &dbItems, query, productID); err != nil {
+		return nil, pgerrs.Map(err)
+	}
+
+	items := make(inventory.Stocks, len(dbItems))
+	for i, dbs := range dbItems {
+		items[i] = dbs.toDomain()
+	}
+
+	return items, nil
+}
+
+// Lock locks stock level of a product in a warehouse until the end of the transaction,
+// so concurrent adjustments and reservations of the stock are serialized. Missing stock level is created empty.
+// Should be called inside a transaction.
+func (r *Repository) Lock(ctx context.Context, productID uuid.UUID, warehouse string) (inventory.Stock, error) {
+	if ctx.Err() != nil {
+		return inventory.Stock{}, ctx.Err()
+	}
+
+	insertQuery := `
+	INSERT INTO stock_levels (product_id, warehouse)
+	VALUES ($1, $2)
+	ON CONFLICT DO NOTHING;
+	`
+
+	if _, err := r.db.ExecContext(ctx, insertQuery, productID, warehouse); err != nil {
+		return inventory.Stock{}, mapError(err)
+	}
+
+	query := `
+	SELECT s.product_id, s.warehouse, s.quantity, s.low_stock_threshold, s.updated_at,` + reservedQuery + `
+	FROM stock_levels s
+	WHERE s.product_id = $1 AND s.warehouse = $2
+	FOR UPDATE;
+	`
+
+	var dbs dbStock
+	if err := sqlx.GetContext(ctx, r.db, &dbs, query, productID, warehouse); err != nil {
+		return inventory.Stock{}, pgerrs.Map(err)
+	}
+
+	return dbs.toDomain(), nil
+}
+
+// Adjust changes units on hand and optionally the low stock threshold of a locked stock level.
+// Should be called inside a transaction.
+func (r *Repository) Adjust(ctx context.Context, e inventory.Adjustment) (inventory.Stock, error) {
+	if ctx.Err() != nil {
+		return inventory.Stock{}, ctx.Err()
+	}
+
+	query := `
+	WITH s AS (
+		UPDATE stock_levels
+		SET quantity = quantity + $3,
+		    low_stock_threshold = COALESCE($4, low_stock_threshold)
+		WHERE product_id = $1 AND warehouse = $2
+		RETURNING product_id, warehouse, quantity, low_stock_threshold, updated_at
+	)
+	SELECT s.product_id, s.warehouse, s.quantity, s.low_stock_threshold, s.updated_at,` + reservedQuery + `
+	FROM s;
+	`
+
+	var dbs dbStock
+	err := sqlx.GetContext(ctx, r.db, &dbs, query, e.ProductID, e.Warehouse, e.Delta, e.LowStockThreshold)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return inventory.Stock{}, errs.NotFound{What: "stock"}
+		}
+
+		return inventory.Stock{}, mapError(err)
+	}
+
+	return dbs.toDomain(), nil
+}
+
+// CreateReservation holds units of a locked stock level until the reservation expires.
+// Should be called inside a transaction.
+func (r *Repository) CreateReservation(ctx context.Context, e inventory.Reservation) (inventory.Reservation, error) {
+	if ctx.Err() != nil {
+		return inventory.Reservation{}, ctx.Err()
+	}
+
+	query := `
+	INSERT INTO stock_reservations (product_id, warehouse, quantity, expires_at)
+	VALUES ($1, $2, $3, $4)
+	RETURNING id, product_id, warehouse, quantity, expires_at, released_at, created_at;
+	`
+
+	var dbr dbReservation
+	err := sqlx.GetContext(ctx, r.db, &dbr, query, e.ProductID, e.Warehouse, e.Quantity, e.ExpiresAt)
+	if err != nil {
+		return inventory.Reservation{}, mapError(err)
+	}
+
+	return dbr.toDomain(), nil
+}
+
+// ReleaseReservation releases units held by a reservation of a product.
+func (r *Repository) ReleaseReservation(
+	ctx context.Context,
+	productID, id uuid.UUID,
+) (inventory.Reservation, error) {
+	if ctx.Err() != nil {
+		return inventory.Reservation{}, ctx.Err()
+	}
+
+	query := `
+	UPDATE stock_reservations
+	SET released_at = now()
+	WHERE id = $1 AND product_id = $2 AND released_at IS NULL
+	RETURNING id, product_id, warehouse, quantity, expires_at, released_at, created_at;
+	`
+
+	var dbr dbReservation
+	if err := sqlx.GetContext(ctx, r.db, &dbr, query, id, productID); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return inventory.Reservation{}, pgerrs.Map(err)
+		}
+
+		// tell a missing reservation from a released one
+		var exists bool
+		existsQuery := `SELECT EXISTS (SELECT 1 FROM stock_reservations WHERE id = $1 AND product_id = $2);`
+		if err = sqlx.GetContext(ctx, r.db, &exists, existsQuery, id, productID); err != nil {
+			return inventory.Reservation{}, pgerrs.Map(err)
+		}
+		if exists {
+			return inventory.Reservation{}, errs.Conflict{What: "reservation already released"}
+		}
+
+		return inventory.Reservation{}, errs.NotFound{What: "reservation"}
+	}
+
+	return dbr.toDomain(), nil
+}
+
+// mapError maps database errors of inventory to application errors.
+func mapError(err error) error {
+	switch pgerrs.Constraint(err) {
+	case "fk_stock_levels_product":
+		return errs.NotFound{What: "product"}
+	case "stock_levels_quantity_check":
+		return errs.Conflict{What: "insufficient stock"}
+	}
+
+	return pgerrs.Map(err)
+}
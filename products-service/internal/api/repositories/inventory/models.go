@@ -0,0 +1,62 @@
+package inventory
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/inventory"
+	"github.com/google/uuid"
+)
+
+type (
+	// dbStock - defines a stock level in the database.
+	dbStock struct {
+		ProductID         uuid.UUID `db:"product_id"`
+		Warehouse         string    `db:"warehouse"`
+		Quantity          int64     `db:"quantity"`
+		Reserved          int64     `db:"reserved"`
+		LowStockThreshold int64     `db:"low_stock_threshold"`
+		UpdatedAt         time.Time `db:"updated_at"`
+	}
+
+	// dbReservation - defines a stock reservation in the database.
+	dbReservation struct {
+		ID         uuid.UUID    `db:"id"`
+		ProductID  uuid.UUID    `db:"product_id"`
+		Warehouse  string       `db:"warehouse"`
+		Quantity   int64        `db:"quantity"`
+		ExpiresAt  time.Time    `db:"expires_at"`
+		ReleasedAt sql.NullTime `db:"released_at"`
+		CreatedAt  time.Time    `db:"created_at"`
+	}
+)
+
+// toDomain converts dbStock -> Stock
+func (d dbStock) toDomain() inventory.Stock {
+	return inventory.Stock{
+		ProductID:         d.ProductID,
+		Warehouse:         d.Warehouse,
+		Quantity:          d.Quantity,
+		Reserved:          d.Reserved,
+		LowStockThreshold: d.LowStockThreshold,
+		UpdatedAt:         d.UpdatedAt,
+	}
+}
+
+// toDomain converts dbReservation -> Reservation
+func (d dbReservation) toDomain() inventory.Reservation {
+	var releasedAt *time.Time
+	if d.ReleasedAt.Valid {
+		releasedAt = &d.ReleasedAt.Time
+	}
+
+	return inventory.Reservation{
+		ID:         d.ID,
+		ProductID:  d.ProductID,
+		Warehouse:  d.Warehouse,
+		Quantity:   d.Quantity,
+		ExpiresAt:  d.ExpiresAt,
+		ReleasedAt: releasedAt,
+		CreatedAt:  d.CreatedAt,
+	}
+}
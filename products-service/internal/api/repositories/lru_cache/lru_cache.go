@@ -0,0 +1,110 @@
+package lru_cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
+	"go.uber.org/zap"
+)
+
+var _ repositories.CacheRepository = &Repository{}
+
+type (
+	// Repository - defines an in-process cache evicting the least recently used entries above its size.
+	Repository struct {
+		mu      sync.Mutex
+		size    int
+		entries map[string]*list.Element
+		order   *list.List // the most recently used entries first
+		now     func() time.Time
+		logger  *zap.Logger
+	}
+
+	// entry - cached value with its key, so evicted elements can be removed from entries.
+	entry struct {
+		key       string
+		value     []byte
+		expiresAt time.Time // zero never expires
+	}
+)
+
+// NewRepository creates a new repositories holding at most size entries.
+func NewRepository(size int, logger *zap.Logger) *Repository {
+	return &Repository{
+		size:    size,
+		entries: make(map[string]*list.Element, size),
+		order:   list.New(),
+		now:     time.Now,
+		logger:  logger.With(zap.String("repositories", "lru_cache")),
+	}
+}
+
+// Get returns a cached value, expired values are removed.
+func (r *Repository) Get(_ context.Context, key string) ([]byte, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	el, ok := r.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+
+	e := el.Value.(*entry)
+	if !e.expiresAt.IsZero() && !r.now().Before(e.expiresAt) {
+		r.remove(el)
+		return nil, false, nil
+	}
+
+	r.order.MoveToFront(el)
+
+	return e.value, true, nil
+}
+
+// Set caches a value for ttl, the least recently used entry is evicted when the cache is full.
+func (r *Repository) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = r.now().Add(ttl)
+	}
+
+	if el, ok := r.entries[key]; ok {
+		e := el.Value.(*entry)
+		e.value, e.expiresAt = value, expiresAt
+		r.order.MoveToFront(el)
+
+		return nil
+	}
+
+	r.entries[key] = r.order.PushFront(&entry{key: key, value: value, expiresAt: expiresAt})
+	for r.order.Len() > r.size {
+		r.remove(r.order.Back())
+	}
+
+	return nil
+}
+
+// Delete removes cached values.
+func (r *Repository) Delete(_ context.Context, keys ...string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, key := range keys {
+		if el, ok := r.entries[key]; ok {
+			r.remove(el)
+		}
+	}
+
+	return nil
+}
+
+// remove removes an element of the cache, the lock must be held.
+func (r *Repository) remove(el *list.Element) {
+	r.order.Remove(el)
+	delete(r.entries, el.Value.(*entry).key)
+}
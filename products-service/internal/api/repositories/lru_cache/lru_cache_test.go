@@ -0,0 +1,45 @@
+package lru_cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+// TestRepository tests eviction of the least recently used entries and expiration.
+func TestRepository(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	r := NewRepository(2, zap.NewNop())
+	r.now = func() time.Time { return now }
+
+	assert.NoError(t, r.Set(ctx, "a", []byte("1"), 0))
+	assert.NoError(t, r.Set(ctx, "b", []byte("2"), time.Minute))
+
+	_, ok, _ := r.Get(ctx, "a") // a is used more recently than b now
+	assert.True(t, ok)
+
+	assert.NoError(t, r.Set(ctx, "c", []byte("3"), 0))
+	_, ok, _ = r.Get(ctx, "b")
+	assert.False(t, ok, "the least recently used entry is evicted")
+
+	value, ok, _ := r.Get(ctx, "a")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("1"), value)
+
+	assert.NoError(t, r.Set(ctx, "c", []byte("4"), time.Minute))
+	now = now.Add(time.Minute)
+	_, ok, _ = r.Get(ctx, "c")
+	assert.False(t, ok, "expired entry is not returned")
+	_, ok, _ = r.Get(ctx, "a")
+	assert.True(t, ok, "entry without ttl never expires")
+
+	assert.NoError(t, r.Delete(ctx, "a", "missing"))
+	_, ok, _ = r.Get(ctx, "a")
+	assert.False(t, ok)
+	assert.Zero(t, r.order.Len())
+}
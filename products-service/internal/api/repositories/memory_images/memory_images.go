@@ -0,0 +1,95 @@
+package memory_images
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/images"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ repositories.ImagesRepository = &Repository{}
+
+// Repository - defines an in-process repositories of images metadata, e.g. for tests without a database.
+// Images of deleted products are not removed, as products are stored by another repositories.
+type Repository struct {
+	mu     sync.Mutex
+	images map[uuid.UUID]images.Image
+	now    func() time.Time
+	logger *zap.Logger
+}
+
+// NewRepository creates a new repositories.
+func NewRepository(logger *zap.Logger) *Repository {
+	return &Repository{
+		images: make(map[uuid.UUID]images.Image),
+		now:    time.Now,
+		logger: logger.With(zap.String("repositories", "memory_images")),
+	}
+}
+
+// Create stores metadata of an uploaded image.
+func (r *Repository) Create(ctx context.Context, e images.Image) (images.Image, error) {
+	if ctx.Err() != nil {
+		return images.Image{}, ctx.Err()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e.URL = ""
+	e.CreatedAt = r.now().UTC()
+	r.images[e.ID] = e
+
+	return e, nil
+}
+
+// GetByProductIDs returns images of products, the oldest first.
+func (r *Repository) GetByProductIDs(ctx context.Context, productIDs []uuid.UUID) (images.Images, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	items := images.Images{}
+	for _, img := range r.images {
+		if slices.Contains(productIDs, img.ProductID) {
+			items = append(items, img)
+		}
+	}
+
+	slices.SortFunc(items, func(a, b images.Image) int {
+		if c := strings.Compare(a.ProductID.String(), b.ProductID.String()); c != 0 {
+			return c
+		}
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	return items, nil
+}
+
+// Delete removes metadata of an image of a product and returns it.
+func (r *Repository) Delete(ctx context.Context, productID, id uuid.UUID) (images.Image, error) {
+	if ctx.Err() != nil {
+		return images.Image{}, ctx.Err()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	img, ok := r.images[id]
+	if !ok || img.ProductID != productID {
+		return images.Image{}, errs.NotFound{What: "image"}
+	}
+	delete(r.images, id)
+
+	return img, nil
+}
@@ -0,0 +1,438 @@
+package memory_products
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/changes"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/currencies"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var (
+	_ repositories.ProductsRepository       = &Repository{}
+	_ repositories.ProductsTxRepository     = &Repository{}
+	_ repositories.ProductChangesRepository = &Repository{}
+)
+
+type (
+	// Repository - defines an in-process repositories of products, their vendors and the feed of their changes,
+	// e.g. for tests without a database. Categories are not stored, so products with categories are rejected
+	// as with unknown categories.
+	Repository struct {
+		mu     sync.Mutex
+		store  *store
+		logger *zap.Logger
+	}
+
+	// store - products, vendors and changes, its methods are not synchronized.
+	store struct {
+		products map[uuid.UUID]products.Product
+		vendors  map[string]uuid.UUID // ids of vendors by normalized names
+		names    map[uuid.UUID]string // names of vendors by ids
+		changes  changes.Changes      // changes in order of commits, transactions are serialized
+		lastID   int64                // id of the last recorded change, purged changes included
+		now      func() time.Time
+	}
+
+	// txRepository - repositories bound to a copy of the store, which replaces the store on commit.
+	txRepository struct {
+		store *store
+	}
+)
+
+// NewRepository creates a new repositories.
+func NewRepository(logger *zap.Logger) *Repository {
+	return &Repository{
+		store: &store{
+			products: make(map[uuid.UUID]products.Product),
+			vendors:  make(map[string]uuid.UUID),
+			names:    make(map[uuid.UUID]string),
+			now:      time.Now,
+		},
+		logger: logger.With(zap.String("repositories", "memory_products")),
+	}
+}
+
+// InTx runs fn with a repository bound to a copy of the store, the copy replaces the store when fn succeeds.
+// Transactions are serialized, so fn must not use the repository itself.
+func (r *Repository) InTx(ctx context.Context, fn func(repo repositories.ProductsRepository) error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tx := r.store.clone()
+	if err := fn(txRepository{store: tx}); err != nil {
+		return err
+	}
+	r.store = tx
+
+	return nil
+}
+
+// GetAfter returns up to limit changes after a position.
+func (r *Repository) GetAfter(ctx context.Context, after changes.Position, limit uint64) (changes.Changes, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	i, _ := slices.BinarySearchFunc(r.store.changes, after, func(c changes.Change, p changes.Position) int {
+		if c.Position.After(p) {
+			return 1
+		}
+		return -1
+	})
+	found := r.store.changes[i:]
+
+	return slices.Clone(found[:min(uint64(len(found)), limit)]), nil
+}
+
+// Latest returns the position of the last change, changes are committed in order of their ids.
+func (r *Repository) Latest(ctx context.Context) (changes.Position, error) {
+	if ctx.Err() != nil {
+		return changes.Position{}, ctx.Err()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return changes.Position{ID: r.store.lastID}, nil
+}
+
+// Purge removes changes made before a moment.
+func (r *Repository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	if ctx.Err() != nil {
+		return 0, ctx.Err()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	n := len(r.store.changes)
+	r.store.changes = slices.DeleteFunc(r.store.changes, func(c changes.Change) bool {
+		return c.ChangedAt.Before(before)
+	})
+
+	return int64(n - len(r.store.changes)), nil
+}
+
+// Create creates a new product, the vendor is found or created by name when VendorID is not set.
+func (r *Repository) Create(ctx context.Context, e products.Product) (products.Product, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.store.create(ctx, e)
+}
+
+// CreateBatch creates products, products which already exist by name and vendor are skipped.
+// Returns ids of created products.
+func (r *Repository) CreateBatch(ctx context.Context, items products.Products) ([]uuid.UUID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.store.createBatch(ctx, items)
+}
+
+// Update updates name, vendor, description, categories and tags of a product.
+func (r *Repository) Update(ctx context.Context, e products.Product) (products.Product, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.store.update(ctx, e)
+}
+
+// GetByID returns a product by id.
+func (r *Repository) GetByID(ctx context.Context, id uuid.UUID) (products.Product, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.store.getByID(ctx, id)
+}
+
+// GetAll returns products matching filter with pagination using limit and offset, the newest first.
+func (r *Repository) GetAll(
+	ctx context.Context,
+	limit, offset uint64,
+	filter products.Filter,
+) (products.ProductList, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.store.getAll(ctx, limit, offset, filter)
+}
+
+// Delete removes a product.
+func (r *Repository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.store.delete(ctx, id)
+}
+
+// Create creates a new product in the transaction.
+func (r txRepository) Create(ctx context.Context, e products.Product) (products.Product, error) {
+	return r.store.create(ctx, e)
+}
+
+// CreateBatch creates products in the transaction.
+func (r txRepository) CreateBatch(ctx context.Context, items products.Products) ([]uuid.UUID, error) {
+	return r.store.createBatch(ctx, items)
+}
+
+// Update updates a product in the transaction.
+func (r txRepository) Update(ctx context.Context, e products.Product) (products.Product, error) {
+	return r.store.update(ctx, e)
+}
+
+// GetByID returns a product by id in the transaction.
+func (r txRepository) GetByID(ctx context.Context, id uuid.UUID) (products.Product, error) {
+	return r.store.getByID(ctx, id)
+}
+
+// GetAll returns products matching filter in the transaction.
+func (r txRepository) GetAll(
+	ctx context.Context,
+	limit, offset uint64,
+	filter products.Filter,
+) (products.ProductList, error) {
+	return r.store.getAll(ctx, limit, offset, filter)
+}
+
+// Delete removes a product in the transaction.
+func (r txRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.store.delete(ctx, id)
+}
+
+// clone returns a copy of the store, products are copied by value and their slices are not modified in place.
+func (s *store) clone() *store {
+	return &store{
+		products: maps.Clone(s.products),
+		vendors:  maps.Clone(s.vendors),
+		names:    maps.Clone(s.names),
+		changes:  slices.Clone(s.changes),
+		lastID:   s.lastID,
+		now:      s.now,
+	}
+}
+
+func (s *store) create(ctx context.Context, e products.Product) (products.Product, error) {
+	if ctx.Err() != nil {
+		return products.Product{}, ctx.Err()
+	}
+
+	if e.Currency == "" {
+		e.Currency = currencies.Base
+	}
+
+	e.ID = uuid.New()
+	e.CreatedAt = s.now().UTC()
+	e.UpdatedAt = e.CreatedAt
+
+	if err := s.put(e); err != nil {
+		return products.Product{}, err
+	}
+	s.record(e.ID, changes.TypeCreate)
+
+	return s.getByID(ctx, e.ID)
+}
+
+func (s *store) createBatch(ctx context.Context, items products.Products) ([]uuid.UUID, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	var created []uuid.UUID
+	for _, e := range items {
+		if e.Currency == "" {
+			e.Currency = currencies.Base
+		}
+
+		e.ID = uuid.New()
+		e.CreatedAt = s.now().UTC()
+		e.UpdatedAt = e.CreatedAt
+
+		err := s.put(e)
+		if errors.As(err, &errs.AlreadyExists{}) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		s.record(e.ID, changes.TypeCreate)
+		created = append(created, e.ID)
+	}
+
+	return created, nil
+}
+
+func (s *store) update(ctx context.Context, e products.Product) (products.Product, error) {
+	if ctx.Err() != nil {
+		return products.Product{}, ctx.Err()
+	}
+
+	p, ok := s.products[e.ID]
+	if !ok {
+		return products.Product{}, errs.NotFound{What: "product"}
+	}
+
+	p.Name, p.VendorID, p.Vendor, p.Description = e.Name, e.VendorID, e.Vendor, e.Description
+	p.CategoryIDs, p.Tags = e.CategoryIDs, e.Tags
+	p.UpdatedAt = s.now().UTC()
+
+	if err := s.put(p); err != nil {
+		return products.Product{}, err
+	}
+	s.record(p.ID, changes.TypeUpdate)
+
+	return s.getByID(ctx, e.ID)
+}
+
+func (s *store) getByID(ctx context.Context, id uuid.UUID) (products.Product, error) {
+	if ctx.Err() != nil {
+		return products.Product{}, ctx.Err()
+	}
+
+	p, ok := s.products[id]
+	if !ok {
+		return products.Product{}, errs.NotFound{What: "product"}
+	}
+	p.Vendor = s.names[p.VendorID]
+
+	return p, nil
+}
+
+func (s *store) getAll(
+	ctx context.Context,
+	limit, offset uint64,
+	filter products.Filter,
+) (products.ProductList, error) {
+	if ctx.Err() != nil {
+		return products.ProductList{}, ctx.Err()
+	}
+
+	if limit == 0 {
+		limit = products.DefaultLimit
+	}
+
+	items := make(products.Products, 0, len(s.products))
+	for _, p := range s.products {
+		if filter.CategoryID != nil ||
+			filter.VendorID != nil && p.VendorID != *filter.VendorID ||
+			filter.Tag != "" && !slices.Contains(p.Tags, products.NormalizeTag(filter.Tag)) ||
+			!changedWithin(p.CreatedAt, filter) && !changedWithin(p.UpdatedAt, filter) ||
+			filter.After != nil && compareKeys(p.Key(), *filter.After) <= 0 {
+			continue
+		}
+		p.Vendor = s.names[p.VendorID]
+		items = append(items, p)
+	}
+
+	slices.SortFunc(items, func(a, b products.Product) int { return compareKeys(a.Key(), b.Key()) })
+
+	total := uint64(len(items))
+
+	return products.ProductList{
+		Total:    total,
+		Products: items[min(offset, total):min(offset+limit, total)],
+	}, nil
+}
+
+func (s *store) delete(ctx context.Context, id uuid.UUID) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	if _, ok := s.products[id]; !ok {
+		return errs.NotFound{What: "product"}
+	}
+	delete(s.products, id)
+	s.record(id, changes.TypeDelete)
+
+	return nil
+}
+
+// record appends a change of a product as the trigger of the products table does.
+func (s *store) record(productID uuid.UUID, changeType string) {
+	s.lastID++
+	s.changes = append(s.changes, changes.Change{
+		Position:  changes.Position{ID: s.lastID},
+		ProductID: productID,
+		Type:      changeType,
+		ChangedAt: s.now().UTC(),
+	})
+}
+
+// put stores a product, its vendor is resolved by name when VendorID is not set. Categories are not stored, so
+// they are not found, names are unique per vendor as in the database.
+func (s *store) put(e products.Product) error {
+	if len(e.CategoryIDs) > 0 {
+		return errs.FieldsValidation{Errors: []string{"category_ids::not_found"}}
+	}
+
+	if e.VendorID == uuid.Nil {
+		e.VendorID = s.resolveVendor(e.Vendor)
+	} else if _, ok := s.names[e.VendorID]; !ok {
+		return errs.FieldsValidation{Errors: []string{"vendor_id::not_found"}}
+	}
+
+	for _, p := range s.products {
+		if p.ID != e.ID && p.Name == e.Name && p.VendorID == e.VendorID {
+			return errs.AlreadyExists{What: "product with the same name already exists for the vendor"}
+		}
+	}
+
+	e.Vendor = ""
+	e.CategoryIDs = []uuid.UUID{}
+	e.Tags = products.NormalizeTags(e.Tags)
+	s.products[e.ID] = e
+
+	return nil
+}
+
+// resolveVendor finds or creates a vendor by name, names are compared ignoring case and spaces, unlike
+// normalize_vendor_name legal suffixes are not stripped.
+func (s *store) resolveVendor(name string) uuid.UUID {
+	normalized := strings.ToLower(strings.Join(strings.Fields(name), " "))
+	if id, ok := s.vendors[normalized]; ok {
+		return id
+	}
+
+	id := uuid.New()
+	s.vendors[normalized] = id
+	s.names[id] = strings.TrimSpace(name)
+
+	return id
+}
+
+// changedWithin reports whether a time of a change is within bounds of changes of the filter.
+func changedWithin(t time.Time, filter products.Filter) bool {
+	return (filter.ChangedFrom.IsZero() || !t.Before(filter.ChangedFrom)) &&
+		(filter.ChangedTo.IsZero() || t.Before(filter.ChangedTo))
+}
+
+// compareKeys compares positions of products in lists, which are ordered by creation time and id, newest first,
+// as by the database.
+func compareKeys(a, b products.Key) int {
+	if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+		return c
+	}
+
+	return bytes.Compare(b.ID[:], a.ID[:])
+}
@@ -0,0 +1,208 @@
+package memory_products
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/changes"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// TestRepositoryInTx tests that writes of a transaction are seen once it is committed.
+func TestRepositoryInTx(t *testing.T) {
+	ctx := context.Background()
+	r := NewRepository(zap.NewNop())
+	errFailed := errors.New("failed")
+
+	var created products.Product
+	err := r.InTx(ctx, func(repo repositories.ProductsRepository) (err error) {
+		created, err = repo.Create(ctx, products.Product{Name: "Keyboard", Vendor: "Acme", Price: decimal.New(1, 0)})
+		require.NoError(t, err)
+
+		_, err = r.store.getByID(ctx, created.ID)
+		require.ErrorAs(t, err, &errs.NotFound{}, "writes are not seen before commit")
+
+		return errFailed
+	})
+	require.ErrorIs(t, err, errFailed)
+
+	_, err = r.GetByID(ctx, created.ID)
+	require.ErrorAs(t, err, &errs.NotFound{}, "writes are rolled back")
+
+	err = r.InTx(ctx, func(repo repositories.ProductsRepository) (err error) {
+		created, err = repo.Create(ctx, products.Product{Name: "Keyboard", Vendor: "Acme", Price: decimal.New(1, 0)})
+		return err
+	})
+	require.NoError(t, err)
+
+	got, err := r.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, created, got)
+	assert.Equal(t, "Acme", got.Vendor)
+	assert.Equal(t, "USD", got.Currency)
+}
+
+// TestRepositoryConstraints tests that writes are rejected as by constraints of the database.
+func TestRepositoryConstraints(t *testing.T) {
+	ctx := context.Background()
+	r := NewRepository(zap.NewNop())
+
+	created, err := r.Create(ctx, products.Product{Name: "Mouse", Vendor: "Acme"})
+	require.NoError(t, err)
+
+	_, err = r.Create(ctx, products.Product{Name: "Mouse", Vendor: " acme  "})
+	require.ErrorAs(t, err, &errs.Conflict{}, "vendors are found by normalized names")
+
+	_, err = r.Create(ctx, products.Product{Name: "Pad", VendorID: uuid.New()})
+	assert.Equal(t, errs.FieldsValidation{Errors: []string{"vendor_id::not_found"}}, err)
+
+	_, err = r.Create(ctx, products.Product{Name: "Pad", Vendor: "Acme", CategoryIDs: []uuid.UUID{uuid.New()}})
+	assert.Equal(t, errs.FieldsValidation{Errors: []string{"category_ids::not_found"}}, err)
+
+	_, err = r.Update(ctx, products.Product{ID: uuid.New(), Name: "Pad", Vendor: "Acme"})
+	require.ErrorAs(t, err, &errs.NotFound{})
+
+	ids, err := r.CreateBatch(ctx, products.Products{{Name: "Mouse", Vendor: "Acme"}, {Name: "Pad", Vendor: "Acme"}})
+	require.NoError(t, err)
+	assert.Len(t, ids, 1, "existing products are skipped")
+
+	require.NoError(t, r.Delete(ctx, created.ID))
+	require.ErrorAs(t, r.Delete(ctx, created.ID), &errs.NotFound{})
+}
+
+// TestRepositoryGetAll tests filters and pagination of products by offsets and keys, the newest first.
+func TestRepositoryGetAll(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	r := NewRepository(zap.NewNop())
+	r.store.now = func() time.Time { now = now.Add(time.Second); return now }
+
+	first, err := r.Create(ctx, products.Product{Name: "First", Vendor: "Acme", Tags: []string{" Sale "}})
+	require.NoError(t, err)
+	second, err := r.Create(ctx, products.Product{Name: "Second", Vendor: "Other"})
+	require.NoError(t, err)
+	third, err := r.Create(ctx, products.Product{Name: "Third", Vendor: "Acme", Tags: []string{"sale"}})
+	require.NoError(t, err)
+
+	list, err := r.GetAll(ctx, 2, 0, products.Filter{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), list.Total)
+	assert.Equal(t, products.Products{third, second}, list.Products)
+
+	list, err = r.GetAll(ctx, 2, 2, products.Filter{})
+	require.NoError(t, err)
+	assert.Equal(t, products.Products{first}, list.Products)
+
+	after := second.Key()
+	list, err = r.GetAll(ctx, 2, 0, products.Filter{After: &after})
+	require.NoError(t, err)
+	assert.Equal(t, products.Products{first}, list.Products, "the page starts after the key")
+
+	list, err = r.GetAll(ctx, 0, 5, products.Filter{})
+	require.NoError(t, err)
+	assert.Empty(t, list.Products)
+
+	list, err = r.GetAll(ctx, 0, 0, products.Filter{VendorID: &first.VendorID, Tag: "SALE"})
+	require.NoError(t, err)
+	assert.Equal(t, products.Products{third, first}, list.Products)
+}
+
+// TestRepositoryGetAllChanged tests filtering of products created or updated within bounds of changes.
+func TestRepositoryGetAllChanged(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	r := NewRepository(zap.NewNop())
+	r.store.now = func() time.Time { now = now.Add(time.Second); return now }
+
+	first, err := r.Create(ctx, products.Product{Name: "First", Vendor: "Acme"})
+	require.NoError(t, err)
+	second, err := r.Create(ctx, products.Product{Name: "Second", Vendor: "Acme"})
+	require.NoError(t, err)
+	third, err := r.Create(ctx, products.Product{Name: "Third", Vendor: "Acme"})
+	require.NoError(t, err)
+
+	first.Description = "updated after third is created"
+	first, err = r.Update(ctx, first)
+	require.NoError(t, err)
+
+	list, err := r.GetAll(ctx, 0, 0, products.Filter{ChangedFrom: second.CreatedAt, ChangedTo: third.CreatedAt})
+	require.NoError(t, err)
+	assert.Equal(t, products.Products{second}, list.Products, "the upper bound is excluded")
+
+	list, err = r.GetAll(ctx, 0, 0, products.Filter{ChangedFrom: third.CreatedAt})
+	require.NoError(t, err)
+	assert.Equal(t, products.Products{third, first}, list.Products, "updated products are included")
+
+	list, err = r.GetAll(ctx, 0, 0, products.Filter{ChangedTo: second.CreatedAt})
+	require.NoError(t, err)
+	assert.Equal(t, products.Products{first}, list.Products)
+}
+
+// TestRepositoryChanges tests that committed writes are recorded as changes, which are read after positions
+// and purged by the time they are made.
+func TestRepositoryChanges(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	r := NewRepository(zap.NewNop())
+	r.store.now = func() time.Time { now = now.Add(time.Second); return now }
+
+	start, err := r.Latest(ctx)
+	require.NoError(t, err)
+
+	created, err := r.Create(ctx, products.Product{Name: "Keyboard", Vendor: "Acme"})
+	require.NoError(t, err)
+	created.Description = "updated"
+	_, err = r.Update(ctx, created)
+	require.NoError(t, err)
+
+	_ = r.InTx(ctx, func(repo repositories.ProductsRepository) error {
+		_, err = repo.Create(ctx, products.Product{Name: "Mouse", Vendor: "Acme"})
+		require.NoError(t, err)
+		return errors.New("rolled back")
+	})
+	require.NoError(t, r.Delete(ctx, created.ID))
+
+	got, err := r.GetAfter(ctx, start, changes.MaxBatch)
+	require.NoError(t, err)
+	require.Len(t, got, 3, "changes of rolled back transactions are not recorded")
+	assert.Equal(t, []string{changes.TypeCreate, changes.TypeUpdate, changes.TypeDelete},
+		[]string{got[0].Type, got[1].Type, got[2].Type})
+	for _, c := range got {
+		assert.Equal(t, created.ID, c.ProductID)
+	}
+
+	latest, err := r.Latest(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, got[2].Position, latest)
+
+	after, err := r.GetAfter(ctx, got[0].Position, 1)
+	require.NoError(t, err)
+	assert.Equal(t, changes.Changes{got[1]}, after)
+
+	purged, err := r.Purge(ctx, got[2].ChangedAt)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), purged)
+
+	after, err = r.GetAfter(ctx, start, changes.MaxBatch)
+	require.NoError(t, err)
+	assert.Equal(t, changes.Changes{got[2]}, after)
+
+	_, err = r.Create(ctx, products.Product{Name: "Mouse", Vendor: "Acme"})
+	require.NoError(t, err)
+	after, err = r.GetAfter(ctx, latest, changes.MaxBatch)
+	require.NoError(t, err)
+	require.Len(t, after, 1)
+	assert.True(t, after[0].Position.After(latest), "ids of changes are not reused after purges")
+}
@@ -0,0 +1,97 @@
+package memory_storage
+
+import (
+	"context"
+	"io"
+	"maps"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
+	"go.uber.org/zap"
+)
+
+var _ repositories.ObjectStorageRepository = &Repository{}
+
+// Repository - defines an in-process object storage, e.g. for tests without S3. Presigned URLs are memory:// URLs
+// of keys, they can't be downloaded.
+type Repository struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	logger  *zap.Logger
+}
+
+// NewRepository creates a new repositories.
+func NewRepository(logger *zap.Logger) *Repository {
+	return &Repository{
+		objects: make(map[string][]byte),
+		logger:  logger.With(zap.String("repositories", "memory_storage")),
+	}
+}
+
+// Put stores an object, checksum is not verified.
+func (r *Repository) Put(ctx context.Context, key, _, _ string, body io.Reader, size int64) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	data, err := io.ReadAll(io.LimitReader(body, size))
+	if err != nil {
+		return errs.Internal{Cause: "failed to read object: " + err.Error()}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.objects[key] = data
+
+	return nil
+}
+
+// PresignGet returns a memory:// URL of an object.
+func (r *Repository) PresignGet(ctx context.Context, key string) (string, error) {
+	if ctx.Err() != nil {
+		return "", ctx.Err()
+	}
+
+	return (&url.URL{Scheme: "memory", Path: "/" + key}).String(), nil
+}
+
+// Delete removes an object.
+func (r *Repository) Delete(ctx context.Context, key string) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.objects, key)
+
+	return nil
+}
+
+// DeletePrefix removes all objects with keys starting with prefix.
+func (r *Repository) DeletePrefix(ctx context.Context, prefix string) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	maps.DeleteFunc(r.objects, func(key string, _ []byte) bool { return strings.HasPrefix(key, prefix) })
+
+	return nil
+}
+
+// Keys returns sorted keys of stored objects, e.g. to check objects removed by tests.
+func (r *Repository) Keys() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return slices.Sorted(maps.Keys(r.objects))
+}
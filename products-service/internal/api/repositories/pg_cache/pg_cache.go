@@ -0,0 +1,103 @@
+package pg_cache
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/pgerrs"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+var _ repositories.CacheRepository = &Repository{}
+
+type (
+	// Repository - defines a cache stored in Postgres, it is shared by all instances of the service,
+	// so their invalidations are seen by each other.
+	Repository struct {
+		db     sqlx.ExtContext
+		logger *zap.Logger
+	}
+)
+
+// NewRepository creates a new repositories.
+func NewRepository(db sqlx.ExtContext, logger *zap.Logger) *Repository {
+	return &Repository{db: db, logger: logger.With(zap.String("repositories", "pg_cache"))}
+}
+
+// Get returns a cached value which has not expired.
+func (r *Repository) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	if ctx.Err() != nil {
+		return nil, false, ctx.Err()
+	}
+
+	query := `
+	SELECT value FROM cache_entries
+	WHERE key = $1 AND (expires_at IS NULL OR expires_at > now());
+	`
+
+	var value []byte
+	if err := sqlx.GetContext(ctx, r.db, &value, query, key); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, false, nil
+		}
+
+		return nil, false, pgerrs.Map(err)
+	}
+
+	return value, true, nil
+}
+
+// Set caches a value for ttl.
+func (r *Repository) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	query := `
+	INSERT INTO cache_entries (key, value, expires_at)
+	VALUES ($1, $2, CASE WHEN $3::bigint > 0 THEN now() + $3::bigint * interval '1 millisecond' END)
+	ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, expires_at = EXCLUDED.expires_at;
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, key, value, ttl.Milliseconds()); err != nil {
+		return pgerrs.Map(err)
+	}
+
+	return nil
+}
+
+// Delete removes cached values.
+func (r *Repository) Delete(ctx context.Context, keys ...string) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	if len(keys) == 0 {
+		return nil
+	}
+
+	query, args, err := sqlx.In(`DELETE FROM cache_entries WHERE key IN (?);`, keys)
+	if err != nil {
+		return err
+	}
+
+	if _, err = r.db.ExecContext(ctx, r.db.Rebind(query), args...); err != nil {
+		return pgerrs.Map(err)
+	}
+
+	return nil
+}
+
+// Purge removes expired values, they are not returned anyway but take space.
+func (r *Repository) Purge(ctx context.Context) (int64, error) {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM cache_entries WHERE expires_at <= now();`)
+	if err != nil {
+		return 0, pgerrs.Map(err)
+	}
+
+	return res.RowsAffected()
+}
@@ -0,0 +1,41 @@
+package prices
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/prices"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type (
+	// dbPrice - defines a product price in the database.
+	dbPrice struct {
+		ID            uuid.UUID       `db:"id"`
+		ProductID     uuid.UUID       `db:"product_id"`
+		Price         decimal.Decimal `db:"price"`
+		Currency      string          `db:"currency"`
+		EffectiveFrom time.Time       `db:"effective_from"`
+		EffectiveTo   sql.NullTime    `db:"effective_to"`
+		CreatedAt     time.Time       `db:"created_at"`
+	}
+)
+
+// toDomain converts dbPrice -> Price
+func (d dbPrice) toDomain() prices.Price {
+	var effectiveTo *time.Time
+	if d.EffectiveTo.Valid {
+		effectiveTo = &d.EffectiveTo.Time
+	}
+
+	return prices.Price{
+		ID:            d.ID,
+		ProductID:     d.ProductID,
+		Amount:        d.Price,
+		Currency:      d.Currency,
+		EffectiveFrom: d.EffectiveFrom,
+		EffectiveTo:   effectiveTo,
+		CreatedAt:     d.CreatedAt,
+	}
+}
@@ -0,0 +1,139 @@
+package prices
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/prices"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/pgerrs"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+var _ repositories.PricesRepository = &Repository{}
+
+type (
+	// Repository - defines a repositories.
+	Repository struct {
+		db     sqlx.ExtContext
+		logger *zap.Logger
+	}
+)
+
+// NewRepository creates a new repositories.
+func NewRepository(db sqlx.ExtContext, logger *zap.Logger) repositories.PricesRepository {
+	return &Repository{db: db, logger: logger.With(zap.String("repositories", "prices"))}
+}
+
+// Create schedules a new price. An open-ended price closes the open-ended price started before it at its start.
+// A price with an end, e.g. of a promo, splits the price covering its period, which continues after the end.
+// Should be called inside a transaction.
+func (r *Repository) Create(ctx context.Context, e prices.Price) (prices.Price, error) {
+	if ctx.Err() != nil {
+		return prices.Price{}, ctx.Err()
+	}
+
+	if e.EffectiveTo == nil {
+		closeQuery := `
+		UPDATE product_prices
+		SET effective_to = $2
+		WHERE product_id = $1 AND effective_to IS NULL AND effective_from < $2;
+		`
+
+		if _, err := r.db.ExecContext(ctx, closeQuery, e.ProductID, e.EffectiveFrom); err != nil {
+			return prices.Price{}, pgerrs.Map(err)
+		}
+	} else if err := r.split(ctx, e); err != nil {
+		return prices.Price{}, err
+	}
+
+	query := `
+	INSERT INTO product_prices (product_id, price, currency, effective_from, effective_to)
+	VALUES ($1, $2, $3, $4, $5)
+	RETURNING id, product_id, price, currency, effective_from, effective_to, created_at;
+	`
+
+	var dbp dbPrice
+	err := sqlx.GetContext(ctx, r.db, &dbp, query,
+		e.ProductID, e.Amount, e.Currency, e.EffectiveFrom, e.EffectiveTo)
+	if err != nil {
+		switch pgerrs.Code(err) {
+		case pgerrs.CodeExclusionViolation:
+			return prices.Price{}, errs.Conflict{What: "price period overlaps with a scheduled price"}
+		case pgerrs.CodeForeignKeyViolation:
+			return prices.Price{}, errs.NotFound{What: "product"}
+		}
+
+		return prices.Price{}, pgerrs.Map(err)
+	}
+
+	return dbp.toDomain(), nil
+}
+
+// split closes the price covering the period of a price with an end at its start and continues the covered
+// price from its end, so the covered price is in effect again once the period ends.
+func (r *Repository) split(ctx context.Context, e prices.Price) error {
+	coveringQuery := `
+	SELECT id, product_id, price, currency, effective_from, effective_to, created_at
+	FROM product_prices
+	WHERE product_id = $1 AND effective_from < $2 AND (effective_to IS NULL OR effective_to > $3)
+	FOR UPDATE;
+	`
+
+	var covering dbPrice
+	err := sqlx.GetContext(ctx, r.db, &covering, coveringQuery, e.ProductID, e.EffectiveFrom, *e.EffectiveTo)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return pgerrs.Map(err)
+	}
+
+	closeQuery := `UPDATE product_prices SET effective_to = $2 WHERE id = $1;`
+	if _, err = r.db.ExecContext(ctx, closeQuery, covering.ID, e.EffectiveFrom); err != nil {
+		return pgerrs.Map(err)
+	}
+
+	continueQuery := `
+	INSERT INTO product_prices (product_id, price, currency, effective_from, effective_to)
+	VALUES ($1, $2, $3, $4, $5);
+	`
+
+	_, err = r.db.ExecContext(ctx, continueQuery,
+		covering.ProductID, covering.Price, covering.Currency, *e.EffectiveTo, covering.EffectiveTo)
+	if err != nil {
+		return pgerrs.Map(err)
+	}
+
+	return nil
+}
+
+// GetByProductID returns price history of a product, the latest first.
+func (r *Repository) GetByProductID(ctx context.Context, productID uuid.UUID) (prices.Prices, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	query := `
+	SELECT id, product_id, price, currency, effective_from, effective_to, created_at
+	FROM product_prices
+	WHERE product_id = $1
+	ORDER BY effective_from DESC;
+	`
+
+	var dbItems []dbPrice
+	if err := sqlx.SelectContext(ctx, r.db, &dbItems, query, productID); err != nil {
+		return nil, pgerrs.Map(err)
+	}
+
+	items := make(prices.Prices, len(dbItems))
+	for i, dbp := range dbItems {
+		items[i] = dbp.toDomain()
+	}
+
+	return items, nil
+}
@@ -0,0 +1,105 @@
+package product_changes
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/changes"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/pgerrs"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+var _ repositories.ProductChangesRepository = &Repository{}
+
+type (
+	// Repository - defines a feed of changes of products written by triggers of the products table. Changes are
+	// read by the primary, replicas may lag behind it.
+	Repository struct {
+		db     sqlx.ExtContext
+		logger *zap.Logger
+	}
+
+	// dbChange - defines a change of a product in the database.
+	dbChange struct {
+		ID        int64     `db:"id"`
+		TxID      int64     `db:"tx_id"`
+		ProductID uuid.UUID `db:"product_id"`
+		Type      string    `db:"type"`
+		ChangedAt time.Time `db:"changed_at"`
+	}
+)
+
+// NewRepository creates a new repositories.
+func NewRepository(db sqlx.ExtContext, logger *zap.Logger) *Repository {
+	return &Repository{db: db, logger: logger.With(zap.String("repositories", "product_changes"))}
+}
+
+// GetAfter returns up to limit changes after a position in order of their transactions. Ids of changes are not
+// ordered by commits of their transactions, so only changes of transactions below the xmin of the snapshot
+// are returned: all of them are settled, and transactions committed later have greater ids.
+func (r *Repository) GetAfter(ctx context.Context, after changes.Position, limit uint64) (changes.Changes, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	query := `
+	SELECT id, tx_id::text::bigint AS tx_id, product_id, type, changed_at
+	FROM product_changes
+	WHERE (tx_id, id) > ($1::xid8, $2)
+	  AND tx_id < pg_snapshot_xmin(pg_current_snapshot())
+	ORDER BY tx_id, id
+	LIMIT $3;
+	`
+
+	var rows []dbChange
+	err := sqlx.SelectContext(ctx, r.db, &rows, query, strconv.FormatUint(after.TxID, 10), after.ID, limit)
+	if err != nil {
+		return nil, pgerrs.Map(err)
+	}
+
+	items := make(changes.Changes, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, changes.Change{
+			Position:  changes.Position{TxID: uint64(row.TxID), ID: row.ID},
+			ProductID: row.ProductID,
+			Type:      row.Type,
+			ChangedAt: row.ChangedAt,
+		})
+	}
+
+	return items, nil
+}
+
+// Latest returns the position before transactions which are in progress or not started yet, so changes
+// committed from now on are after it.
+func (r *Repository) Latest(ctx context.Context) (changes.Position, error) {
+	if ctx.Err() != nil {
+		return changes.Position{}, ctx.Err()
+	}
+
+	var xmin int64
+	query := `SELECT pg_snapshot_xmin(pg_current_snapshot())::text::bigint;`
+	if err := sqlx.GetContext(ctx, r.db, &xmin, query); err != nil {
+		return changes.Position{}, pgerrs.Map(err)
+	}
+
+	return changes.Position{TxID: uint64(xmin)}, nil
+}
+
+// Purge removes changes made before a moment, streams can't be resumed from them anymore.
+func (r *Repository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	if ctx.Err() != nil {
+		return 0, ctx.Err()
+	}
+
+	res, err := r.db.ExecContext(ctx, `DELETE FROM product_changes WHERE changed_at < $1;`, before)
+	if err != nil {
+		return 0, pgerrs.Map(err)
+	}
+
+	return res.RowsAffected()
+}
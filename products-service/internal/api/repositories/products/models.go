@@ -6,6 +6,7 @@ import (
 
 	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/shopspring/decimal"
 )
 
@@ -14,9 +15,13 @@ type (
 	dbProduct struct {
 		ID          uuid.UUID       `db:"id"`
 		Name        string          `db:"name"`
+		VendorID    uuid.UUID       `db:"vendor_id"`
 		Vendor      string          `db:"vendor"`
 		Description sql.NullString  `db:"description"`
 		Price       decimal.Decimal `db:"price"`
+		Currency    string          `db:"currency"`
+		CategoryIDs pq.StringArray  `db:"category_ids"`
+		Tags        pq.StringArray  `db:"tags"`
 		CreatedAt   time.Time       `db:"created_at"`
 		UpdatedAt   time.Time       `db:"updated_at"`
 	}
@@ -29,12 +34,26 @@ func (d dbProduct) toDomain() products.Product {
 		desc = d.Description.String
 	}
 
+	categoryIDs := make([]uuid.UUID, 0, len(d.CategoryIDs))
+	for _, id := range d.CategoryIDs {
+		if parsed, err := uuid.Parse(id); err == nil {
+			categoryIDs = append(categoryIDs, parsed)
+		}
+	}
+
+	tags := make([]string, 0, len(d.Tags))
+	tags = append(tags, d.Tags...)
+
 	return products.Product{
 		ID:          d.ID,
 		Name:        d.Name,
+		VendorID:    d.VendorID,
 		Vendor:      d.Vendor,
 		Description: desc,
 		Price:       d.Price,
+		Currency:    d.Currency,
+		CategoryIDs: categoryIDs,
+		Tags:        tags,
 		CreatedAt:   d.CreatedAt,
 		UpdatedAt:   d.UpdatedAt,
 	}
@@ -2,57 +2,256 @@ package products
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"strconv"
 	"strings"
 
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/currencies"
 	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
 	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
-	"github.com/at-kh/guru-apps-test-services/products-service/pkg/errs"
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/pgerrs"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"go.uber.org/zap"
 )
 
 var _ repositories.ProductsRepository = &Repository{}
 
+// selectProductsQuery selects products with their vendor, the price effective at the moment of the query,
+// categories and tags.
+const selectProductsQuery = `
+	SELECT p.id, p.name, p.vendor_id, v.name AS vendor, p.description,
+	       COALESCE(pp.price, p.price) AS price,
+	       COALESCE(pp.currency, p.currency) AS currency,
+	       ARRAY(
+	           SELECT pc.category_id::text FROM product_categories pc
+	           WHERE pc.product_id = p.id ORDER BY pc.category_id
+	       )::text AS category_ids,
+	       ARRAY(
+	           SELECT pt.tag FROM product_tags pt
+	           WHERE pt.product_id = p.id ORDER BY pt.tag
+	       )::text AS tags,
+	       p.created_at, p.updated_at
+	FROM products p
+	JOIN vendors v ON v.id = p.vendor_id
+	LEFT JOIN LATERAL (
+		SELECT price, currency
+		FROM product_prices
+		WHERE product_id = p.id
+		  AND effective_from <= now()
+		  AND (effective_to IS NULL OR effective_to > now())
+		ORDER BY effective_from DESC
+		LIMIT 1
+	) pp ON true
+	`
+
 type (
 	// Repository - defines a repositories.
 	Repository struct {
 		db     sqlx.ExtContext
+		reads  sqlx.ExtContext // runs GetAll and GetByID, which may be served by replicas
 		logger *zap.Logger
 	}
 )
 
 // NewRepository creates a new repositories.
 func NewRepository(db sqlx.ExtContext, logger *zap.Logger) repositories.ProductsRepository {
-	return &Repository{db: db, logger: logger.With(zap.String("repositories", "products"))}
+	return NewRepositoryWithReads(db, db, logger)
 }
 
-// Create creates a new product.
+// NewRepositoryWithReads creates a new repositories reading products by GetAll and GetByID from reads,
+// e.g. a router of replicas, other queries run on db.
+func NewRepositoryWithReads(db, reads sqlx.ExtContext, logger *zap.Logger) repositories.ProductsRepository {
+	return &Repository{db: db, reads: reads, logger: logger.With(zap.String("repositories", "products"))}
+}
+
+// Create creates a new product together with its initial price, categories and tags.
+// The vendor is found or created by name when VendorID is not set.
+// Should be called inside a transaction.
 func (r *Repository) Create(ctx context.Context, e products.Product) (products.Product, error) {
 	if ctx.Err() != nil {
 		return products.Product{}, ctx.Err()
 	}
 
 	query := `
-	INSERT INTO products (name, vendor, description, price)
-	VALUES ($1, $2, $3, $4)
-	RETURNING id, name, vendor, description, price, created_at, updated_at;
+	WITH p AS (
+		INSERT INTO products (name, vendor_id, description, price, currency)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at, price, currency
+	), pp AS (
+		INSERT INTO product_prices (product_id, price, currency, effective_from)
+		SELECT id, price, currency, created_at FROM p
+	)
+	SELECT id FROM p;
+	`
+
+	if e.Currency == "" {
+		e.Currency = currencies.Base
+	}
+
+	vendorID, err := r.resolveVendor(ctx, e)
+	if err != nil {
+		return products.Product{}, err
+	}
+
+	var id uuid.UUID
+	if err = sqlx.GetContext(ctx, r.db, &id, query, e.Name, vendorID, e.Description, e.Price, e.Currency); err != nil {
+		return products.Product{}, mapError(err)
+	}
+
+	if err = r.setCategories(ctx, id, e.CategoryIDs); err != nil {
+		return products.Product{}, err
+	}
+
+	if err = r.setTags(ctx, id, e.Tags); err != nil {
+		return products.Product{}, err
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+// CreateBatch creates products together with their initial prices, categories and tags by a few queries,
+// products which already exist by name and vendor are skipped. Returns ids of created products.
+// Should be called inside a transaction.
+func (r *Repository) CreateBatch(ctx context.Context, items products.Products) ([]uuid.UUID, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	if len(items) == 0 {
+		return nil, nil
+	}
+
+	query := `
+	WITH p AS (
+		INSERT INTO products (id, name, vendor_id, description, price, currency)
+		SELECT * FROM unnest($1::uuid[], $2::text[], $3::uuid[], $4::text[], $5::numeric[], $6::text[])
+		ON CONFLICT (name, vendor_id) DO NOTHING
+		RETURNING id, created_at, price, currency
+	), pp AS (
+		INSERT INTO product_prices (product_id, price, currency, effective_from)
+		SELECT id, price, currency, created_at FROM p
+	)
+	SELECT id FROM p;
+	`
+
+	var (
+		ids          = make(pq.StringArray, len(items))
+		names        = make(pq.StringArray, len(items))
+		vendorIDs    = make(pq.StringArray, len(items))
+		descriptions = make(pq.StringArray, len(items))
+		prices       = make(pq.StringArray, len(items))
+		currencyList = make(pq.StringArray, len(items))
+		resolved     = make(map[string]uuid.UUID) // vendors found or created by name
+	)
+
+	for i, e := range items {
+		if e.Currency == "" {
+			e.Currency = currencies.Base
+		}
+
+		vendorID, ok := e.VendorID, e.VendorID != uuid.Nil
+		if !ok {
+			vendorID, ok = resolved[e.Vendor]
+		}
+		if !ok {
+			var err error
+			if vendorID, err = r.resolveVendor(ctx, e); err != nil {
+				return nil, err
+			}
+			resolved[e.Vendor] = vendorID
+		}
+
+		ids[i] = uuid.NewString()
+		names[i] = e.Name
+		vendorIDs[i] = vendorID.String()
+		descriptions[i] = e.Description
+		prices[i] = e.Price.String()
+		currencyList[i] = e.Currency
+	}
+
+	var created []uuid.UUID
+	err := sqlx.SelectContext(ctx, r.db, &created, query, ids, names, vendorIDs, descriptions, prices, currencyList)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	if err = r.setBatchRelations(ctx, items, ids, created); err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// Update updates name, vendor, description, categories and tags of a product.
+// The vendor is found or created by name when VendorID is not set.
+// Should be called inside a transaction.
+func (r *Repository) Update(ctx context.Context, e products.Product) (products.Product, error) {
+	if ctx.Err() != nil {
+		return products.Product{}, ctx.Err()
+	}
+
+	query := `
+	UPDATE products
+	SET name = $2, vendor_id = $3, description = $4
+	WHERE id = $1
+	RETURNING id;
 	`
 
+	vendorID, err := r.resolveVendor(ctx, e)
+	if err != nil {
+		return products.Product{}, err
+	}
+
+	var id uuid.UUID
+	if err = sqlx.GetContext(ctx, r.db, &id, query, e.ID, e.Name, vendorID, e.Description); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return products.Product{}, errs.NotFound{What: "product"}
+		}
+
+		return products.Product{}, mapError(err)
+	}
+
+	if err = r.setCategories(ctx, id, e.CategoryIDs); err != nil {
+		return products.Product{}, err
+	}
+
+	if err = r.setTags(ctx, id, e.Tags); err != nil {
+		return products.Product{}, err
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+// GetByID returns a product by id.
+func (r *Repository) GetByID(ctx context.Context, id uuid.UUID) (products.Product, error) {
+	if ctx.Err() != nil {
+		return products.Product{}, ctx.Err()
+	}
+
+	query := selectProductsQuery + `WHERE p.id = $1;`
+
 	var dbp dbProduct
-	if err := sqlx.GetContext(ctx, r.db, &dbp, query, e.Name, e.Vendor, e.Description, e.Price); err != nil {
-		if strings.Contains(err.Error(), "duplicate key") {
-			return products.Product{}, errs.Conflict{What: "product already exists"}
+	if err := sqlx.GetContext(ctx, r.reads, &dbp, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return products.Product{}, errs.NotFound{What: "product"}
 		}
 
-		return products.Product{}, errs.Internal{Cause: err.Error()}
+		return products.Product{}, pgerrs.Map(err)
 	}
 
 	return dbp.toDomain(), nil
 }
 
-// GetAll returns products with pagination using limit and offset.
-func (r *Repository) GetAll(ctx context.Context, limit, offset uint64) (products.ProductList, error) {
+// GetAll returns products matching filter with pagination using limit and offset.
+func (r *Repository) GetAll(
+	ctx context.Context,
+	limit, offset uint64,
+	filter products.Filter,
+) (products.ProductList, error) {
 	if ctx.Err() != nil {
 		return products.ProductList{}, ctx.Err()
 	}
@@ -61,16 +260,15 @@ func (r *Repository) GetAll(ctx context.Context, limit, offset uint64) (products
 		limit = products.DefaultLimit
 	}
 
-	query := `
-	SELECT id, name, vendor, description, price, created_at, updated_at
-	FROM products
-	ORDER BY created_at DESC
-	LIMIT $1 OFFSET $2;
-	`
+	where, args := filterClause(filter)
+
+	query := selectProductsQuery + where + `
+	ORDER BY p.created_at DESC, p.id DESC
+	LIMIT $` + strconv.Itoa(len(args)+1) + ` OFFSET $` + strconv.Itoa(len(args)+2) + `;`
 
 	var dbItems []dbProduct
-	if err := sqlx.SelectContext(ctx, r.db, &dbItems, query, limit, offset); err != nil {
-		return products.ProductList{}, errs.Internal{Cause: err.Error()}
+	if err := sqlx.SelectContext(ctx, r.reads, &dbItems, query, append(args, limit, offset)...); err != nil {
+		return products.ProductList{}, pgerrs.Map(err)
 	}
 
 	items := make([]products.Product, len(dbItems))
@@ -80,8 +278,8 @@ func (r *Repository) GetAll(ctx context.Context, limit, offset uint64) (products
 
 	// get total count of entities
 	var total uint64
-	if err := sqlx.GetContext(ctx, r.db, &total, "SELECT COUNT(*) FROM products"); err != nil {
-		return products.ProductList{}, errs.Internal{Cause: err.Error()}
+	if err := sqlx.GetContext(ctx, r.reads, &total, "SELECT COUNT(*) FROM products p "+where, args...); err != nil {
+		return products.ProductList{}, pgerrs.Map(err)
 	}
 
 	return products.ProductList{
@@ -100,12 +298,12 @@ func (r *Repository) Delete(ctx context.Context, id uuid.UUID) error {
 
 	res, err := r.db.ExecContext(ctx, query, id)
 	if err != nil {
-		return errs.Internal{Cause: err.Error()}
+		return pgerrs.Map(err)
 	}
 
 	affected, err := res.RowsAffected()
 	if err != nil {
-		return errs.Internal{Cause: err.Error()}
+		return pgerrs.Map(err)
 	}
 	if affected == 0 {
 		return errs.NotFound{What: "product"}
@@ -113,3 +311,243 @@ func (r *Repository) Delete(ctx context.Context, id uuid.UUID) error {
 
 	return nil
 }
+
+// resolveVendor returns VendorID of a product, or finds or creates the vendor by its name.
+func (r *Repository) resolveVendor(ctx context.Context, e products.Product) (uuid.UUID, error) {
+	if e.VendorID != uuid.Nil {
+		return e.VendorID, nil
+	}
+
+	query := `
+	WITH v AS (
+		INSERT INTO vendors (name)
+		VALUES (trim($1))
+		ON CONFLICT (normalized_name) DO NOTHING
+		RETURNING id
+	)
+	SELECT id FROM v
+	UNION ALL
+	SELECT id FROM vendors WHERE normalized_name = normalize_vendor_name($1)
+	LIMIT 1;
+	`
+
+	var id uuid.UUID
+	err := sqlx.GetContext(ctx, r.db, &id, query, e.Vendor)
+	if errors.Is(err, sql.ErrNoRows) {
+		// the vendor is inserted by a concurrent transaction, which is committed once the insert conflicts,
+		// but the snapshot of the query is taken before the commit
+		selectQuery := `SELECT id FROM vendors WHERE normalized_name = normalize_vendor_name($1);`
+		err = sqlx.GetContext(ctx, r.db, &id, selectQuery, e.Vendor)
+	}
+	if err != nil {
+		return uuid.Nil, mapError(err)
+	}
+
+	return id, nil
+}
+
+// setCategories replaces categories of a product.
+func (r *Repository) setCategories(ctx context.Context, productID uuid.UUID, categoryIDs []uuid.UUID) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM product_categories WHERE product_id = $1`, productID); err != nil {
+		return pgerrs.Map(err)
+	}
+
+	query := `
+	INSERT INTO product_categories (product_id, category_id)
+	VALUES ($1, $2)
+	ON CONFLICT DO NOTHING;
+	`
+
+	for _, categoryID := range categoryIDs {
+		if _, err := r.db.ExecContext(ctx, query, productID, categoryID); err != nil {
+			return mapError(err)
+		}
+	}
+
+	return nil
+}
+
+// setTags replaces tags of a product.
+func (r *Repository) setTags(ctx context.Context, productID uuid.UUID, tags []string) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM product_tags WHERE product_id = $1`, productID); err != nil {
+		return pgerrs.Map(err)
+	}
+
+	query := `
+	INSERT INTO product_tags (product_id, tag)
+	VALUES ($1, $2)
+	ON CONFLICT DO NOTHING;
+	`
+
+	for _, tag := range products.NormalizeTags(tags) {
+		if _, err := r.db.ExecContext(ctx, query, productID, tag); err != nil {
+			return mapError(err)
+		}
+	}
+
+	return nil
+}
+
+// setBatchRelations inserts categories and tags of products created by CreateBatch, ids are ids generated for
+// items, products which were skipped are ignored.
+func (r *Repository) setBatchRelations(ctx context.Context, items products.Products, ids pq.StringArray,
+	created []uuid.UUID,
+) error {
+	isCreated := make(map[string]bool, len(created))
+	for _, id := range created {
+		isCreated[id.String()] = true
+	}
+
+	var categoryProductIDs, categoryIDs, tagProductIDs, tags pq.StringArray
+	for i, e := range items {
+		if !isCreated[ids[i]] {
+			continue
+		}
+
+		for _, categoryID := range e.CategoryIDs {
+			categoryProductIDs = append(categoryProductIDs, ids[i])
+			categoryIDs = append(categoryIDs, categoryID.String())
+		}
+
+		for _, tag := range products.NormalizeTags(e.Tags) {
+			tagProductIDs = append(tagProductIDs, ids[i])
+			tags = append(tags, tag)
+		}
+	}
+
+	if len(categoryIDs) > 0 {
+		query := `
+		INSERT INTO product_categories (product_id, category_id)
+		SELECT * FROM unnest($1::uuid[], $2::uuid[])
+		ON CONFLICT DO NOTHING;
+		`
+
+		if _, err := r.db.ExecContext(ctx, query, categoryProductIDs, categoryIDs); err != nil {
+			return mapError(err)
+		}
+	}
+
+	if len(tags) > 0 {
+		query := `
+		INSERT INTO product_tags (product_id, tag)
+		SELECT * FROM unnest($1::uuid[], $2::text[])
+		ON CONFLICT DO NOTHING;
+		`
+
+		if _, err := r.db.ExecContext(ctx, query, tagProductIDs, tags); err != nil {
+			return mapError(err)
+		}
+	}
+
+	return nil
+}
+
+// filterClause builds WHERE clause with positional arguments for filter.
+func filterClause(filter products.Filter) (string, []any) {
+	var (
+		conditions []string
+		args       []any
+	)
+
+	if filter.CategoryID != nil {
+		args = append(args, *filter.CategoryID)
+		conditions = append(conditions, `EXISTS (
+		SELECT 1 FROM product_categories pc
+		WHERE pc.product_id = p.id AND pc.category_id IN (
+			WITH RECURSIVE tree AS (
+				SELECT id FROM categories WHERE id = $`+strconv.Itoa(len(args))+`
+				UNION
+				SELECT c.id FROM categories c JOIN tree t ON c.parent_id = t.id
+			)
+			SELECT id FROM tree
+		)
+	)`)
+	}
+
+	if filter.VendorID != nil {
+		args = append(args, *filter.VendorID)
+		conditions = append(conditions, `p.vendor_id = $`+strconv.Itoa(len(args)))
+	}
+
+	if filter.Tag != "" {
+		args = append(args, products.NormalizeTag(filter.Tag))
+		conditions = append(conditions, `EXISTS (
+		SELECT 1 FROM product_tags pt
+		WHERE pt.product_id = p.id AND pt.tag = $`+strconv.Itoa(len(args))+`
+	)`)
+	}
+
+	if !filter.ChangedFrom.IsZero() || !filter.ChangedTo.IsZero() {
+		conditions = append(conditions, "("+changedClause("p.created_at", filter, &args)+
+			" OR "+changedClause("p.updated_at", filter, &args)+")")
+	}
+
+	if filter.After != nil {
+		args = append(args, filter.After.CreatedAt, filter.After.ID)
+		conditions = append(conditions,
+			`(p.created_at, p.id) < ($`+strconv.Itoa(len(args)-1)+`, $`+strconv.Itoa(len(args))+`)`)
+	}
+
+	if len(conditions) == 0 {
+		return "", nil
+	}
+
+	return "\n\tWHERE " + strings.Join(conditions, "\n\t  AND ") + "\n", args
+}
+
+// constraintFields maps constraints checked on writes of products to fields of requests.
+var constraintFields = map[string]string{
+	"products_name_check":            "name",
+	"products_description_check":     "description",
+	"products_price_check":           "price",
+	"products_currency_check":        "currency",
+	"product_prices_price_check":     "price",
+	"product_prices_currency_check":  "currency",
+	"vendors_name_check":             "vendor",
+	"fk_products_vendor":             "vendor_id",
+	"fk_product_categories_category": "category_ids",
+	"product_tags_tag_check":         "tags",
+}
+
+// mapError maps database errors of products to application errors, constraint violations are errors of
+// the fields they check, so they are not responded as internal errors.
+func mapError(err error) error {
+	switch pgerrs.Constraint(err) {
+	case "uq_products_name_vendor":
+		return errs.AlreadyExists{What: "product with the same name already exists for the vendor"}
+	case "products_pkey":
+		return errs.AlreadyExists{What: "product with the same id already exists"}
+	}
+
+	if pgerrs.Code(err) == pgerrs.CodeUniqueViolation {
+		return errs.AlreadyExists{What: "product already exists"}
+	}
+
+	if field, ok := constraintFields[pgerrs.Constraint(err)]; ok {
+		if pgerrs.Code(err) == pgerrs.CodeForeignKeyViolation {
+			return errs.FieldsValidation{Errors: []string{field + "::not_found"}}
+		}
+
+		return errs.FieldsValidation{Errors: []string{field + "::invalid_value"}}
+	}
+
+	return pgerrs.Map(err)
+}
+
+// changedClause returns a condition of a timestamp column within bounds of changes of the filter, values of bounds
+// are appended to args.
+func changedClause(column string, filter products.Filter, args *[]any) string {
+	var conditions []string
+
+	if !filter.ChangedFrom.IsZero() {
+		*args = append(*args, filter.ChangedFrom)
+		conditions = append(conditions, column+" >= $"+strconv.Itoa(len(*args)))
+	}
+
+	if !filter.ChangedTo.IsZero() {
+		*args = append(*args, filter.ChangedTo)
+		conditions = append(conditions, column+" < $"+strconv.Itoa(len(*args)))
+	}
+
+	return "(" + strings.Join(conditions, " AND ") + ")"
+}
@@ -0,0 +1,116 @@
+package products
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/pgerrs"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterClause(t *testing.T) {
+	categoryID, vendorID := uuid.New(), uuid.New()
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		filter   products.Filter
+		contains []string
+		args     []any
+	}{
+		{
+			name: "no filter",
+		},
+		{
+			name:     "category with subcategories",
+			filter:   products.Filter{CategoryID: &categoryID},
+			contains: []string{"WITH RECURSIVE tree", "WHERE id = $1", "UNION\n"},
+			args:     []any{categoryID},
+		},
+		{
+			name:     "normalized tag",
+			filter:   products.Filter{Tag: " Sale "},
+			contains: []string{"pt.tag = $1"},
+			args:     []any{"sale"},
+		},
+		{
+			name:   "all conditions are numbered in order",
+			filter: products.Filter{CategoryID: &categoryID, VendorID: &vendorID, Tag: "sale", ChangedFrom: from, ChangedTo: to},
+			contains: []string{
+				"WHERE id = $1", "p.vendor_id = $2", "pt.tag = $3",
+				"((p.created_at >= $4 AND p.created_at < $5) OR (p.updated_at >= $6 AND p.updated_at < $7))",
+			},
+			args: []any{categoryID, vendorID, "sale", from, to, from, to},
+		},
+		{
+			name:     "changed from only",
+			filter:   products.Filter{ChangedFrom: from},
+			contains: []string{"((p.created_at >= $1) OR (p.updated_at >= $2))"},
+			args:     []any{from, from},
+		},
+		{
+			name:     "after key",
+			filter:   products.Filter{VendorID: &vendorID, After: &products.Key{CreatedAt: from, ID: categoryID}},
+			contains: []string{"p.vendor_id = $1", "(p.created_at, p.id) < ($2, $3)"},
+			args:     []any{vendorID, from, categoryID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clause, args := filterClause(tt.filter)
+			assert.Equal(t, tt.args, args)
+
+			if len(tt.contains) == 0 {
+				assert.Empty(t, clause)
+				return
+			}
+
+			assert.True(t, strings.HasPrefix(strings.TrimSpace(clause), "WHERE "), clause)
+			for _, part := range tt.contains {
+				assert.Contains(t, clause, part)
+			}
+		})
+	}
+}
+
+func TestMapError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{
+			name: "duplicate name of the vendor",
+			err:  &pgconn.PgError{Code: pgerrs.CodeUniqueViolation, ConstraintName: "uq_products_name_vendor"},
+			want: errs.AlreadyExists{What: "product with the same name already exists for the vendor"},
+		},
+		{
+			name: "duplicate id",
+			err:  &pgconn.PgError{Code: pgerrs.CodeUniqueViolation, ConstraintName: "products_pkey"},
+			want: errs.AlreadyExists{What: "product with the same id already exists"},
+		},
+		{
+			name: "other unique violation",
+			err:  &pgconn.PgError{Code: pgerrs.CodeUniqueViolation, ConstraintName: "uq_unknown"},
+			want: errs.AlreadyExists{What: "product already exists"},
+		},
+		{
+			name: "unknown vendor",
+			err:  &pgconn.PgError{Code: pgerrs.CodeForeignKeyViolation, ConstraintName: "fk_products_vendor"},
+			want: errs.FieldsValidation{Errors: []string{"vendor_id::not_found"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, mapError(tt.err))
+		})
+	}
+}
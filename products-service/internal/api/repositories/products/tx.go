@@ -0,0 +1,62 @@
+package products
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+var _ repositories.ProductsTxRepository = &TxRepository{}
+
+// TxRepository - runs functions with repositories bound to transactions of the primary database.
+type TxRepository struct {
+	db         *sqlx.DB
+	repoLogger *zap.Logger // logger of repositories bound to transactions
+	logger     *zap.Logger
+}
+
+// NewTxRepository creates a new repositories.
+func NewTxRepository(db *sqlx.DB, logger *zap.Logger) *TxRepository {
+	return &TxRepository{db: db, repoLogger: logger, logger: logger.With(zap.String("repositories", "products"))}
+}
+
+// InTx runs fn with a repository bound to a transaction, which is committed when fn succeeds
+// and rolled back otherwise, panics of fn roll the transaction back as well.
+func (r *TxRepository) InTx(ctx context.Context, fn func(repo repositories.ProductsRepository) error) (err error) {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	tx, err := r.db.BeginTxx(ctx, &sql.TxOptions{})
+	if err != nil {
+		r.logger.Error("failed to begin transaction", zap.Error(err))
+		return err
+	}
+	defer func() {
+		if pp := recover(); pp != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				r.logger.Error("failed to rollback transaction after panic", zap.Error(rollbackErr))
+			}
+			panic(pp)
+		}
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				r.logger.Error("failed to rollback transaction", zap.Error(rollbackErr))
+			}
+		}
+	}()
+
+	if err = fn(NewRepository(tx, r.repoLogger)); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error("failed to commit transaction", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
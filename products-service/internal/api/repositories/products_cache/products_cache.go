@@ -0,0 +1,390 @@
+package products_cache
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/metrics"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/dbrouter"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Keys of cached reads, lists are keyed by a generation replaced on every invalidation, so all of them are
+// invalidated at once. Products are keyed by a generation replaced when all of them are invalidated.
+const (
+	productKeyPrefix     = "products:id:"
+	productGenerationKey = "products:generation"
+	listKeyPrefix        = "products:list:"
+	listGenerationKey    = "products:list-generation"
+	// invalidatedLSNKey - WAL position of the primary after the last invalidation, misses are read from
+	// replicas which have applied it.
+	invalidatedLSNKey = "products:invalidated-lsn"
+)
+
+// Labels of cache metrics.
+const (
+	cacheProduct     = "product"
+	cacheProductList = "product_list"
+)
+
+var (
+	_ repositories.ProductsRepository      = &Repository{}
+	_ repositories.ProductsCacheRepository = &Repository{}
+)
+
+type (
+	// PrimaryLSNReader - reads the current WAL position of the primary, e.g. *dbrouter.Router.
+	PrimaryLSNReader interface {
+		PrimaryLSN(ctx context.Context) (uint64, error)
+	}
+
+	// Repository - defines a products repositories serving GetByID and GetAll from cache, other methods go to
+	// the wrapped repositories and invalidate the cache.
+	Repository struct {
+		repositories.ProductsRepository
+
+		primary    PrimaryLSNReader
+		cache      repositories.CacheRepository
+		productTTL time.Duration
+		listTTL    time.Duration
+		metrics    *metrics.Metrics
+		logger     *zap.Logger
+	}
+)
+
+// NewRepository creates a new repositories caching reads of next. Misses are read by next from replicas
+// which have applied the last invalidation read from primary, so lagging replicas don't fill the cache with
+// products it invalidated; primary is nil when reads are not routed to replicas.
+func NewRepository(
+	next repositories.ProductsRepository,
+	primary PrimaryLSNReader,
+	cache repositories.CacheRepository,
+	productTTL, listTTL time.Duration,
+	metrics *metrics.Metrics,
+	logger *zap.Logger,
+) *Repository {
+	return &Repository{
+		ProductsRepository: next,
+		primary:            primary,
+		cache:              cache,
+		productTTL:         productTTL,
+		listTTL:            listTTL,
+		metrics:            metrics,
+		logger:             logger.With(zap.String("repositories", "products_cache")),
+	}
+}
+
+// Create creates a product and invalidates cached lists.
+func (r *Repository) Create(ctx context.Context, e products.Product) (products.Product, error) {
+	product, err := r.ProductsRepository.Create(ctx, e)
+	if err != nil {
+		return products.Product{}, err
+	}
+
+	r.invalidate(ctx, product.ID)
+
+	return product, nil
+}
+
+// CreateBatch creates products and invalidates cached lists.
+func (r *Repository) CreateBatch(ctx context.Context, items products.Products) ([]uuid.UUID, error) {
+	ids, err := r.ProductsRepository.CreateBatch(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.Invalidate(ctx, ids...); err != nil {
+		r.logger.Error("failed to invalidate products cache", zap.Error(err), zap.Int("count", len(ids)))
+	}
+
+	return ids, nil
+}
+
+// Update updates a product and invalidates it and cached lists.
+func (r *Repository) Update(ctx context.Context, e products.Product) (products.Product, error) {
+	product, err := r.ProductsRepository.Update(ctx, e)
+	if err != nil {
+		return products.Product{}, err
+	}
+
+	r.invalidate(ctx, product.ID)
+
+	return product, nil
+}
+
+// Delete deletes a product and invalidates it and cached lists.
+func (r *Repository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.ProductsRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	r.invalidate(ctx, id)
+
+	return nil
+}
+
+// GetByID returns a product by id from cache, it is read from the wrapped repositories on miss. Reads of
+// clients which must observe their writes bypass the cache.
+func (r *Repository) GetByID(ctx context.Context, id uuid.UUID) (products.Product, error) {
+	if dbrouter.MinLSN(ctx) > 0 {
+		return r.ProductsRepository.GetByID(ctx, id)
+	}
+
+	key := r.productKey(ctx, id)
+
+	var product products.Product
+	if key != "" && r.get(ctx, cacheProduct, key, &product) {
+		return product, nil
+	}
+
+	product, err := r.ProductsRepository.GetByID(r.missContext(ctx), id)
+	if err != nil {
+		return products.Product{}, err
+	}
+
+	if key != "" {
+		r.set(ctx, key, product, r.productTTL)
+	}
+
+	return product, nil
+}
+
+// GetAll returns a page of products from cache, it is read from the wrapped repositories on miss. Reads of
+// clients which must observe their writes bypass the cache.
+func (r *Repository) GetAll(
+	ctx context.Context,
+	limit, offset uint64,
+	filter products.Filter,
+) (products.ProductList, error) {
+	if dbrouter.MinLSN(ctx) > 0 {
+		return r.ProductsRepository.GetAll(ctx, limit, offset, filter)
+	}
+
+	key := r.listKey(ctx, limit, offset, filter)
+
+	var list products.ProductList
+	if key != "" && r.get(ctx, cacheProductList, key, &list) {
+		return list, nil
+	}
+
+	list, err := r.ProductsRepository.GetAll(r.missContext(ctx), limit, offset, filter)
+	if err != nil {
+		return products.ProductList{}, err
+	}
+
+	if key != "" {
+		r.set(ctx, key, list, r.listTTL)
+	}
+
+	return list, nil
+}
+
+// Invalidate removes cached products and all cached lists, it is called after writes committed by services.
+func (r *Repository) Invalidate(ctx context.Context, ids ...uuid.UUID) error {
+	generation, ok, err := r.cache.Get(ctx, productGenerationKey)
+	if err != nil {
+		return err
+	}
+
+	if ok {
+		keys := make([]string, 0, len(ids))
+		for _, id := range ids {
+			keys = append(keys, productKeyPrefix+string(generation)+":"+id.String())
+		}
+
+		if err = r.cache.Delete(ctx, keys...); err != nil {
+			return err
+		}
+	}
+
+	if err = r.setInvalidatedLSN(ctx); err != nil {
+		return err
+	}
+
+	return r.cache.Set(ctx, listGenerationKey, []byte(uuid.NewString()), 0)
+}
+
+// InvalidateAll removes all cached products and lists, it is called after writes changing products
+// without their ids known, e.g. renames of vendors and moves of categories.
+func (r *Repository) InvalidateAll(ctx context.Context) error {
+	if err := r.setInvalidatedLSN(ctx); err != nil {
+		return err
+	}
+
+	if err := r.cache.Set(ctx, productGenerationKey, []byte(uuid.NewString()), 0); err != nil {
+		return err
+	}
+
+	return r.cache.Set(ctx, listGenerationKey, []byte(uuid.NewString()), 0)
+}
+
+// invalidate invalidates cache after writes, failures are logged as the cache expires anyway.
+func (r *Repository) invalidate(ctx context.Context, id uuid.UUID) {
+	if err := r.Invalidate(ctx, id); err != nil {
+		r.logger.Error("failed to invalidate products cache", zap.Error(err), zap.String("id", id.String()))
+	}
+}
+
+// setInvalidatedLSN stores the WAL position of the primary, which has the invalidating write, before
+// the invalidation, so misses after it aren't read from replicas lagging behind the write.
+func (r *Repository) setInvalidatedLSN(ctx context.Context) error {
+	if r.primary == nil {
+		return nil
+	}
+
+	lsn, err := r.primary.PrimaryLSN(ctx)
+	if err != nil {
+		return err
+	}
+
+	return r.cache.Set(ctx, invalidatedLSNKey, []byte(dbrouter.FormatLSN(lsn)), 0)
+}
+
+// missContext returns ctx of a read on miss, it is routed to replicas which have applied the last
+// invalidation, to any replica when nothing is invalidated since the cache is empty, and to the primary
+// when the position can't be read.
+func (r *Repository) missContext(ctx context.Context) context.Context {
+	if r.primary == nil {
+		return ctx
+	}
+
+	data, ok, err := r.cache.Get(ctx, invalidatedLSNKey)
+	if err != nil {
+		r.logger.Warn("failed to get WAL position of invalidation", zap.Error(err))
+		return dbrouter.WithMinLSN(ctx, math.MaxUint64)
+	}
+	if !ok {
+		return ctx
+	}
+
+	lsn, err := dbrouter.ParseLSN(string(data))
+	if err != nil {
+		r.logger.Warn("invalid WAL position of invalidation", zap.Error(err))
+		return dbrouter.WithMinLSN(ctx, math.MaxUint64)
+	}
+
+	return dbrouter.WithMinLSN(ctx, lsn)
+}
+
+// productKey returns key of a product in the current generation of products, it is empty when
+// the generation is unknown, so the product is not cached.
+func (r *Repository) productKey(ctx context.Context, id uuid.UUID) string {
+	generation := r.generation(ctx, productGenerationKey)
+	if generation == "" {
+		return ""
+	}
+
+	return productKeyPrefix + generation + ":" + id.String()
+}
+
+// listKey returns key of a page of products in the current generation of lists, it is empty when
+// the generation is unknown, so the page is not cached.
+func (r *Repository) listKey(ctx context.Context, limit, offset uint64, filter products.Filter) string {
+	generation := r.generation(ctx, listGenerationKey)
+	if generation == "" {
+		return ""
+	}
+
+	parts := []string{
+		generation,
+		strconv.FormatUint(limit, 10),
+		strconv.FormatUint(offset, 10),
+		optionalID(filter.VendorID),
+		optionalID(filter.CategoryID),
+		filter.Tag,
+		optionalTime(filter.ChangedFrom),
+		optionalTime(filter.ChangedTo),
+		optionalKey(filter.After),
+	}
+
+	return listKeyPrefix + strings.Join(parts, ":")
+}
+
+// generation returns the current generation of cached reads stored by key, it is empty when it can't be
+// read or set.
+func (r *Repository) generation(ctx context.Context, key string) string {
+	generation, ok, err := r.cache.Get(ctx, key)
+	if err != nil {
+		r.logger.Warn("failed to get generation of cached products", zap.Error(err), zap.String("key", key))
+		return ""
+	}
+	if !ok {
+		// the generation is evicted or never set, reads cached before can't be trusted
+		generation = []byte(uuid.NewString())
+		if err = r.cache.Set(ctx, key, generation, 0); err != nil {
+			r.logger.Warn("failed to set generation of cached products", zap.Error(err), zap.String("key", key))
+			return ""
+		}
+	}
+
+	return string(generation)
+}
+
+// get decodes a cached value to dst and counts hits and misses, failures of the cache are misses.
+func (r *Repository) get(ctx context.Context, cache, key string, dst any) bool {
+	data, ok, err := r.cache.Get(ctx, key)
+	if err != nil {
+		r.logger.Warn("failed to get cached value", zap.Error(err), zap.String("key", key))
+	}
+	if ok {
+		if err = json.Unmarshal(data, dst); err != nil {
+			r.logger.Warn("failed to decode cached value", zap.Error(err), zap.String("key", key))
+			ok = false
+		}
+	}
+
+	if r.metrics != nil && r.metrics.CacheHitsCounter != nil && r.metrics.CacheMissesCounter != nil {
+		if ok {
+			r.metrics.CacheHitsCounter.WithLabelValues(cache).Inc()
+		} else {
+			r.metrics.CacheMissesCounter.WithLabelValues(cache).Inc()
+		}
+	}
+
+	return ok
+}
+
+// set caches a value for ttl, failures are logged as the value is read from the wrapped repositories anyway.
+func (r *Repository) set(ctx context.Context, key string, value any, ttl time.Duration) {
+	data, err := json.Marshal(value)
+	if err == nil {
+		err = r.cache.Set(ctx, key, data, ttl)
+	}
+	if err != nil {
+		r.logger.Warn("failed to cache value", zap.Error(err), zap.String("key", key))
+	}
+}
+
+// optionalID formats an optional id of a filter.
+func optionalID(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+
+	return id.String()
+}
+
+// optionalKey formats an optional key of a filter.
+func optionalKey(key *products.Key) string {
+	if key == nil {
+		return ""
+	}
+
+	return optionalTime(key.CreatedAt) + "/" + key.ID.String()
+}
+
+// optionalTime formats an optional time of a filter.
+func optionalTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339Nano)
+}
@@ -0,0 +1,162 @@
+package products_cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/lru_cache"
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/dbrouter"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type (
+	// fakeRepository - products repositories counting reads and WAL positions they must observe.
+	fakeRepository struct {
+		repositories.ProductsRepository
+
+		product products.Product
+		gets    int
+		lists   int
+		minLSNs []uint64
+	}
+
+	// fakePrimary - primary at a WAL position.
+	fakePrimary struct {
+		lsn uint64
+	}
+)
+
+func (f *fakeRepository) GetByID(ctx context.Context, _ uuid.UUID) (products.Product, error) {
+	f.gets++
+	f.minLSNs = append(f.minLSNs, dbrouter.MinLSN(ctx))
+	return f.product, nil
+}
+
+func (f *fakeRepository) GetAll(ctx context.Context, _, _ uint64, _ products.Filter) (products.ProductList, error) {
+	f.lists++
+	f.minLSNs = append(f.minLSNs, dbrouter.MinLSN(ctx))
+	return products.ProductList{Total: 1, Products: products.Products{f.product}}, nil
+}
+
+func (f *fakePrimary) PrimaryLSN(context.Context) (uint64, error) { return f.lsn, nil }
+
+func (f *fakeRepository) Delete(context.Context, uuid.UUID) error { return nil }
+
+// TestRepository tests that reads are served from cache until they are invalidated.
+func TestRepository(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeRepository{product: products.Product{
+		ID:    uuid.New(),
+		Name:  "Laptop",
+		Price: decimal.RequireFromString("10.50"),
+		Tags:  []string{"laptop"},
+	}}
+	r := NewRepository(fake, nil, lru_cache.NewRepository(100, zap.NewNop()), time.Minute, time.Minute, nil,
+		zap.NewNop())
+
+	for range 2 {
+		product, err := r.GetByID(ctx, fake.product.ID)
+		require.NoError(t, err)
+		assert.Equal(t, fake.product.Name, product.Name)
+		assert.True(t, fake.product.Price.Equal(product.Price))
+
+		_, err = r.GetAll(ctx, 10, 0, products.Filter{Tag: "laptop"})
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, fake.gets)
+	assert.Equal(t, 1, fake.lists)
+
+	_, err := r.GetAll(ctx, 10, 0, products.Filter{})
+	require.NoError(t, err)
+	assert.Equal(t, 2, fake.lists, "pages of other filters are cached separately")
+
+	require.NoError(t, r.Invalidate(ctx, fake.product.ID))
+	_, err = r.GetByID(ctx, fake.product.ID)
+	require.NoError(t, err)
+	_, err = r.GetAll(ctx, 10, 0, products.Filter{Tag: "laptop"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, fake.gets)
+	assert.Equal(t, 3, fake.lists)
+
+	require.NoError(t, r.Delete(ctx, fake.product.ID))
+	_, err = r.GetByID(ctx, fake.product.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 3, fake.gets, "delete invalidates the product")
+}
+
+// TestRepository_InvalidateAll tests that all products and lists are invalidated at once.
+func TestRepository_InvalidateAll(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeRepository{product: products.Product{ID: uuid.New(), Name: "Laptop"}}
+	r := NewRepository(fake, nil, lru_cache.NewRepository(100, zap.NewNop()), time.Minute, time.Minute, nil,
+		zap.NewNop())
+
+	for range 2 {
+		_, err := r.GetByID(ctx, fake.product.ID)
+		require.NoError(t, err)
+		_, err = r.GetAll(ctx, 10, 0, products.Filter{})
+		require.NoError(t, err)
+
+		require.NoError(t, r.InvalidateAll(ctx))
+	}
+	assert.Equal(t, 2, fake.gets)
+	assert.Equal(t, 2, fake.lists)
+}
+
+// TestRepository_MissesReadInvalidatedLSN tests that misses are read from replicas which have applied the last
+// invalidation, so a replica lagging behind it doesn't cache the product it had before.
+func TestRepository_MissesReadInvalidatedLSN(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+	fake := &fakeRepository{product: products.Product{ID: id, Name: "Laptop"}}
+	primary := &fakePrimary{lsn: 0x10}
+	r := NewRepository(fake, primary, lru_cache.NewRepository(100, zap.NewNop()), time.Minute, time.Minute,
+		nil, zap.NewNop())
+
+	_, err := r.GetByID(ctx, id)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Invalidate(ctx, id))
+	_, err = r.GetByID(ctx, id)
+	require.NoError(t, err)
+
+	primary.lsn = 0x20
+	require.NoError(t, r.InvalidateAll(ctx))
+	_, err = r.GetAll(ctx, 10, 0, products.Filter{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []uint64{0, 0x10, 0x20}, fake.minLSNs, "any replica is read before invalidations")
+}
+
+// TestRepository_MinLSNBypassesCache tests that reads of clients which must observe their writes are neither
+// served from cache nor cached.
+func TestRepository_MinLSNBypassesCache(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeRepository{product: products.Product{ID: uuid.New(), Name: "Laptop"}}
+	r := NewRepository(fake, &fakePrimary{lsn: 0x10}, lru_cache.NewRepository(100, zap.NewNop()), time.Minute,
+		time.Minute, nil, zap.NewNop())
+
+	_, err := r.GetByID(ctx, fake.product.ID)
+	require.NoError(t, err)
+
+	for range 2 {
+		_, err = r.GetByID(dbrouter.WithMinLSN(ctx, 0x30), fake.product.ID)
+		require.NoError(t, err)
+		_, err = r.GetAll(dbrouter.WithMinLSN(ctx, 0x30), 10, 0, products.Filter{})
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 3, fake.gets)
+	assert.Equal(t, 2, fake.lists)
+	assert.Equal(t, []uint64{0, 0x30, 0x30, 0x30, 0x30}, fake.minLSNs)
+
+	_, err = r.GetAll(ctx, 10, 0, products.Filter{})
+	require.NoError(t, err)
+	assert.Equal(t, 3, fake.lists, "lists read with a min LSN are not cached")
+}
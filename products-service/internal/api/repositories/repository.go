@@ -2,8 +2,17 @@ package repositories
 
 import (
 	"context"
+	"io"
+	"time"
 
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/categories"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/changes"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/currencies"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/images"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/inventory"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/prices"
 	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/vendors"
 	"github.com/google/uuid"
 )
 
@@ -11,13 +20,103 @@ type (
 	// ProductsRepository defines the interface for product repositories.
 	ProductsRepository interface {
 		Create(ctx context.Context, e products.Product) (products.Product, error)
-		GetAll(ctx context.Context, limit, offset uint64) (products.ProductList, error)
+		CreateBatch(ctx context.Context, items products.Products) ([]uuid.UUID, error)
+		Update(ctx context.Context, e products.Product) (products.Product, error)
+		GetByID(ctx context.Context, id uuid.UUID) (products.Product, error)
+		GetAll(ctx context.Context, limit, offset uint64, filter products.Filter) (products.ProductList, error)
 		Delete(ctx context.Context, id uuid.UUID) error
 	}
 
+	// ProductsTxRepository defines the interface for transactions of product repositories.
+	ProductsTxRepository interface {
+		// InTx runs fn with a repository bound to a transaction, which is committed when fn succeeds
+		// and rolled back otherwise.
+		InTx(ctx context.Context, fn func(repo ProductsRepository) error) error
+	}
+
+	// ProductChangesRepository defines the interface for the feed of committed changes of products.
+	ProductChangesRepository interface {
+		// GetAfter returns up to limit changes after a position, changes of transactions which may still
+		// be committed before other changes are not returned yet.
+		GetAfter(ctx context.Context, after changes.Position, limit uint64) (changes.Changes, error)
+		// Latest returns a position changes committed from now on are after.
+		Latest(ctx context.Context) (changes.Position, error)
+		// Purge removes changes made before a moment, returns the number of removed changes.
+		Purge(ctx context.Context, before time.Time) (int64, error)
+	}
+
+	// VendorsRepository defines the interface for vendors repositories.
+	VendorsRepository interface {
+		Create(ctx context.Context, e vendors.Vendor) (vendors.Vendor, error)
+		GetAll(ctx context.Context, limit, offset uint64) (vendors.VendorList, error)
+		GetByID(ctx context.Context, id uuid.UUID) (vendors.Vendor, error)
+		Update(ctx context.Context, e vendors.Vendor) (vendors.Vendor, error)
+		Delete(ctx context.Context, id uuid.UUID) error
+	}
+
+	// CategoriesRepository defines the interface for categories repositories.
+	CategoriesRepository interface {
+		Create(ctx context.Context, e categories.Category) (categories.Category, error)
+		GetAll(ctx context.Context) (categories.Categories, error)
+		GetByID(ctx context.Context, id uuid.UUID) (categories.Category, error)
+		Update(ctx context.Context, e categories.Category) (categories.Category, error)
+		Delete(ctx context.Context, id uuid.UUID, reassignTo *uuid.UUID) error
+	}
+
+	// PricesRepository defines the interface for product prices repositories.
+	PricesRepository interface {
+		Create(ctx context.Context, e prices.Price) (prices.Price, error)
+		GetByProductID(ctx context.Context, productID uuid.UUID) (prices.Prices, error)
+	}
+
+	// CurrencyRatesRepository defines the interface for currency rates repositories.
+	CurrencyRatesRepository interface {
+		GetAll(ctx context.Context) (currencies.Rates, error)
+		Upsert(ctx context.Context, e currencies.Rate) (currencies.Rate, error)
+	}
+
+	// InventoryRepository defines the interface for stock levels and reservations repositories.
+	InventoryRepository interface {
+		GetByProductID(ctx context.Context, productID uuid.UUID) (inventory.Stocks, error)
+		Lock(ctx context.Context, productID uuid.UUID, warehouse string) (inventory.Stock, error)
+		Adjust(ctx context.Context, e inventory.Adjustment) (inventory.Stock, error)
+		CreateReservation(ctx context.Context, e inventory.Reservation) (inventory.Reservation, error)
+		ReleaseReservation(ctx context.Context, productID, id uuid.UUID) (inventory.Reservation, error)
+	}
+
+	// ImagesRepository defines the interface for product images metadata repositories.
+	ImagesRepository interface {
+		Create(ctx context.Context, e images.Image) (images.Image, error)
+		GetByProductIDs(ctx context.Context, productIDs []uuid.UUID) (images.Images, error)
+		Delete(ctx context.Context, productID, id uuid.UUID) (images.Image, error)
+	}
+
+	// ObjectStorageRepository defines the interface for object storage.
+	ObjectStorageRepository interface {
+		Put(ctx context.Context, key, contentType, checksum string, body io.Reader, size int64) error
+		PresignGet(ctx context.Context, key string) (string, error)
+		Delete(ctx context.Context, key string) error
+		DeletePrefix(ctx context.Context, prefix string) error
+	}
+
+	// CacheRepository defines the interface for caches of reads, values are encoded by their users.
+	CacheRepository interface {
+		Get(ctx context.Context, key string) ([]byte, bool, error)
+		Set(ctx context.Context, key string, value []byte, ttl time.Duration) error // ttl 0 never expires
+		Delete(ctx context.Context, keys ...string) error
+	}
+
+	// ProductsCacheRepository defines the interface for invalidation of cached product reads.
+	ProductsCacheRepository interface {
+		Invalidate(ctx context.Context, ids ...uuid.UUID) error
+		InvalidateAll(ctx context.Context) error
+	}
+
 	// SQSPublisherRepository defines the interface for SQS publisher.
 	SQSPublisherRepository interface {
 		CreateToNotificationsService(ctx context.Context, id uuid.UUID) error
+		UpdateToNotificationsService(ctx context.Context, id uuid.UUID) error
 		DeleteToNotificationsService(ctx context.Context, id uuid.UUID) error
+		StockAlertToNotificationsService(ctx context.Context, alert inventory.Alert) error
 	}
 )
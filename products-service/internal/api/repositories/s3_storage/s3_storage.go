@@ -0,0 +1,129 @@
+package s3_storage
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"go.uber.org/zap"
+)
+
+var _ repositories.ObjectStorageRepository = &Repository{}
+
+// Repository implements repositories interface for storing objects in S3 bucket.
+type Repository struct {
+	client     *s3.Client
+	presigner  *s3.PresignClient
+	bucket     string
+	presignTTL time.Duration
+	logger     *zap.Logger
+}
+
+// NewRepository creates a new repositories, presigner may use another endpoint than client,
+// e.g. the one reachable by API clients.
+func NewRepository(
+	client *s3.Client,
+	presigner *s3.PresignClient,
+	bucket string,
+	presignTTL time.Duration,
+	logger *zap.Logger,
+) repositories.ObjectStorageRepository {
+	return &Repository{
+		client:     client,
+		presigner:  presigner,
+		bucket:     bucket,
+		presignTTL: presignTTL,
+		logger:     logger.With(zap.String("repositories", "s3_storage")),
+	}
+}
+
+// Put uploads an object, checksum is hex encoded SHA-256 of the body verified by S3.
+func (r Repository) Put(ctx context.Context, key, contentType, checksum string, body io.Reader, size int64) error {
+	sum, err := hex.DecodeString(checksum)
+	if err != nil {
+		return errs.Internal{Cause: "invalid checksum: " + err.Error()}
+	}
+
+	input := &s3.PutObjectInput{
+		Bucket:            aws.String(r.bucket),
+		Key:               aws.String(key),
+		Body:              body,
+		ContentLength:     aws.Int64(size),
+		ContentType:       aws.String(contentType),
+		ChecksumAlgorithm: types.ChecksumAlgorithmSha256,
+		ChecksumSHA256:    aws.String(base64.StdEncoding.EncodeToString(sum)),
+	}
+
+	if _, err = r.client.PutObject(ctx, input); err != nil {
+		return errs.Internal{Cause: "failed to put object to S3: " + err.Error()}
+	}
+
+	r.logger.Debug("object put to S3", zap.String("key", key), zap.Int64("size", size))
+
+	return nil
+}
+
+// PresignGet returns a temporary URL to download an object.
+func (r Repository) PresignGet(ctx context.Context, key string) (string, error) {
+	req, err := r.presigner.PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(r.bucket),
+		Key:    aws.String(key),
+	}, s3.WithPresignExpires(r.presignTTL))
+	if err != nil {
+		return "", errs.Internal{Cause: "failed to presign S3 object: " + err.Error()}
+	}
+
+	return req.URL, nil
+}
+
+// Delete removes an object.
+func (r Repository) Delete(ctx context.Context, key string) error {
+	if _, err := r.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(r.bucket),
+		Key:    aws.String(key),
+	}); err != nil {
+		return errs.Internal{Cause: "failed to delete object from S3: " + err.Error()}
+	}
+
+	return nil
+}
+
+// DeletePrefix removes all objects with keys starting with prefix.
+func (r Repository) DeletePrefix(ctx context.Context, prefix string) error {
+	paginator := s3.NewListObjectsV2Paginator(r.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(r.bucket),
+		Prefix: aws.String(prefix),
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return errs.Internal{Cause: "failed to list objects in S3: " + err.Error()}
+		}
+
+		if len(page.Contents) == 0 {
+			continue
+		}
+
+		objects := make([]types.ObjectIdentifier, len(page.Contents))
+		for i, obj := range page.Contents {
+			objects[i] = types.ObjectIdentifier{Key: obj.Key}
+		}
+
+		if _, err = r.client.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(r.bucket),
+			Delete: &types.Delete{Objects: objects, Quiet: aws.Bool(true)},
+		}); err != nil {
+			return errs.Internal{Cause: "failed to delete objects from S3: " + err.Error()}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package s3_storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// fakeS3 - a bucket served over the S3 API with path-style addressing, listings are paginated by pageSize.
+type fakeS3 struct {
+	mu       sync.Mutex
+	objects  map[string][]byte
+	headers  map[string]http.Header
+	pageSize int
+	deletes  int // requests deleting objects in batches
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	_, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	query := r.URL.Query()
+
+	switch {
+	case r.Method == http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		f.objects[key] = body
+		f.headers[key] = r.Header.Clone()
+		w.Header().Set("ETag", `"etag"`)
+	case r.Method == http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPost && query.Has("delete"):
+		var req struct {
+			Objects []struct {
+				Key string `xml:"Key"`
+			} `xml:"Object"`
+		}
+		_ = xml.NewDecoder(r.Body).Decode(&req)
+		for _, obj := range req.Objects {
+			delete(f.objects, obj.Key)
+		}
+		f.deletes++
+		_, _ = io.WriteString(w, `<DeleteResult></DeleteResult>`)
+	case r.Method == http.MethodGet && query.Get("list-type") == "2":
+		f.list(w, query.Get("prefix"), query.Get("continuation-token"))
+	default:
+		http.Error(w, "unexpected request", http.StatusBadRequest)
+	}
+}
+
+// list responds a page of keys with prefix after the continuation token, which is the last key of the previous page.
+func (f *fakeS3) list(w http.ResponseWriter, prefix, after string) {
+	var keys []string
+	for key := range f.objects {
+		if strings.HasPrefix(key, prefix) && key > after {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	truncated := len(keys) > f.pageSize
+	if truncated {
+		keys = keys[:f.pageSize]
+	}
+
+	var b strings.Builder
+	b.WriteString(`<ListBucketResult><Name>bucket</Name>`)
+	for _, key := range keys {
+		b.WriteString(`<Contents><Key>` + key + `</Key></Contents>`)
+	}
+	if truncated {
+		b.WriteString(`<IsTruncated>true</IsTruncated><NextContinuationToken>` + keys[len(keys)-1] +
+			`</NextContinuationToken>`)
+	}
+	b.WriteString(`</ListBucketResult>`)
+
+	_, _ = io.WriteString(w, b.String())
+}
+
+// newRepository returns a repository of the bucket served by the fake.
+func newRepository(t *testing.T, f *fakeS3) *Repository {
+	t.Helper()
+
+	srv := httptest.NewServer(f)
+	t.Cleanup(srv.Close)
+
+	client := s3.New(s3.Options{
+		Region:       "us-east-1",
+		BaseEndpoint: aws.String(srv.URL),
+		UsePathStyle: true,
+		Credentials:  credentials.NewStaticCredentialsProvider("test", "test", ""),
+	})
+
+	repo, ok := NewRepository(client, s3.NewPresignClient(client), "bucket", 15*time.Minute, zap.NewNop()).(*Repository)
+	require.True(t, ok)
+
+	return repo
+}
+
+func TestRepository(t *testing.T) {
+	ctx := context.Background()
+	f := &fakeS3{objects: map[string][]byte{}, headers: map[string]http.Header{}, pageSize: 2}
+	repo := newRepository(t, f)
+
+	content := []byte("png")
+	sum := sha256.Sum256(content)
+	checksum := hex.EncodeToString(sum[:])
+
+	keys := []string{"products/a/1.png", "products/a/2.png", "products/a/3.png", "products/b/1.png"}
+	for _, key := range keys {
+		require.NoError(t, repo.Put(ctx, key, "image/png", checksum, bytes.NewReader(content), int64(len(content))))
+	}
+	assert.Equal(t, content, f.objects[keys[0]])
+	assert.Equal(t, "image/png", f.headers[keys[0]].Get("Content-Type"))
+	assert.NotEmpty(t, f.headers[keys[0]].Get("X-Amz-Checksum-Sha256"), "S3 verifies the checksum")
+
+	require.Error(t, repo.Put(ctx, "invalid", "image/png", "not hex", bytes.NewReader(content), 3))
+
+	presigned, err := repo.PresignGet(ctx, keys[0])
+	require.NoError(t, err)
+	u, err := url.Parse(presigned)
+	require.NoError(t, err)
+	assert.Equal(t, "/bucket/"+keys[0], u.Path)
+	assert.Equal(t, "900", u.Query().Get("X-Amz-Expires"))
+
+	require.NoError(t, repo.Delete(ctx, keys[3]))
+	assert.NotContains(t, f.objects, keys[3])
+
+	require.NoError(t, repo.DeletePrefix(ctx, "products/a/"))
+	assert.Empty(t, f.objects, "objects of all pages are deleted")
+	assert.Equal(t, 2, f.deletes, "objects are deleted by pages")
+
+	require.NoError(t, repo.DeletePrefix(ctx, "products/missing/"), "nothing to delete")
+}
@@ -0,0 +1,156 @@
+package sqs_publisher
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/inventory"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/google/uuid"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// eventsDir - the catalog of events shared with consumers: a JSON schema and a golden payload of every event type.
+const eventsDir = "../../../../../events"
+
+// Values of golden payloads.
+var (
+	goldenProductID  = uuid.MustParse("7c9e6679-7425-40de-944b-e07fc1f90ae7")
+	goldenOccurredAt = time.Date(2026, 1, 2, 3, 4, 5, 123456789, time.UTC)
+)
+
+// TestContract_Publisher checks that every event of the catalog is published valid by its schema and equal to its
+// golden payload, and that no event out of the catalog is published.
+func TestContract_Publisher(t *testing.T) {
+	publish := map[string]func(r *Repository) error{
+		eventTypeCreateProduct: func(r *Repository) error {
+			return r.CreateToNotificationsService(context.Background(), goldenProductID)
+		},
+		eventTypeUpdateProduct: func(r *Repository) error {
+			return r.UpdateToNotificationsService(context.Background(), goldenProductID)
+		},
+		eventTypeDeleteProduct: func(r *Repository) error {
+			return r.DeleteToNotificationsService(context.Background(), goldenProductID)
+		},
+		eventTypeStockLow: func(r *Repository) error {
+			return r.StockAlertToNotificationsService(context.Background(), inventory.Alert{
+				Type: inventory.AlertStockLow, ProductID: goldenProductID, Warehouse: "kyiv-1", Available: 3, Threshold: 5,
+			})
+		},
+		eventTypeOutOfStock: func(r *Repository) error {
+			return r.StockAlertToNotificationsService(context.Background(), inventory.Alert{
+				Type: inventory.AlertOutOfStock, ProductID: goldenProductID, Warehouse: "kyiv-1", Threshold: 5,
+			})
+		},
+	}
+
+	schemas := loadSchemas(t)
+	require.ElementsMatch(t, slices.Collect(maps.Keys(schemas)), slices.Collect(maps.Keys(publish)),
+		"event types published must be the event types of the catalog")
+
+	for eventType, schema := range schemas {
+		t.Run(eventType, func(t *testing.T) {
+			queue := newFakeQueue(t)
+			require.NoError(t, publish[eventType](queue.repo))
+			require.Len(t, queue.sent, 1)
+
+			payload, err := jsonschema.UnmarshalJSON(strings.NewReader(queue.sent[0]))
+			require.NoError(t, err)
+			assert.NoError(t, schema.Validate(payload), queue.sent[0])
+
+			golden, err := os.ReadFile(filepath.Join(eventsDir, "golden", eventType+".json"))
+			require.NoError(t, err)
+			assert.JSONEq(t, string(golden), queue.sent[0])
+		})
+	}
+}
+
+// loadSchemas compiles schemas of the catalog by their event types.
+func loadSchemas(t *testing.T) map[string]*jsonschema.Schema {
+	t.Helper()
+
+	paths, err := filepath.Glob(filepath.Join(eventsDir, "schemas", "*.schema.json"))
+	require.NoError(t, err)
+	require.NotEmpty(t, paths, "the catalog of events is not found")
+
+	c := jsonschema.NewCompiler()
+	c.AssertFormat()
+
+	schemas := make(map[string]*jsonschema.Schema, len(paths))
+	for _, path := range paths {
+		schema, err := c.Compile(path)
+		require.NoError(t, err)
+		schemas[strings.TrimSuffix(filepath.Base(path), ".schema.json")] = schema
+	}
+
+	return schemas
+}
+
+// fakeQueue - SQS serving SendMessage of the AWS JSON protocol, it records bodies of sent messages.
+type fakeQueue struct {
+	repo *Repository
+
+	mu   sync.Mutex
+	sent []string
+}
+
+// newFakeQueue returns a queue with a repository publishing to it at the time of golden payloads.
+func newFakeQueue(t *testing.T) *fakeQueue {
+	t.Helper()
+
+	q := &fakeQueue{}
+	srv := httptest.NewServer(http.HandlerFunc(q.sendMessage))
+	t.Cleanup(srv.Close)
+
+	client := sqs.New(sqs.Options{
+		Region:       "us-east-1",
+		BaseEndpoint: aws.String(srv.URL),
+		Credentials:  aws.AnonymousCredentials{},
+	})
+
+	q.repo = &Repository{
+		client:       client,
+		baseQueueURL: srv.URL + "/000000000000/test-queue",
+		logger:       zap.NewNop(),
+		now:          func() time.Time { return goldenOccurredAt },
+	}
+
+	return q
+}
+
+func (q *fakeQueue) sendMessage(w http.ResponseWriter, r *http.Request) {
+	var in struct {
+		MessageBody string
+	}
+	if r.Header.Get("X-Amz-Target") != "AmazonSQS.SendMessage" || json.NewDecoder(r.Body).Decode(&in) != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	q.mu.Lock()
+	q.sent = append(q.sent, in.MessageBody)
+	q.mu.Unlock()
+
+	sum := md5.Sum([]byte(in.MessageBody))
+	w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"MessageId":        uuid.NewString(),
+		"MD5OfMessageBody": hex.EncodeToString(sum[:]),
+	})
+}
@@ -1,15 +1,29 @@
 package sqs_publisher
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/inventory"
+	"github.com/google/uuid"
+)
 
 // Event types for notifications services.
 const (
 	eventTypeCreateProduct = "create_product"
+	eventTypeUpdateProduct = "update_product"
 	eventTypeDeleteProduct = "delete_product"
+	eventTypeStockLow      = inventory.AlertStockLow
+	eventTypeOutOfStock    = inventory.AlertOutOfStock
 )
 
 // message - message for SQS.
 type message struct {
-	EventType string    `json:"event_type"`
-	ProductID uuid.UUID `json:"product_id"`
+	EventType  string    `json:"event_type"`
+	ProductID  uuid.UUID `json:"product_id"`
+	OccurredAt time.Time `json:"occurred_at"` // time the event is published at, consumers measure their lag by it
+
+	// stock alerts only
+	Warehouse string `json:"warehouse,omitempty"`
+	Available *int64 `json:"available,omitempty"`
+	Threshold *int64 `json:"threshold,omitempty"`
 }
@@ -2,9 +2,11 @@ package sqs_publisher
 
 import (
 	"context"
+	"time"
 
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/inventory"
 	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
-	"github.com/at-kh/guru-apps-test-services/products-service/pkg/errs"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/goccy/go-json"
@@ -19,6 +21,7 @@ type Repository struct {
 	client       *sqs.Client
 	baseQueueURL string
 	logger       *zap.Logger
+	now          func() time.Time // time events are published at
 }
 
 // NewRepository creates a new repositories.
@@ -27,11 +30,14 @@ func NewRepository(client *sqs.Client, baseQueueURL string, logger *zap.Logger)
 		client:       client,
 		baseQueueURL: baseQueueURL,
 		logger:       logger.With(zap.String("repositories", "sqs_publisher")),
+		now:          time.Now,
 	}
 }
 
 // sendEvent sends an event to SQS.
 func (r Repository) sendEvent(ctx context.Context, msg message) error {
+	msg.OccurredAt = r.now().UTC()
+
 	data, err := json.Marshal(msg)
 	if err != nil {
 		return errs.Internal{Cause: "failed to marshal SQS event: " + err.Error()}
@@ -42,7 +48,9 @@ func (r Repository) sendEvent(ctx context.Context, msg message) error {
 		MessageBody: aws.String(string(data)),
 	}
 
-	if _, err = r.client.SendMessage(ctx, input); err != nil {
+	// connections of the HTTP client read values of ctx after the request, ctx of fasthttp handlers is reused
+	// by next requests, so it is detached from them
+	if _, err = r.client.SendMessage(context.WithoutCancel(ctx), input); err != nil {
 		return errs.Internal{Cause: "failed to send message to SQS: " + err.Error()}
 	}
 
@@ -62,6 +70,14 @@ func (r Repository) CreateToNotificationsService(ctx context.Context, id uuid.UU
 	})
 }
 
+// UpdateToNotificationsService - send event "updated product".
+func (r Repository) UpdateToNotificationsService(ctx context.Context, id uuid.UUID) error {
+	return r.sendEvent(ctx, message{
+		EventType: eventTypeUpdateProduct,
+		ProductID: id,
+	})
+}
+
 // DeleteToNotificationsService - send event "deleted product".
 func (r Repository) DeleteToNotificationsService(ctx context.Context, id uuid.UUID) error {
 	return r.sendEvent(ctx, message{
@@ -69,3 +85,19 @@ func (r Repository) DeleteToNotificationsService(ctx context.Context, id uuid.UU
 		ProductID: id,
 	})
 }
+
+// StockAlertToNotificationsService - send event "stock is low" or "out of stock".
+func (r Repository) StockAlertToNotificationsService(ctx context.Context, alert inventory.Alert) error {
+	eventType := eventTypeStockLow
+	if alert.Type == inventory.AlertOutOfStock {
+		eventType = eventTypeOutOfStock
+	}
+
+	return r.sendEvent(ctx, message{
+		EventType: eventType,
+		ProductID: alert.ProductID,
+		Warehouse: alert.Warehouse,
+		Available: &alert.Available,
+		Threshold: &alert.Threshold,
+	})
+}
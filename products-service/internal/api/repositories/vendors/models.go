@@ -0,0 +1,28 @@
+package vendors
+
+import (
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/vendors"
+	"github.com/google/uuid"
+)
+
+type (
+	// dbVendor - defines a vendor in the database.
+	dbVendor struct {
+		ID        uuid.UUID `db:"id"`
+		Name      string    `db:"name"`
+		CreatedAt time.Time `db:"created_at"`
+		UpdatedAt time.Time `db:"updated_at"`
+	}
+)
+
+// toDomain converts dbVendor -> Vendor
+func (d dbVendor) toDomain() vendors.Vendor {
+	return vendors.Vendor{
+		ID:        d.ID,
+		Name:      d.Name,
+		CreatedAt: d.CreatedAt,
+		UpdatedAt: d.UpdatedAt,
+	}
+}
@@ -0,0 +1,169 @@
+package vendors
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/vendors"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/pgerrs"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+var _ repositories.VendorsRepository = &Repository{}
+
+type (
+	// Repository - defines a repositories.
+	Repository struct {
+		db     sqlx.ExtContext
+		logger *zap.Logger
+	}
+)
+
+// NewRepository creates a new repositories.
+func NewRepository(db sqlx.ExtContext, logger *zap.Logger) repositories.VendorsRepository {
+	return &Repository{db: db, logger: logger.With(zap.String("repositories", "vendors"))}
+}
+
+// Create creates a new vendor.
+func (r *Repository) Create(ctx context.Context, e vendors.Vendor) (vendors.Vendor, error) {
+	if ctx.Err() != nil {
+		return vendors.Vendor{}, ctx.Err()
+	}
+
+	query := `
+	INSERT INTO vendors (name)
+	VALUES (trim($1))
+	RETURNING id, name, created_at, updated_at;
+	`
+
+	var dbv dbVendor
+	if err := sqlx.GetContext(ctx, r.db, &dbv, query, e.Name); err != nil {
+		return vendors.Vendor{}, mapError(err)
+	}
+
+	return dbv.toDomain(), nil
+}
+
+// GetAll returns vendors ordered by name with pagination using limit and offset.
+func (r *Repository) GetAll(ctx context.Context, limit, offset uint64) (vendors.VendorList, error) {
+	if ctx.Err() != nil {
+		return vendors.VendorList{}, ctx.Err()
+	}
+
+	if limit == 0 {
+		limit = products.DefaultLimit
+	}
+
+	query := `
+	SELECT id, name, created_at, updated_at
+	FROM vendors
+	ORDER BY normalized_name
+	LIMIT $1 OFFSET $2;
+	`
+
+	var dbItems []dbVendor
+	if err := sqlx.SelectContext(ctx, r.db, &dbItems, query, limit, offset); err != nil {
+		return vendors.VendorList{}, pgerrs.Map(err)
+	}
+
+	items := make(vendors.Vendors, len(dbItems))
+	for i, dbv := range dbItems {
+		items[i] = dbv.toDomain()
+	}
+
+	// get total count of entities
+	var total uint64
+	if err := sqlx.GetContext(ctx, r.db, &total, "SELECT COUNT(*) FROM vendors"); err != nil {
+		return vendors.VendorList{}, pgerrs.Map(err)
+	}
+
+	return vendors.VendorList{
+		Total:   total,
+		Vendors: items,
+	}, nil
+}
+
+// GetByID returns a vendor by id.
+func (r *Repository) GetByID(ctx context.Context, id uuid.UUID) (vendors.Vendor, error) {
+	if ctx.Err() != nil {
+		return vendors.Vendor{}, ctx.Err()
+	}
+
+	query := `SELECT id, name, created_at, updated_at FROM vendors WHERE id = $1;`
+
+	var dbv dbVendor
+	if err := sqlx.GetContext(ctx, r.db, &dbv, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return vendors.Vendor{}, errs.NotFound{What: "vendor"}
+		}
+
+		return vendors.Vendor{}, pgerrs.Map(err)
+	}
+
+	return dbv.toDomain(), nil
+}
+
+// Update renames a vendor.
+func (r *Repository) Update(ctx context.Context, e vendors.Vendor) (vendors.Vendor, error) {
+	if ctx.Err() != nil {
+		return vendors.Vendor{}, ctx.Err()
+	}
+
+	query := `
+	UPDATE vendors
+	SET name = trim($2)
+	WHERE id = $1
+	RETURNING id, name, created_at, updated_at;
+	`
+
+	var dbv dbVendor
+	if err := sqlx.GetContext(ctx, r.db, &dbv, query, e.ID, e.Name); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return vendors.Vendor{}, errs.NotFound{What: "vendor"}
+		}
+
+		return vendors.Vendor{}, mapError(err)
+	}
+
+	return dbv.toDomain(), nil
+}
+
+// Delete removes a vendor without products.
+func (r *Repository) Delete(ctx context.Context, id uuid.UUID) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	res, err := r.db.ExecContext(ctx, `DELETE FROM vendors WHERE id = $1`, id)
+	if err != nil {
+		return mapError(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return pgerrs.Map(err)
+	}
+	if affected == 0 {
+		return errs.NotFound{What: "vendor"}
+	}
+
+	return nil
+}
+
+// mapError maps database errors of vendors to application errors.
+func mapError(err error) error {
+	switch pgerrs.Constraint(err) {
+	case "uq_vendors_normalized_name":
+		return errs.AlreadyExists{What: "vendor already exists"}
+	case "fk_products_vendor":
+		return errs.Conflict{What: "vendor has products"}
+	}
+
+	return pgerrs.Map(err)
+}
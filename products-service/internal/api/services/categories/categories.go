@@ -0,0 +1,192 @@
+package categories
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/categories"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
+	repocategories "github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/categories"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/services"
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/pgerrs"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+var _ services.CategoriesService = &Service{}
+
+// Service - defines services struct.
+type Service struct {
+	db                      *sqlx.DB
+	categoriesRepository    repositories.CategoriesRepository
+	productsCacheRepository repositories.ProductsCacheRepository
+	logger                  *zap.Logger
+}
+
+// NewService constructor, productsCacheRepository is nil when product reads are not cached.
+func NewService(
+	db *sqlx.DB,
+	categoriesRepository repositories.CategoriesRepository,
+	productsCacheRepository repositories.ProductsCacheRepository,
+	logger *zap.Logger,
+) *Service {
+	return &Service{
+		db:                      db,
+		categoriesRepository:    categoriesRepository,
+		productsCacheRepository: productsCacheRepository,
+		logger:                  logger.With(zap.String("services", "categories")),
+	}
+}
+
+// Create creates a new category.
+func (s Service) Create(ctx context.Context, c categories.Category) (categories.Category, error) {
+	category, err := s.categoriesRepository.Create(ctx, c)
+	if err != nil {
+		s.logger.Error("failed to create category", zap.Error(err), zap.String("name", c.Name))
+		return categories.Category{}, err
+	}
+
+	return category, nil
+}
+
+// GetAll returns all categories.
+func (s Service) GetAll(ctx context.Context) (categories.Categories, error) {
+	list, err := s.categoriesRepository.GetAll(ctx)
+	if err != nil {
+		s.logger.Error("failed to get all categories", zap.Error(err))
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// GetByID returns a category by id.
+func (s Service) GetByID(ctx context.Context, id uuid.UUID) (categories.Category, error) {
+	category, err := s.categoriesRepository.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("failed to get category", zap.Error(err), zap.String("id", id.String()))
+		return categories.Category{}, err
+	}
+
+	return category, nil
+}
+
+// Update updates a category, the transaction is retried on serialization failures and deadlocks.
+// Cached products are invalidated as moves change products filtered by ancestors of the category.
+func (s Service) Update(ctx context.Context, c categories.Category) (category categories.Category, err error) {
+	err = pgerrs.Retry(ctx, func() (txErr error) {
+		category, txErr = s.update(ctx, c)
+		return txErr
+	})
+	if err != nil {
+		return categories.Category{}, err
+	}
+
+	s.invalidateCache(ctx)
+
+	return category, nil
+}
+
+// update updates a category in a transaction, so its move is checked against moves of other categories.
+func (s Service) update(ctx context.Context, c categories.Category) (category categories.Category, err error) {
+	if ctx.Err() != nil {
+		return categories.Category{}, ctx.Err()
+	}
+
+	tx, err := s.db.BeginTxx(ctx, &sql.TxOptions{})
+	if err != nil {
+		s.logger.Error("failed to begin transaction", zap.Error(err))
+		return categories.Category{}, err
+	}
+	defer func() {
+		if pp := recover(); pp != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				s.logger.Error("failed to rollback transaction after panic", zap.Error(rollbackErr))
+			}
+			panic(pp)
+		}
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				s.logger.Error("failed to rollback transaction", zap.Error(rollbackErr))
+			}
+		}
+	}()
+
+	txRepo := repocategories.NewRepository(tx, s.logger)
+	category, err = txRepo.Update(ctx, c)
+	if err != nil {
+		s.logger.Error("failed to update category", zap.Error(err), zap.String("id", c.ID.String()))
+		return categories.Category{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("failed to commit transaction", zap.Error(err))
+		return categories.Category{}, err
+	}
+
+	return category, nil
+}
+
+// Delete removes a category, products and subcategories are moved to reassignTo when it is set.
+// The transaction is retried on serialization failures and deadlocks, cached products are invalidated
+// as they lose the category.
+func (s Service) Delete(ctx context.Context, id uuid.UUID, reassignTo *uuid.UUID) error {
+	if err := pgerrs.Retry(ctx, func() error { return s.delete(ctx, id, reassignTo) }); err != nil {
+		return err
+	}
+
+	s.invalidateCache(ctx)
+
+	return nil
+}
+
+// delete removes a category in a transaction.
+func (s Service) delete(ctx context.Context, id uuid.UUID, reassignTo *uuid.UUID) (err error) {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	tx, err := s.db.BeginTxx(ctx, &sql.TxOptions{})
+	if err != nil {
+		s.logger.Error("failed to begin transaction", zap.Error(err))
+		return err
+	}
+	defer func() {
+		if pp := recover(); pp != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				s.logger.Error("failed to rollback transaction after panic", zap.Error(rollbackErr))
+			}
+			panic(pp)
+		}
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				s.logger.Error("failed to rollback transaction", zap.Error(rollbackErr))
+			}
+		}
+	}()
+
+	txRepo := repocategories.NewRepository(tx, s.logger)
+	if err = txRepo.Delete(ctx, id, reassignTo); err != nil {
+		s.logger.Error("failed to delete category", zap.Error(err), zap.String("id", id.String()))
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("failed to commit transaction", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// invalidateCache invalidates all cached product reads, failures are logged only, cached reads expire anyway.
+func (s Service) invalidateCache(ctx context.Context) {
+	if s.productsCacheRepository == nil {
+		return
+	}
+
+	if err := s.productsCacheRepository.InvalidateAll(ctx); err != nil {
+		s.logger.Error("failed to invalidate cached products", zap.Error(err))
+	}
+}
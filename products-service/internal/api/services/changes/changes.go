@@ -0,0 +1,76 @@
+package changes
+
+import (
+	"context"
+	"errors"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/changes"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/services"
+	"go.uber.org/zap"
+)
+
+var _ services.ChangesService = &Service{}
+
+// Service - defines services struct.
+type Service struct {
+	changesRepository  repositories.ProductChangesRepository
+	productsRepository repositories.ProductsRepository
+	logger             *zap.Logger
+}
+
+// NewService constructor, productsRepository must read committed products without caches or replicas, so
+// products of changes are not older than the changes.
+func NewService(
+	changesRepository repositories.ProductChangesRepository,
+	productsRepository repositories.ProductsRepository,
+	logger *zap.Logger,
+) *Service {
+	return &Service{
+		changesRepository:  changesRepository,
+		productsRepository: productsRepository,
+		logger:             logger.With(zap.String("services", "changes")),
+	}
+}
+
+// Latest returns a position changes committed from now on are after.
+func (s Service) Latest(ctx context.Context) (changes.Position, error) {
+	position, err := s.changesRepository.Latest(ctx)
+	if err != nil {
+		s.logger.Error("failed to get latest position of changes", zap.Error(err))
+		return changes.Position{}, err
+	}
+
+	return position, nil
+}
+
+// GetAfter returns up to changes.MaxBatch changes after a position with current states of created and updated
+// products.
+func (s Service) GetAfter(ctx context.Context, after changes.Position) (changes.Changes, error) {
+	items, err := s.changesRepository.GetAfter(ctx, after, changes.MaxBatch)
+	if err != nil {
+		s.logger.Error("failed to get changes", zap.Error(err), zap.Stringer("after", after))
+		return nil, err
+	}
+
+	for i := range items {
+		if items[i].Type == changes.TypeDelete {
+			continue
+		}
+
+		p, err := s.productsRepository.GetByID(ctx, items[i].ProductID)
+		if errors.As(err, &errs.NotFound{}) {
+			continue
+		}
+		if err != nil {
+			s.logger.Error("failed to get changed product", zap.Error(err),
+				zap.String("id", items[i].ProductID.String()))
+			return nil, err
+		}
+
+		items[i].Product = &p
+	}
+
+	return items, nil
+}
@@ -0,0 +1,53 @@
+package currencies
+
+import (
+	"context"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/currencies"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/services"
+	"go.uber.org/zap"
+)
+
+var _ services.CurrenciesService = &Service{}
+
+// Service - defines services struct.
+type Service struct {
+	currencyRatesRepository repositories.CurrencyRatesRepository
+	logger                  *zap.Logger
+}
+
+// NewService constructor.
+func NewService(currencyRatesRepository repositories.CurrencyRatesRepository, logger *zap.Logger) *Service {
+	return &Service{
+		currencyRatesRepository: currencyRatesRepository,
+		logger:                  logger.With(zap.String("services", "currencies")),
+	}
+}
+
+// GetRates returns all currency rates.
+func (s Service) GetRates(ctx context.Context) (currencies.Rates, error) {
+	rates, err := s.currencyRatesRepository.GetAll(ctx)
+	if err != nil {
+		s.logger.Error("failed to get currency rates", zap.Error(err))
+		return nil, err
+	}
+
+	return rates, nil
+}
+
+// SetRate creates or updates a currency rate, the rate of the base currency can't be changed.
+func (s Service) SetRate(ctx context.Context, r currencies.Rate) (currencies.Rate, error) {
+	if r.Currency == currencies.Base {
+		return currencies.Rate{}, errs.Conflict{What: "rate of the base currency " + currencies.Base + " is fixed"}
+	}
+
+	rate, err := s.currencyRatesRepository.Upsert(ctx, r)
+	if err != nil {
+		s.logger.Error("failed to set currency rate", zap.Error(err), zap.String("currency", r.Currency))
+		return currencies.Rate{}, err
+	}
+
+	return rate, nil
+}
@@ -0,0 +1,116 @@
+package events
+
+import (
+	"context"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/events"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/services"
+	"go.uber.org/zap"
+)
+
+var _ services.EventsService = &Service{}
+
+// pageSize - number of products read by a query when events of a range are published.
+const pageSize = products.MaxLimit
+
+// Service - defines services struct.
+type Service struct {
+	productsRepository     repositories.ProductsRepository
+	sqsPublisherRepository repositories.SQSPublisherRepository
+	logger                 *zap.Logger
+}
+
+// NewService constructor, products are read from the primary database, as events must be published
+// by the stored state of products rather than by cached or replicated one.
+func NewService(
+	productsRepository repositories.ProductsRepository,
+	sqsPublisherRepository repositories.SQSPublisherRepository,
+	logger *zap.Logger,
+) *Service {
+	return &Service{
+		productsRepository:     productsRepository,
+		sqsPublisherRepository: sqsPublisherRepository,
+		logger:                 logger.With(zap.String("services", "events")),
+	}
+}
+
+// Republish publishes again events of a product or of products changed within a range by their stored state.
+// Publishing stops on the first failure, events published before it are not revoked.
+func (s Service) Republish(ctx context.Context, e events.Republish) (events.RepublishResult, error) {
+	if e.ProductID == nil && e.From.IsZero() {
+		return events.RepublishResult{}, errs.FieldsValidation{Errors: []string{"from::is_required"}}
+	}
+	if !e.From.IsZero() && !e.To.IsZero() && !e.To.After(e.From) {
+		return events.RepublishResult{}, errs.FieldsValidation{Errors: []string{"to::invalid_value"}}
+	}
+
+	var result events.RepublishResult
+	publish := func(items products.Products) error {
+		for _, p := range items {
+			result.Products++
+
+			if e.Within(p.CreatedAt) {
+				if err := s.sqsPublisherRepository.CreateToNotificationsService(ctx, p.ID); err != nil {
+					return err
+				}
+				result.Created++
+			}
+
+			if p.UpdatedAt.After(p.CreatedAt) && e.Within(p.UpdatedAt) {
+				if err := s.sqsPublisherRepository.UpdateToNotificationsService(ctx, p.ID); err != nil {
+					return err
+				}
+				result.Updated++
+			}
+		}
+
+		return nil
+	}
+
+	err := s.forEachPage(ctx, e, publish)
+	if err != nil {
+		s.logger.Error("failed to republish events", zap.Error(err), zap.Int("products", result.Products),
+			zap.Int("created", result.Created), zap.Int("updated", result.Updated))
+		return events.RepublishResult{}, err
+	}
+
+	s.logger.Info("events republished", zap.Int("products", result.Products),
+		zap.Int("created", result.Created), zap.Int("updated", result.Updated))
+
+	return result, nil
+}
+
+// forEachPage calls fn for the product of the request or for pages of products changed within its range.
+func (s Service) forEachPage(ctx context.Context, e events.Republish, fn func(items products.Products) error) error {
+	if e.ProductID != nil {
+		p, err := s.productsRepository.GetByID(ctx, *e.ProductID)
+		if err != nil {
+			return err
+		}
+
+		return fn(products.Products{p})
+	}
+
+	// pages are read by keys, so products created meanwhile don't shift pages and republish products twice
+	filter := products.Filter{ChangedFrom: e.From, ChangedTo: e.To}
+	for {
+		list, err := s.productsRepository.GetAll(ctx, pageSize, 0, filter)
+		if err != nil {
+			return err
+		}
+
+		if err = fn(list.Products); err != nil {
+			return err
+		}
+
+		if len(list.Products) < pageSize {
+			return nil
+		}
+
+		last := list.Products[len(list.Products)-1].Key()
+		filter.After = &last
+	}
+}
@@ -0,0 +1,197 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/events"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/inventory"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var t0 = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestService_Republish(t *testing.T) {
+	created := products.Product{ID: uuid.New(), CreatedAt: t0, UpdatedAt: t0}
+	updated := products.Product{ID: uuid.New(), CreatedAt: t0, UpdatedAt: t0.Add(2 * time.Hour)}
+	product := func(id uuid.UUID) *uuid.UUID { return &id }
+
+	tests := []struct {
+		name       string
+		items      products.Products
+		req        events.Republish
+		want       events.RepublishResult
+		wantEvents []string
+		wantErr    error
+	}{
+		{
+			name:       "created product",
+			items:      products.Products{created},
+			req:        events.Republish{ProductID: product(created.ID)},
+			want:       events.RepublishResult{Products: 1, Created: 1},
+			wantEvents: []string{"create_product " + created.ID.String()},
+		},
+		{
+			name:  "updated product",
+			items: products.Products{updated},
+			req:   events.Republish{ProductID: product(updated.ID)},
+			want:  events.RepublishResult{Products: 1, Created: 1, Updated: 1},
+			wantEvents: []string{
+				"create_product " + updated.ID.String(),
+				"update_product " + updated.ID.String(),
+			},
+		},
+		{
+			name:       "updates within range",
+			items:      products.Products{created, updated},
+			req:        events.Republish{From: t0.Add(time.Hour), To: t0.Add(3 * time.Hour)},
+			want:       events.RepublishResult{Products: 1, Updated: 1},
+			wantEvents: []string{"update_product " + updated.ID.String()},
+		},
+		{
+			name:    "product not found",
+			req:     events.Republish{ProductID: product(uuid.New())},
+			wantErr: errs.NotFound{What: "product"},
+		},
+		{
+			name:    "neither product nor range",
+			wantErr: errs.FieldsValidation{Errors: []string{"from::is_required"}},
+		},
+		{
+			name:    "empty range",
+			req:     events.Republish{From: t0, To: t0},
+			wantErr: errs.FieldsValidation{Errors: []string{"to::invalid_value"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			publisher := &publisherRecorder{}
+			s := NewService(&productsStub{items: tt.items}, publisher, zap.NewNop())
+
+			got, err := s.Republish(context.Background(), tt.req)
+			if tt.wantErr != nil {
+				require.Equal(t, tt.wantErr, err)
+				assert.Empty(t, publisher.events)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantEvents, publisher.events)
+		})
+	}
+}
+
+// TestService_RepublishPages tests that events of all products of a range are published page by page once,
+// although products are created meanwhile.
+func TestService_RepublishPages(t *testing.T) {
+	items := make(products.Products, 2*pageSize+1)
+	for i := range items {
+		items[i] = products.Product{ID: uuid.New(), CreatedAt: t0, UpdatedAt: t0}
+	}
+
+	publisher := &publisherRecorder{}
+	repo := &productsStub{items: items, onGetAll: func(r *productsStub) {
+		now := t0.Add(time.Second)
+		r.items = append(r.items, products.Product{ID: uuid.New(), CreatedAt: now, UpdatedAt: now})
+	}}
+	s := NewService(repo, publisher, zap.NewNop())
+
+	got, err := s.Republish(context.Background(), events.Republish{From: t0, To: t0.Add(time.Minute)})
+	require.NoError(t, err)
+	assert.Equal(t, events.RepublishResult{Products: len(items), Created: len(items)}, got)
+
+	published := slices.Clone(publisher.events)
+	slices.Sort(published)
+	assert.Len(t, slices.Compact(published), len(items), "every product is published once")
+}
+
+// productsStub - products repository reading stored products, the range of the filter is applied to creations
+// and updates and lists are ordered as by the database, onGetAll is called after every list when it is set.
+type productsStub struct {
+	repositories.ProductsRepository
+
+	items    products.Products
+	onGetAll func(r *productsStub)
+}
+
+func (r *productsStub) GetByID(_ context.Context, id uuid.UUID) (products.Product, error) {
+	i := slices.IndexFunc(r.items, func(p products.Product) bool { return p.ID == id })
+	if i < 0 {
+		return products.Product{}, errs.NotFound{What: "product"}
+	}
+
+	return r.items[i], nil
+}
+
+func (r *productsStub) GetAll(
+	_ context.Context,
+	limit, offset uint64,
+	filter products.Filter,
+) (products.ProductList, error) {
+	if r.onGetAll != nil {
+		defer r.onGetAll(r)
+	}
+
+	within := events.Republish{From: filter.ChangedFrom, To: filter.ChangedTo}
+
+	var found products.Products
+	for _, p := range r.items {
+		if (within.Within(p.CreatedAt) || within.Within(p.UpdatedAt)) &&
+			(filter.After == nil || compareKeys(p.Key(), *filter.After) > 0) {
+			found = append(found, p)
+		}
+	}
+	slices.SortFunc(found, func(a, b products.Product) int { return compareKeys(a.Key(), b.Key()) })
+
+	total := uint64(len(found))
+
+	return products.ProductList{
+		Total:    total,
+		Products: found[min(offset, total):min(offset+limit, total)],
+	}, nil
+}
+
+// compareKeys compares positions of products in lists, newest first.
+func compareKeys(a, b products.Key) int {
+	if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+		return c
+	}
+
+	return bytes.Compare(b.ID[:], a.ID[:])
+}
+
+// publisherRecorder - publisher recording events as "<event type> <product id>".
+type publisherRecorder struct {
+	events []string
+}
+
+func (p *publisherRecorder) CreateToNotificationsService(_ context.Context, id uuid.UUID) error {
+	p.events = append(p.events, "create_product "+id.String())
+	return nil
+}
+
+func (p *publisherRecorder) UpdateToNotificationsService(_ context.Context, id uuid.UUID) error {
+	p.events = append(p.events, "update_product "+id.String())
+	return nil
+}
+
+func (p *publisherRecorder) DeleteToNotificationsService(_ context.Context, id uuid.UUID) error {
+	p.events = append(p.events, "delete_product "+id.String())
+	return nil
+}
+
+func (p *publisherRecorder) StockAlertToNotificationsService(_ context.Context, alert inventory.Alert) error {
+	p.events = append(p.events, alert.Type+" "+alert.ProductID.String())
+	return nil
+}
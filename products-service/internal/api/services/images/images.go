@@ -0,0 +1,122 @@
+package images
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/images"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/services"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ services.ImagesService = &Service{}
+
+// Service - defines services struct.
+type Service struct {
+	productsRepository      repositories.ProductsRepository
+	imagesRepository        repositories.ImagesRepository
+	objectStorageRepository repositories.ObjectStorageRepository
+	logger                  *zap.Logger
+}
+
+// NewService constructor.
+func NewService(
+	productsRepository repositories.ProductsRepository,
+	imagesRepository repositories.ImagesRepository,
+	objectStorageRepository repositories.ObjectStorageRepository,
+	logger *zap.Logger,
+) *Service {
+	return &Service{
+		productsRepository:      productsRepository,
+		imagesRepository:        imagesRepository,
+		objectStorageRepository: objectStorageRepository,
+		logger:                  logger.With(zap.String("services", "images")),
+	}
+}
+
+// Upload stores an image of a product in the object storage and its metadata in the database.
+func (s Service) Upload(ctx context.Context, img images.Image, content []byte) (images.Image, error) {
+	if _, err := s.productsRepository.GetByID(ctx, img.ProductID); err != nil {
+		return images.Image{}, err
+	}
+
+	sum := sha256.Sum256(content)
+	img.ID = uuid.New()
+	img.Key = images.Key(img.ProductID, img.ID, img.ContentType)
+	img.Size = int64(len(content))
+	img.Checksum = hex.EncodeToString(sum[:])
+
+	err := s.objectStorageRepository.Put(ctx, img.Key, img.ContentType, img.Checksum, bytes.NewReader(content), img.Size)
+	if err != nil {
+		s.logger.Error("failed to upload image", zap.Error(err),
+			zap.String("product_id", img.ProductID.String()),
+			zap.String("key", img.Key))
+		return images.Image{}, err
+	}
+
+	image, err := s.imagesRepository.Create(ctx, img)
+	if err != nil {
+		s.logger.Error("failed to create image", zap.Error(err),
+			zap.String("product_id", img.ProductID.String()),
+			zap.String("key", img.Key))
+
+		// the object is useless without metadata
+		if delErr := s.objectStorageRepository.Delete(ctx, img.Key); delErr != nil {
+			s.logger.Warn("uploaded image is not removed from storage", zap.Error(delErr),
+				zap.String("key", img.Key))
+		}
+
+		return images.Image{}, err
+	}
+
+	if image.URL, err = s.objectStorageRepository.PresignGet(ctx, image.Key); err != nil {
+		s.logger.Error("failed to presign image", zap.Error(err), zap.String("key", image.Key))
+		return images.Image{}, err
+	}
+
+	return image, nil
+}
+
+// GetByProductID returns images of a product with presigned URLs.
+func (s Service) GetByProductID(ctx context.Context, productID uuid.UUID) (images.Images, error) {
+	if _, err := s.productsRepository.GetByID(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	list, err := s.imagesRepository.GetByProductIDs(ctx, []uuid.UUID{productID})
+	if err != nil {
+		s.logger.Error("failed to get images", zap.Error(err), zap.String("product_id", productID.String()))
+		return nil, err
+	}
+
+	for i := range list {
+		if list[i].URL, err = s.objectStorageRepository.PresignGet(ctx, list[i].Key); err != nil {
+			s.logger.Error("failed to presign image", zap.Error(err), zap.String("key", list[i].Key))
+			return nil, err
+		}
+	}
+
+	return list, nil
+}
+
+// Delete removes an image of a product.
+func (s Service) Delete(ctx context.Context, productID, id uuid.UUID) error {
+	image, err := s.imagesRepository.Delete(ctx, productID, id)
+	if err != nil {
+		s.logger.Error("failed to delete image", zap.Error(err),
+			zap.String("product_id", productID.String()),
+			zap.String("id", id.String()))
+		return err
+	}
+
+	if err = s.objectStorageRepository.Delete(ctx, image.Key); err != nil {
+		s.logger.Warn("image deleted but not removed from storage", zap.Error(err),
+			zap.String("key", image.Key))
+	}
+
+	return nil
+}
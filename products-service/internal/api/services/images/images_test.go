@@ -0,0 +1,90 @@
+package images
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/images"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/memory_images"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/memory_products"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/memory_storage"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// failingImages - a repository of images failing to store metadata.
+type failingImages struct{ *memory_images.Repository }
+
+func (failingImages) Create(context.Context, images.Image) (images.Image, error) {
+	return images.Image{}, errs.Internal{Cause: "failed"}
+}
+
+// newProduct creates a product in a memory repository.
+func newProduct(t *testing.T, repo *memory_products.Repository) products.Product {
+	t.Helper()
+
+	p, err := repo.Create(context.Background(),
+		products.Product{Name: "Keyboard", Vendor: "Acme", Price: decimal.New(1, 0)})
+	require.NoError(t, err)
+
+	return p
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+	productsRepo := memory_products.NewRepository(zap.NewNop())
+	storage := memory_storage.NewRepository(zap.NewNop())
+	s := NewService(productsRepo, memory_images.NewRepository(zap.NewNop()), storage, zap.NewNop())
+
+	product := newProduct(t, productsRepo)
+	content := []byte("\x89PNG\r\n\x1a\n")
+
+	uploaded, err := s.Upload(ctx, images.Image{ProductID: product.ID, ContentType: "image/png"}, content)
+	require.NoError(t, err)
+	sum := sha256.Sum256(content)
+	assert.Equal(t, hex.EncodeToString(sum[:]), uploaded.Checksum)
+	assert.Equal(t, int64(len(content)), uploaded.Size)
+	assert.Equal(t, images.ProductPrefix(product.ID)+uploaded.ID.String()+".png", uploaded.Key)
+	assert.Equal(t, "memory:///"+uploaded.Key, uploaded.URL)
+	assert.Equal(t, []string{uploaded.Key}, storage.Keys())
+
+	list, err := s.GetByProductID(ctx, product.ID)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, uploaded.ID, list[0].ID)
+	assert.Equal(t, uploaded.URL, list[0].URL, "listed images have presigned URLs")
+
+	require.ErrorAs(t, s.Delete(ctx, uuid.New(), uploaded.ID), &errs.NotFound{}, "images of other products")
+	require.NoError(t, s.Delete(ctx, product.ID, uploaded.ID))
+	assert.Empty(t, storage.Keys())
+
+	list, err = s.GetByProductID(ctx, product.ID)
+	require.NoError(t, err)
+	assert.Empty(t, list)
+
+	_, err = s.Upload(ctx, images.Image{ProductID: uuid.New(), ContentType: "image/png"}, content)
+	require.ErrorAs(t, err, &errs.NotFound{})
+
+	_, err = s.GetByProductID(ctx, uuid.New())
+	require.ErrorAs(t, err, &errs.NotFound{})
+}
+
+func TestService_UploadFailedMetadata(t *testing.T) {
+	ctx := context.Background()
+	productsRepo := memory_products.NewRepository(zap.NewNop())
+	storage := memory_storage.NewRepository(zap.NewNop())
+	s := NewService(productsRepo, failingImages{memory_images.NewRepository(zap.NewNop())}, storage, zap.NewNop())
+
+	product := newProduct(t, productsRepo)
+
+	_, err := s.Upload(ctx, images.Image{ProductID: product.ID, ContentType: "image/png"}, []byte("png"))
+	require.ErrorAs(t, err, &errs.Internal{})
+	assert.Empty(t, storage.Keys(), "objects without metadata are removed")
+}
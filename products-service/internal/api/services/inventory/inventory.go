@@ -0,0 +1,211 @@
+package inventory
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/inventory"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
+	repoinventory "github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/inventory"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/services"
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/pgerrs"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+var _ services.InventoryService = &Service{}
+
+// Service - defines services struct.
+type Service struct {
+	db                     *sqlx.DB
+	productsRepository     repositories.ProductsRepository
+	inventoryRepository    repositories.InventoryRepository
+	sqsPublisherRepository repositories.SQSPublisherRepository
+	logger                 *zap.Logger
+}
+
+// NewService constructor.
+func NewService(
+	db *sqlx.DB,
+	productsRepository repositories.ProductsRepository,
+	inventoryRepository repositories.InventoryRepository,
+	sqsPublisherRepository repositories.SQSPublisherRepository,
+	logger *zap.Logger,
+) *Service {
+	return &Service{
+		db:                     db,
+		productsRepository:     productsRepository,
+		inventoryRepository:    inventoryRepository,
+		sqsPublisherRepository: sqsPublisherRepository,
+		logger:                 logger.With(zap.String("services", "inventory")),
+	}
+}
+
+// GetByProductID returns stock levels of a product in all warehouses.
+func (s Service) GetByProductID(ctx context.Context, productID uuid.UUID) (inventory.Stocks, error) {
+	if _, err := s.productsRepository.GetByID(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	stocks, err := s.inventoryRepository.GetByProductID(ctx, productID)
+	if err != nil {
+		s.logger.Error("failed to get stock levels", zap.Error(err), zap.String("product_id", productID.String()))
+		return nil, err
+	}
+
+	return stocks, nil
+}
+
+// Adjust changes units on hand of a product in a warehouse, units held by reservations can't be removed.
+func (s Service) Adjust(ctx context.Context, a inventory.Adjustment) (stock inventory.Stock, err error) {
+	if a.Warehouse == "" {
+		a.Warehouse = inventory.DefaultWarehouse
+	}
+
+	err = s.inTx(ctx, func(repo repositories.InventoryRepository) error {
+		before, lockErr := repo.Lock(ctx, a.ProductID, a.Warehouse)
+		if lockErr != nil {
+			return lockErr
+		}
+
+		if before.Quantity+a.Delta < before.Reserved {
+			return errs.Conflict{What: "insufficient stock"}
+		}
+
+		if stock, err = repo.Adjust(ctx, a); err != nil {
+			return err
+		}
+
+		return s.alert(ctx, before, stock)
+	})
+	if err != nil {
+		s.logger.Error("failed to adjust stock", zap.Error(err),
+			zap.String("product_id", a.ProductID.String()),
+			zap.String("warehouse", a.Warehouse),
+			zap.Int64("delta", a.Delta))
+		return inventory.Stock{}, err
+	}
+
+	return stock, nil
+}
+
+// Reserve holds units of a product in a warehouse until the reservation expires or is released.
+func (s Service) Reserve(ctx context.Context, r inventory.Reservation) (reservation inventory.Reservation, err error) {
+	if r.Warehouse == "" {
+		r.Warehouse = inventory.DefaultWarehouse
+	}
+	if r.ExpiresAt.IsZero() {
+		r.ExpiresAt = time.Now().Add(inventory.DefaultReservationTTL)
+	}
+
+	err = s.inTx(ctx, func(repo repositories.InventoryRepository) error {
+		// the stock stays locked until commit, so concurrent reservations can't oversell it
+		before, lockErr := repo.Lock(ctx, r.ProductID, r.Warehouse)
+		if lockErr != nil {
+			return lockErr
+		}
+
+		if before.Available() < r.Quantity {
+			return errs.Conflict{What: "insufficient stock"}
+		}
+
+		if reservation, err = repo.CreateReservation(ctx, r); err != nil {
+			return err
+		}
+
+		after := before
+		after.Reserved += r.Quantity
+
+		return s.alert(ctx, before, after)
+	})
+	if err != nil {
+		s.logger.Error("failed to reserve stock", zap.Error(err),
+			zap.String("product_id", r.ProductID.String()),
+			zap.String("warehouse", r.Warehouse),
+			zap.Int64("quantity", r.Quantity))
+		return inventory.Reservation{}, err
+	}
+
+	return reservation, nil
+}
+
+// Release releases units held by a reservation.
+func (s Service) Release(ctx context.Context, productID, id uuid.UUID) error {
+	reservation, err := s.inventoryRepository.ReleaseReservation(ctx, productID, id)
+	if err != nil {
+		s.logger.Error("failed to release reservation", zap.Error(err),
+			zap.String("product_id", productID.String()),
+			zap.String("id", id.String()))
+		return err
+	}
+
+	s.logger.Debug("reservation released",
+		zap.String("id", reservation.ID.String()),
+		zap.String("warehouse", reservation.Warehouse),
+		zap.Int64("quantity", reservation.Quantity))
+
+	return nil
+}
+
+// alert sends an alert to notifications services when the stock became low or ran out.
+func (s Service) alert(ctx context.Context, before, after inventory.Stock) error {
+	alert, ok := inventory.NewAlert(before, after)
+	if !ok {
+		return nil
+	}
+
+	if err := s.sqsPublisherRepository.StockAlertToNotificationsService(ctx, alert); err != nil {
+		s.logger.Error("failed to send stock alert msg to notifications services", zap.Error(err),
+			zap.String("type", alert.Type),
+			zap.String("product_id", alert.ProductID.String()))
+		return err
+	}
+
+	return nil
+}
+
+// inTx runs fn with inventory repositories bound to a transaction, the transaction is committed when fn succeeds
+// and is retried on serialization failures and deadlocks, which are likely as stocks are locked.
+func (s Service) inTx(ctx context.Context, fn func(repo repositories.InventoryRepository) error) error {
+	return pgerrs.Retry(ctx, func() error { return s.runTx(ctx, fn) })
+}
+
+// runTx runs fn with inventory repositories bound to a transaction once.
+func (s Service) runTx(ctx context.Context, fn func(repo repositories.InventoryRepository) error) (err error) {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	tx, err := s.db.BeginTxx(ctx, &sql.TxOptions{})
+	if err != nil {
+		s.logger.Error("failed to begin transaction", zap.Error(err))
+		return err
+	}
+	defer func() {
+		if pp := recover(); pp != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				s.logger.Error("failed to rollback transaction after panic", zap.Error(rollbackErr))
+			}
+			panic(pp)
+		}
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				s.logger.Error("failed to rollback transaction", zap.Error(rollbackErr))
+			}
+		}
+	}()
+
+	if err = fn(repoinventory.NewRepository(tx, s.logger)); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("failed to commit transaction", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
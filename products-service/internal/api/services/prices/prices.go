@@ -0,0 +1,147 @@
+package prices
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/currencies"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/prices"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
+	repoprices "github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/prices"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/services"
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/pgerrs"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+var _ services.PricesService = &Service{}
+
+// Service - defines services struct.
+type Service struct {
+	db                      *sqlx.DB
+	productsRepository      repositories.ProductsRepository
+	productsCacheRepository repositories.ProductsCacheRepository
+	pricesRepository        repositories.PricesRepository
+	currencyRatesRepository repositories.CurrencyRatesRepository
+	logger                  *zap.Logger
+}
+
+// NewService constructor.
+func NewService(
+	db *sqlx.DB,
+	productsRepository repositories.ProductsRepository,
+	productsCacheRepository repositories.ProductsCacheRepository,
+	pricesRepository repositories.PricesRepository,
+	currencyRatesRepository repositories.CurrencyRatesRepository,
+	logger *zap.Logger,
+) *Service {
+	return &Service{
+		db:                      db,
+		productsRepository:      productsRepository,
+		productsCacheRepository: productsCacheRepository,
+		pricesRepository:        pricesRepository,
+		currencyRatesRepository: currencyRatesRepository,
+		logger:                  logger.With(zap.String("services", "prices")),
+	}
+}
+
+// Create schedules a new price for a product, the transaction is retried on serialization failures and deadlocks.
+func (s Service) Create(ctx context.Context, p prices.Price) (price prices.Price, err error) {
+	if ctx.Err() != nil {
+		return prices.Price{}, ctx.Err()
+	}
+
+	if p.Currency == "" {
+		p.Currency = currencies.Base
+	}
+
+	err = pgerrs.Retry(ctx, func() (txErr error) {
+		price, txErr = s.create(ctx, p)
+		return txErr
+	})
+	if err != nil {
+		return prices.Price{}, err
+	}
+
+	// cached products hold their current price
+	if s.productsCacheRepository != nil {
+		if cacheErr := s.productsCacheRepository.Invalidate(ctx, p.ProductID); cacheErr != nil {
+			s.logger.Error("failed to invalidate cached product", zap.Error(cacheErr),
+				zap.String("product_id", p.ProductID.String()))
+		}
+	}
+
+	return price, nil
+}
+
+// create schedules a new price for a product in a transaction.
+func (s Service) create(ctx context.Context, p prices.Price) (price prices.Price, err error) {
+	tx, err := s.db.BeginTxx(ctx, &sql.TxOptions{})
+	if err != nil {
+		s.logger.Error("failed to begin transaction", zap.Error(err))
+		return prices.Price{}, err
+	}
+	defer func() {
+		if pp := recover(); pp != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				s.logger.Error("failed to rollback transaction after panic", zap.Error(rollbackErr))
+			}
+			panic(pp)
+		}
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				s.logger.Error("failed to rollback transaction", zap.Error(rollbackErr))
+			}
+		}
+	}()
+
+	txRepo := repoprices.NewRepository(tx, s.logger)
+	price, err = txRepo.Create(ctx, p)
+	if err != nil {
+		s.logger.Error("failed to create price", zap.Error(err),
+			zap.String("product_id", p.ProductID.String()),
+			zap.String("currency", p.Currency),
+			zap.Time("effective_from", p.EffectiveFrom))
+		return prices.Price{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("failed to commit transaction", zap.Error(err))
+		return prices.Price{}, err
+	}
+
+	return price, nil
+}
+
+// GetByProductID returns price history of a product, prices are converted to currency when it is set.
+func (s Service) GetByProductID(ctx context.Context, productID uuid.UUID, currency string) (prices.Prices, error) {
+	if _, err := s.productsRepository.GetByID(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	list, err := s.pricesRepository.GetByProductID(ctx, productID)
+	if err != nil {
+		s.logger.Error("failed to get prices", zap.Error(err), zap.String("product_id", productID.String()))
+		return nil, err
+	}
+
+	if currency == "" || len(list) == 0 {
+		return list, nil
+	}
+
+	rates, err := s.currencyRatesRepository.GetAll(ctx)
+	if err != nil {
+		s.logger.Error("failed to get currency rates", zap.Error(err))
+		return nil, err
+	}
+
+	for i := range list {
+		if list[i].Amount, err = rates.Convert(list[i].Amount, list[i].Currency, currency); err != nil {
+			return nil, err
+		}
+		list[i].Currency = currency
+	}
+
+	return list, nil
+}
@@ -2,15 +2,15 @@ package products
 
 import (
 	"context"
-	"database/sql"
 
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/images"
 	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/metrics"
 	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
 	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
-	repoproducts "github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/products"
 	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/services"
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/pgerrs"
 	"github.com/google/uuid"
-	"github.com/jmoiron/sqlx"
 	"go.uber.org/zap"
 )
 
@@ -18,97 +18,190 @@ var _ services.ProductsService = &Service{}
 
 // Service - defines services struct.
 type Service struct {
-	db                     *sqlx.DB
-	productsRepository     repositories.ProductsRepository
-	sqsPublisherRepository repositories.SQSPublisherRepository
-	metrics                *metrics.Metrics
-	logger                 *zap.Logger
+	productsRepository      repositories.ProductsRepository
+	productsTxRepository    repositories.ProductsTxRepository
+	productsCacheRepository repositories.ProductsCacheRepository
+	vendorsRepository       repositories.VendorsRepository
+	imagesRepository        repositories.ImagesRepository
+	objectStorageRepository repositories.ObjectStorageRepository
+	currencyRatesRepository repositories.CurrencyRatesRepository
+	sqsPublisherRepository  repositories.SQSPublisherRepository
+	metrics                 *metrics.Metrics
+	logger                  *zap.Logger
 }
 
-// NewService constructor.
+// NewService constructor, vendorsRepository and currencyRatesRepository are nil when they are not stored,
+// e.g. with memory storage, so filters by vendors and conversions of prices are not available.
 func NewService(
-	db *sqlx.DB,
 	productsRepository repositories.ProductsRepository,
+	productsTxRepository repositories.ProductsTxRepository,
+	productsCacheRepository repositories.ProductsCacheRepository,
+	vendorsRepository repositories.VendorsRepository,
+	imagesRepository repositories.ImagesRepository,
+	objectStorageRepository repositories.ObjectStorageRepository,
+	currencyRatesRepository repositories.CurrencyRatesRepository,
 	sqsPublisherRepository repositories.SQSPublisherRepository,
 	metrics *metrics.Metrics,
 	logger *zap.Logger,
 ) *Service {
 	return &Service{
-		db:                     db,
-		productsRepository:     productsRepository,
-		sqsPublisherRepository: sqsPublisherRepository,
-		metrics:                metrics,
-		logger:                 logger.With(zap.String("services", "products")),
+		productsRepository:      productsRepository,
+		productsTxRepository:    productsTxRepository,
+		productsCacheRepository: productsCacheRepository,
+		vendorsRepository:       vendorsRepository,
+		imagesRepository:        imagesRepository,
+		objectStorageRepository: objectStorageRepository,
+		currencyRatesRepository: currencyRatesRepository,
+		sqsPublisherRepository:  sqsPublisherRepository,
+		metrics:                 metrics,
+		logger:                  logger.With(zap.String("services", "products")),
 	}
 }
 
-// Create creates a new product.
+// Create creates a new product, the transaction is retried on serialization failures and deadlocks.
 func (s Service) Create(ctx context.Context, p products.Product) (product products.Product, err error) {
-	if ctx.Err() != nil {
-		return products.Product{}, ctx.Err()
-	}
-
-	tx, err := s.db.BeginTxx(ctx, &sql.TxOptions{})
+	err = pgerrs.Retry(ctx, func() (txErr error) {
+		product, txErr = s.create(ctx, p)
+		return txErr
+	})
 	if err != nil {
-		s.logger.Error("failed to begin transaction", zap.Error(err))
 		return products.Product{}, err
 	}
-	defer func() {
-		if pp := recover(); pp != nil {
-			if rollbackErr := tx.Rollback(); rollbackErr != nil {
-				s.logger.Error("failed to rollback transaction after panic", zap.Error(rollbackErr))
-			}
-			panic(pp)
-		}
+
+	s.invalidateCache(ctx, product.ID)
+
+	return product, nil
+}
+
+// create creates a new product in a transaction, the created event is published before the commit, so
+// the product is not created when the event is not published.
+func (s Service) create(ctx context.Context, p products.Product) (product products.Product, err error) {
+	err = s.productsTxRepository.InTx(ctx, func(txRepo repositories.ProductsRepository) (err error) {
+		product, err = txRepo.Create(ctx, p)
 		if err != nil {
-			if rollbackErr := tx.Rollback(); rollbackErr != nil {
-				s.logger.Error("failed to rollback transaction", zap.Error(rollbackErr))
-			}
+			s.logger.Error("failed to create product", zap.Error(err),
+				zap.String("name", p.Name),
+				zap.String("vendor", p.Vendor),
+				zap.Float64("price", p.Price.InexactFloat64()))
+			return err
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if err = s.sqsPublisherRepository.CreateToNotificationsService(ctx, product.ID); err != nil {
+			s.logger.Error("failed to send create product msg to notifications services", zap.Error(err),
+				zap.String("id", product.ID.String()))
+			return err
 		}
-	}()
 
-	txRepo := repoproducts.NewRepository(tx, s.logger)
-	product, err = txRepo.Create(ctx, p)
+		return ctx.Err()
+	})
 	if err != nil {
-		s.logger.Error("failed to create product", zap.Error(err),
-			zap.String("name", p.Name),
-			zap.String("vendor", p.Vendor),
-			zap.Float64("price", p.Price.InexactFloat64()))
 		return products.Product{}, err
 	}
 
-	if ctx.Err() != nil {
-		return products.Product{}, ctx.Err()
+	if s.metrics != nil && s.metrics.ProductCreatedCounter != nil {
+		s.metrics.ProductCreatedCounter.Inc()
 	}
 
-	if err = s.sqsPublisherRepository.CreateToNotificationsService(ctx, product.ID); err != nil {
-		s.logger.Error("failed to send create product msg to notifications services", zap.Error(err),
-			zap.String("id", product.ID.String()))
+	return product, nil
+}
+
+// Update updates a product, the transaction is retried on serialization failures and deadlocks.
+func (s Service) Update(ctx context.Context, p products.Product) (products.Product, error) {
+	var product products.Product
+	err := pgerrs.Retry(ctx, func() (txErr error) {
+		product, txErr = s.update(ctx, p)
+		return txErr
+	})
+	if err != nil {
 		return products.Product{}, err
 	}
 
-	if ctx.Err() != nil {
-		return products.Product{}, ctx.Err()
+	s.invalidateCache(ctx, product.ID)
+
+	withImages, err := s.withImages(ctx, products.Products{product})
+	if err != nil {
+		return products.Product{}, err
 	}
 
-	if err = tx.Commit(); err != nil {
-		s.logger.Error("failed to commit transaction", zap.Error(err))
-		if rollbackErr := tx.Rollback(); rollbackErr != nil {
-			s.logger.Error("failed to rollback after commit error", zap.Error(rollbackErr))
+	return withImages[0], nil
+}
+
+// update updates a product in a transaction, the updated event is published before the commit.
+func (s Service) update(ctx context.Context, p products.Product) (product products.Product, err error) {
+	err = s.productsTxRepository.InTx(ctx, func(txRepo repositories.ProductsRepository) (err error) {
+		product, err = txRepo.Update(ctx, p)
+		if err != nil {
+			s.logger.Error("failed to update product", zap.Error(err),
+				zap.String("id", p.ID.String()),
+				zap.String("name", p.Name),
+				zap.String("vendor", p.Vendor))
+			return err
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
 		}
+
+		if err = s.sqsPublisherRepository.UpdateToNotificationsService(ctx, product.ID); err != nil {
+			s.logger.Error("failed to send update product msg to notifications services", zap.Error(err),
+				zap.String("id", product.ID.String()))
+			return err
+		}
+
+		return ctx.Err()
+	})
+	if err != nil {
 		return products.Product{}, err
 	}
 
-	if s.metrics != nil && s.metrics.ProductCreatedCounter != nil {
-		s.metrics.ProductCreatedCounter.Inc()
+	return product, nil
+}
+
+// GetByID returns a product by id, price is converted to currency when it is set.
+func (s Service) GetByID(ctx context.Context, id uuid.UUID, currency string) (products.Product, error) {
+	product, err := s.productsRepository.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("failed to get product", zap.Error(err), zap.String("id", id.String()))
+		return products.Product{}, err
 	}
 
-	return product, nil
+	items, err := s.convert(ctx, products.Products{product}, currency)
+	if err != nil {
+		return products.Product{}, err
+	}
+
+	if items, err = s.withImages(ctx, items); err != nil {
+		return products.Product{}, err
+	}
+
+	return items[0], nil
 }
 
-// GetAll returns products with pagination using limit and offset.
-func (s Service) GetAll(ctx context.Context, limit, offset uint64) (products.ProductList, error) {
-	product, err := s.productsRepository.GetAll(ctx, limit, offset)
+// GetAll returns products matching filter with pagination using limit and offset,
+// prices are converted to currency when it is set.
+func (s Service) GetAll(
+	ctx context.Context,
+	limit, offset uint64,
+	filter products.Filter,
+	currency string,
+) (products.ProductList, error) {
+	if filter.VendorID != nil {
+		if s.vendorsRepository == nil {
+			return products.ProductList{}, errs.NotImplemented{Cause: "vendors are not stored"}
+		}
+
+		// listing a vendor's catalog, unknown vendor is not an empty catalog
+		if _, err := s.vendorsRepository.GetByID(ctx, *filter.VendorID); err != nil {
+			s.logger.Error("failed to get vendor", zap.Error(err), zap.String("id", filter.VendorID.String()))
+			return products.ProductList{}, err
+		}
+	}
+
+	list, err := s.productsRepository.GetAll(ctx, limit, offset, filter)
 	if err != nil {
 		s.logger.Error("failed to get all products", zap.Error(err),
 			zap.Uint64("limit", limit),
@@ -116,7 +209,73 @@ func (s Service) GetAll(ctx context.Context, limit, offset uint64) (products.Pro
 		return products.ProductList{}, err
 	}
 
-	return product, nil
+	if list.Products, err = s.convert(ctx, list.Products, currency); err != nil {
+		return products.ProductList{}, err
+	}
+
+	if list.Products, err = s.withImages(ctx, list.Products); err != nil {
+		return products.ProductList{}, err
+	}
+
+	return list, nil
+}
+
+// convert converts prices of products to currency, products are returned as is for empty currency.
+func (s Service) convert(ctx context.Context, items products.Products, currency string) (products.Products, error) {
+	if currency == "" || len(items) == 0 {
+		return items, nil
+	}
+	if s.currencyRatesRepository == nil {
+		return nil, errs.NotImplemented{Cause: "currency rates are not stored"}
+	}
+
+	rates, err := s.currencyRatesRepository.GetAll(ctx)
+	if err != nil {
+		s.logger.Error("failed to get currency rates", zap.Error(err))
+		return nil, err
+	}
+
+	for i := range items {
+		if items[i].Price, err = rates.Convert(items[i].Price, items[i].Currency, currency); err != nil {
+			return nil, err
+		}
+		items[i].Currency = currency
+	}
+
+	return items, nil
+}
+
+// withImages sets images of products with presigned URLs.
+func (s Service) withImages(ctx context.Context, items products.Products) (products.Products, error) {
+	if len(items) == 0 {
+		return items, nil
+	}
+
+	ids := make([]uuid.UUID, len(items))
+	for i := range items {
+		ids[i] = items[i].ID
+	}
+
+	list, err := s.imagesRepository.GetByProductIDs(ctx, ids)
+	if err != nil {
+		s.logger.Error("failed to get product images", zap.Error(err))
+		return nil, err
+	}
+
+	byProduct := make(map[uuid.UUID]images.Images, len(items))
+	for _, img := range list {
+		if img.URL, err = s.objectStorageRepository.PresignGet(ctx, img.Key); err != nil {
+			s.logger.Error("failed to presign product image", zap.Error(err), zap.String("key", img.Key))
+			return nil, err
+		}
+		byProduct[img.ProductID] = append(byProduct[img.ProductID], img)
+	}
+
+	for i := range items {
+		items[i].Images = byProduct[items[i].ID]
+	}
+
+	return items, nil
 }
 
 // Delete removes a product.
@@ -130,6 +289,12 @@ func (s Service) Delete(ctx context.Context, id uuid.UUID) error {
 		return err
 	}
 
+	// image metadata is removed with the product, objects are removed separately
+	if err := s.objectStorageRepository.DeletePrefix(ctx, images.ProductPrefix(id)); err != nil {
+		s.logger.Warn("product deleted but its images are not removed from storage", zap.Error(err),
+			zap.String("id", id.String()))
+	}
+
 	if err := s.sqsPublisherRepository.DeleteToNotificationsService(ctx, id); err != nil {
 		s.logger.Error("failed to send delete product msg to notifications services", zap.Error(err),
 			zap.String("id", id.String()))
@@ -142,3 +307,15 @@ func (s Service) Delete(ctx context.Context, id uuid.UUID) error {
 
 	return nil
 }
+
+// invalidateCache invalidates cached reads of a product written in a transaction, which bypasses the cache.
+// Failures are logged only, cached reads expire anyway.
+func (s Service) invalidateCache(ctx context.Context, id uuid.UUID) {
+	if s.productsCacheRepository == nil {
+		return
+	}
+
+	if err := s.productsCacheRepository.Invalidate(ctx, id); err != nil {
+		s.logger.Error("failed to invalidate cached product", zap.Error(err), zap.String("id", id.String()))
+	}
+}
@@ -0,0 +1,82 @@
+package products
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/images"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/inventory"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/memory_images"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/memory_products"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/memory_storage"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// publisherStub - a publisher of events, which are not sent anywhere.
+type publisherStub struct{}
+
+func (publisherStub) CreateToNotificationsService(context.Context, uuid.UUID) error { return nil }
+
+func (publisherStub) UpdateToNotificationsService(context.Context, uuid.UUID) error { return nil }
+
+func (publisherStub) DeleteToNotificationsService(context.Context, uuid.UUID) error { return nil }
+
+func (publisherStub) StockAlertToNotificationsService(context.Context, inventory.Alert) error {
+	return nil
+}
+
+// TestService_DeleteRemovesImages tests that objects of images of a deleted product are removed from the storage.
+func TestService_DeleteRemovesImages(t *testing.T) {
+	ctx := context.Background()
+	repo := memory_products.NewRepository(zap.NewNop())
+	storage := memory_storage.NewRepository(zap.NewNop())
+	s := NewService(repo, repo, nil, nil, memory_images.NewRepository(zap.NewNop()), storage, nil,
+		publisherStub{}, nil, zap.NewNop())
+
+	deleted, err := repo.Create(ctx, products.Product{Name: "Keyboard", Vendor: "Acme", Price: decimal.New(1, 0)})
+	require.NoError(t, err)
+	kept, err := repo.Create(ctx, products.Product{Name: "Mouse", Vendor: "Acme", Price: decimal.New(1, 0)})
+	require.NoError(t, err)
+
+	keys := []string{
+		images.Key(deleted.ID, uuid.New(), "image/png"),
+		images.Key(deleted.ID, uuid.New(), "image/jpeg"),
+		images.Key(kept.ID, uuid.New(), "image/png"),
+	}
+	for _, key := range keys {
+		require.NoError(t, storage.Put(ctx, key, "image/png", "", bytes.NewReader([]byte("png")), 3))
+	}
+
+	require.NoError(t, s.Delete(ctx, deleted.ID))
+	assert.Equal(t, []string{keys[2]}, storage.Keys())
+}
+
+// TestService_WithoutVendorsAndRates tests that filters by vendors and conversions of prices are not implemented
+// when vendors and currency rates are not stored.
+func TestService_WithoutVendorsAndRates(t *testing.T) {
+	ctx := context.Background()
+	repo := memory_products.NewRepository(zap.NewNop())
+	s := NewService(repo, repo, nil, nil, memory_images.NewRepository(zap.NewNop()),
+		memory_storage.NewRepository(zap.NewNop()), nil, publisherStub{}, nil, zap.NewNop())
+
+	product, err := s.Create(ctx, products.Product{Name: "Laptop", Price: decimal.RequireFromString("10"),
+		Currency: "USD"})
+	require.NoError(t, err)
+
+	vendorID := uuid.New()
+	_, err = s.GetAll(ctx, 10, 0, products.Filter{VendorID: &vendorID}, "")
+	assert.ErrorAs(t, err, &errs.NotImplemented{})
+
+	_, err = s.GetByID(ctx, product.ID, "EUR")
+	assert.ErrorAs(t, err, &errs.NotImplemented{})
+
+	_, err = s.GetByID(ctx, product.ID, "")
+	assert.NoError(t, err)
+}
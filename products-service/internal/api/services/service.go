@@ -3,7 +3,16 @@ package services
 import (
 	"context"
 
+	"github.com/at-kh/guru-apps-test-services/platform/reload"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/categories"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/changes"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/currencies"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/events"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/images"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/inventory"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/prices"
 	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/vendors"
 	"github.com/google/uuid"
 )
 
@@ -11,7 +20,76 @@ type (
 	// ProductsService defines the interface for product services.
 	ProductsService interface {
 		Create(ctx context.Context, e products.Product) (products.Product, error)
-		GetAll(ctx context.Context, page, limit uint64) (products.ProductList, error)
+		Update(ctx context.Context, e products.Product) (products.Product, error)
+		GetByID(ctx context.Context, id uuid.UUID, currency string) (products.Product, error)
+		GetAll(
+			ctx context.Context,
+			limit, offset uint64,
+			filter products.Filter,
+			currency string,
+		) (products.ProductList, error)
 		Delete(ctx context.Context, id uuid.UUID) error
 	}
+
+	// VendorsService defines the interface for vendors services.
+	VendorsService interface {
+		Create(ctx context.Context, e vendors.Vendor) (vendors.Vendor, error)
+		GetAll(ctx context.Context, limit, offset uint64) (vendors.VendorList, error)
+		GetByID(ctx context.Context, id uuid.UUID) (vendors.Vendor, error)
+		Update(ctx context.Context, e vendors.Vendor) (vendors.Vendor, error)
+		Delete(ctx context.Context, id uuid.UUID) error
+	}
+
+	// CategoriesService defines the interface for categories services.
+	CategoriesService interface {
+		Create(ctx context.Context, e categories.Category) (categories.Category, error)
+		GetAll(ctx context.Context) (categories.Categories, error)
+		GetByID(ctx context.Context, id uuid.UUID) (categories.Category, error)
+		Update(ctx context.Context, e categories.Category) (categories.Category, error)
+		Delete(ctx context.Context, id uuid.UUID, reassignTo *uuid.UUID) error
+	}
+
+	// InventoryService defines the interface for stock levels and reservations services.
+	InventoryService interface {
+		GetByProductID(ctx context.Context, productID uuid.UUID) (inventory.Stocks, error)
+		Adjust(ctx context.Context, e inventory.Adjustment) (inventory.Stock, error)
+		Reserve(ctx context.Context, e inventory.Reservation) (inventory.Reservation, error)
+		Release(ctx context.Context, productID, id uuid.UUID) error
+	}
+
+	// ImagesService defines the interface for product images services.
+	ImagesService interface {
+		Upload(ctx context.Context, e images.Image, content []byte) (images.Image, error)
+		GetByProductID(ctx context.Context, productID uuid.UUID) (images.Images, error)
+		Delete(ctx context.Context, productID, id uuid.UUID) error
+	}
+
+	// PricesService defines the interface for product prices services.
+	PricesService interface {
+		Create(ctx context.Context, e prices.Price) (prices.Price, error)
+		GetByProductID(ctx context.Context, productID uuid.UUID, currency string) (prices.Prices, error)
+	}
+
+	// CurrenciesService defines the interface for currency rates services.
+	CurrenciesService interface {
+		GetRates(ctx context.Context) (currencies.Rates, error)
+		SetRate(ctx context.Context, e currencies.Rate) (currencies.Rate, error)
+	}
+
+	// ConfigsService defines the interface for reloads of the configuration without restart.
+	ConfigsService interface {
+		Reload(ctx context.Context, trigger string) (reload.Reload, error)
+		State(ctx context.Context) (reload.State, error)
+	}
+
+	// EventsService defines the interface for publishing events of products again.
+	EventsService interface {
+		Republish(ctx context.Context, e events.Republish) (events.RepublishResult, error)
+	}
+
+	// ChangesService defines the interface for the feed of committed changes of products.
+	ChangesService interface {
+		Latest(ctx context.Context) (changes.Position, error)
+		GetAfter(ctx context.Context, after changes.Position) (changes.Changes, error)
+	}
 )
@@ -0,0 +1,102 @@
+package vendors
+
+import (
+	"context"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/vendors"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/services"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ services.VendorsService = &Service{}
+
+// Service - defines services struct.
+type Service struct {
+	vendorsRepository       repositories.VendorsRepository
+	productsCacheRepository repositories.ProductsCacheRepository
+	logger                  *zap.Logger
+}
+
+// NewService constructor, productsCacheRepository is nil when product reads are not cached.
+func NewService(
+	vendorsRepository repositories.VendorsRepository,
+	productsCacheRepository repositories.ProductsCacheRepository,
+	logger *zap.Logger,
+) *Service {
+	return &Service{
+		vendorsRepository:       vendorsRepository,
+		productsCacheRepository: productsCacheRepository,
+		logger:                  logger.With(zap.String("services", "vendors")),
+	}
+}
+
+// Create creates a new vendor.
+func (s Service) Create(ctx context.Context, v vendors.Vendor) (vendors.Vendor, error) {
+	vendor, err := s.vendorsRepository.Create(ctx, v)
+	if err != nil {
+		s.logger.Error("failed to create vendor", zap.Error(err), zap.String("name", v.Name))
+		return vendors.Vendor{}, err
+	}
+
+	return vendor, nil
+}
+
+// GetAll returns vendors with pagination using limit and offset.
+func (s Service) GetAll(ctx context.Context, limit, offset uint64) (vendors.VendorList, error) {
+	list, err := s.vendorsRepository.GetAll(ctx, limit, offset)
+	if err != nil {
+		s.logger.Error("failed to get all vendors", zap.Error(err),
+			zap.Uint64("limit", limit),
+			zap.Uint64("offset", offset))
+		return vendors.VendorList{}, err
+	}
+
+	return list, nil
+}
+
+// GetByID returns a vendor by id.
+func (s Service) GetByID(ctx context.Context, id uuid.UUID) (vendors.Vendor, error) {
+	vendor, err := s.vendorsRepository.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("failed to get vendor", zap.Error(err), zap.String("id", id.String()))
+		return vendors.Vendor{}, err
+	}
+
+	return vendor, nil
+}
+
+// Update renames a vendor, cached products are invalidated as they hold the name.
+func (s Service) Update(ctx context.Context, v vendors.Vendor) (vendors.Vendor, error) {
+	vendor, err := s.vendorsRepository.Update(ctx, v)
+	if err != nil {
+		s.logger.Error("failed to update vendor", zap.Error(err), zap.String("id", v.ID.String()))
+		return vendors.Vendor{}, err
+	}
+
+	s.invalidateCache(ctx)
+
+	return vendor, nil
+}
+
+// Delete removes a vendor, vendors with products can't be deleted.
+func (s Service) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := s.vendorsRepository.Delete(ctx, id); err != nil {
+		s.logger.Error("failed to delete vendor", zap.Error(err), zap.String("id", id.String()))
+		return err
+	}
+
+	return nil
+}
+
+// invalidateCache invalidates all cached product reads, failures are logged only, cached reads expire anyway.
+func (s Service) invalidateCache(ctx context.Context) {
+	if s.productsCacheRepository == nil {
+		return
+	}
+
+	if err := s.productsCacheRepository.InvalidateAll(ctx); err != nil {
+		s.logger.Error("failed to invalidate cached products", zap.Error(err))
+	}
+}
@@ -0,0 +1,56 @@
+package vendors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/vendors"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// vendorsStub - vendors repositories renaming vendors or failing with err.
+type vendorsStub struct {
+	repositories.VendorsRepository
+
+	err error
+}
+
+func (v vendorsStub) Update(_ context.Context, e vendors.Vendor) (vendors.Vendor, error) {
+	return e, v.err
+}
+
+// cacheStub - products cache counting invalidations of all products.
+type cacheStub struct {
+	repositories.ProductsCacheRepository
+
+	invalidated int
+}
+
+func (c *cacheStub) InvalidateAll(context.Context) error {
+	c.invalidated++
+	return nil
+}
+
+// TestService_UpdateInvalidatesCache tests that renames invalidate cached products holding the name.
+func TestService_UpdateInvalidatesCache(t *testing.T) {
+	ctx := context.Background()
+	cache := &cacheStub{}
+	vendor := vendors.Vendor{ID: uuid.New(), Name: "Acme"}
+
+	_, err := NewService(vendorsStub{err: errors.New("conflict")}, cache, zap.NewNop()).Update(ctx, vendor)
+	require.Error(t, err)
+	assert.Zero(t, cache.invalidated, "failed renames keep the cache")
+
+	renamed, err := NewService(vendorsStub{}, cache, zap.NewNop()).Update(ctx, vendor)
+	require.NoError(t, err)
+	assert.Equal(t, vendor, renamed)
+	assert.Equal(t, 1, cache.invalidated)
+
+	_, err = NewService(vendorsStub{}, nil, zap.NewNop()).Update(ctx, vendor)
+	require.NoError(t, err, "reads may be not cached")
+}
@@ -2,18 +2,22 @@ package app
 
 import (
 	"context"
-	"embed"
+	"io/fs"
 	"time"
 
+	"github.com/at-kh/guru-apps-test-services/platform/health"
+	"github.com/at-kh/guru-apps-test-services/platform/lifecycle"
+	"github.com/at-kh/guru-apps-test-services/platform/responder"
 	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery"
-	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/health"
 	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/metrics"
 	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
 	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/services"
 	"github.com/at-kh/guru-apps-test-services/products-service/internal/config"
-	"github.com/at-kh/guru-apps-test-services/products-service/pkg/responder"
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/dbrouter"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/jmoiron/sqlx"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/shopspring/decimal"
 	"go.uber.org/zap"
 )
@@ -21,8 +25,8 @@ import (
 type (
 	// Meta defines meta for application.
 	Meta struct {
-		ConfigPath string
-		Info       health.Info
+		ConfigPaths []string // configuration files merged in order, config.DefaultPath when empty
+		Info        health.Info
 	}
 
 	// App defines main application struct.
@@ -31,28 +35,72 @@ type (
 		meta Meta
 
 		// FS dependencies.
-		dbMigrationsFS embed.FS
+		dbMigrationsFS fs.FS
+
+		// Options of embedding the application, e.g. in tests.
+		memoryStorage bool                 // data and objects are stored in memory instead of Postgres and S3
+		metricsReg    *prometheus.Registry // registry of metrics, the default one when nil
 
 		// Tech dependencies.
 		cfg       *config.Config
 		logger    *zap.Logger
+		logLevel  zap.AtomicLevel // level of logger, it is changed by reloads of the configuration
 		db        *sqlx.DB
+		dbRouter  *dbrouter.Router    // routes product reads to replicas
 		responder responder.Responder // responder for http responses
 		sqsClient *sqs.Client
 
+		lifecycle *lifecycle.Manager // starts and stops workers, reports readiness
+
+		s3Client    *s3.Client
+		s3Presigner *s3.PresignClient
+
 		// Metrics dependencies.
 		metrics *metrics.Metrics
 
 		// Repository dependencies.
-		productsRepository     repositories.ProductsRepository
-		sqsPublisherRepository repositories.SQSPublisherRepository
+		productsRepository   repositories.ProductsRepository
+		productsTxRepository repositories.ProductsTxRepository
+		// reads committed products bypassing caches and replicas, e.g. products of changes
+		productsPrimaryRepository repositories.ProductsRepository
+		productChangesRepository  repositories.ProductChangesRepository
+		vendorsRepository         repositories.VendorsRepository
+		categoriesRepository      repositories.CategoriesRepository
+		pricesRepository          repositories.PricesRepository
+		inventoryRepository       repositories.InventoryRepository
+		imagesRepository          repositories.ImagesRepository
+		objectStorageRepository   repositories.ObjectStorageRepository
+		currencyRatesRepository   repositories.CurrencyRatesRepository
+		sqsPublisherRepository    repositories.SQSPublisherRepository
+		cacheRepository           repositories.CacheRepository         // nil when reads are not cached
+		productsCacheRepository   repositories.ProductsCacheRepository // nil when reads are not cached
 
 		// Services dependencies.
-		productsService services.ProductsService
+		productsService   services.ProductsService
+		vendorsService    services.VendorsService
+		categoriesService services.CategoriesService
+		pricesService     services.PricesService
+		inventoryService  services.InventoryService
+		imagesService     services.ImagesService
+		currenciesService services.CurrenciesService
+		configsService    services.ConfigsService
+		eventsService     services.EventsService
+		changesService    services.ChangesService
 
 		// Delivery dependencies.
-		healthHTTPHandler   delivery.HealthHTTPHandler
-		productsHTTPHandler delivery.ProductsHTTPHandler
+		healthHTTPHandler     delivery.HealthHTTPHandler
+		docsHTTPHandler       delivery.DocsHTTPHandler
+		productsHTTPHandler   delivery.ProductsHTTPHandler
+		vendorsHTTPHandler    delivery.VendorsHTTPHandler
+		categoriesHTTPHandler delivery.CategoriesHTTPHandler
+		pricesHTTPHandler     delivery.PricesHTTPHandler
+		inventoryHTTPHandler  delivery.InventoryHTTPHandler
+		imagesHTTPHandler     delivery.ImagesHTTPHandler
+		currenciesHTTPHandler delivery.CurrenciesHTTPHandler
+		configsHTTPHandler    delivery.ConfigsHTTPHandler
+		eventsHTTPHandler     delivery.EventsHTTPHandler
+		changesHTTPHandler    delivery.ChangesHTTPHandler
+		productsGRPCHandler   delivery.ProductsGRPCHandler
 	}
 
 	worker func(ctx context.Context, a *App)
@@ -61,30 +109,71 @@ type (
 // New - app constructor without init for components.
 func New(meta Meta) *App { return &App{meta: meta} }
 
-// WithMigrationFS is a setup for database migration filesystem
-func (a *App) WithMigrationFS(dbMigrationFS embed.FS) *App {
+// WithMigrationFS is a setup for database migration filesystem, migrations are read from the migration
+// directory of the configuration in it, e.g. the directory embedded into the binary.
+func (a *App) WithMigrationFS(dbMigrationFS fs.FS) *App {
 	a.dbMigrationsFS = dbMigrationFS
 	return a
 }
 
-// Run – initialize configuration and application dependencies, run workers.
-func (a *App) Run(ctx context.Context) {
-	decimal.MarshalJSONWithoutQuotes = true
-	time.Local = time.UTC
+// WithConfig sets the configuration, so it is not loaded from files and env.
+func (a *App) WithConfig(cfg *config.Config) *App {
+	a.cfg = cfg
+	return a
+}
+
+// WithLogger sets the logger, its level is not changed by reloads of the configuration.
+func (a *App) WithLogger(logger *zap.Logger) *App {
+	a.logger = logger
+	return a
+}
+
+// WithMetricsRegistry sets the registry of metrics served by /metrics, so several applications may run
+// in the same process.
+func (a *App) WithMetricsRegistry(reg *prometheus.Registry) *App {
+	a.metricsReg = reg
+	return a
+}
+
+// WithMemoryStorage stores products, images and objects in memory instead of Postgres and S3, e.g. in tests.
+// Vendors, categories, prices, inventory and currency rates need Postgres, so their routes, filters of products
+// by vendors and conversions of prices respond 501 Not Implemented.
+func (a *App) WithMemoryStorage() *App {
+	a.memoryStorage = true
+	return a
+}
+
+// SetGlobals sets process-wide settings of the application, settings which are already set are not written,
+// so programs embedding applications call it before starting goroutines, e.g. tests running several of them.
+func SetGlobals() {
+	if !decimal.MarshalJSONWithoutQuotes {
+		decimal.MarshalJSONWithoutQuotes = true
+	}
+	if time.Local != time.UTC {
+		time.Local = time.UTC
+	}
+}
+
+// Run – initialize configuration and application dependencies, run workers until ctx is done and stop them.
+func (a *App) Run(ctx context.Context) error {
+	SetGlobals()
 
 	// Initialize configuration and tech dependencies
 	a.initConfig()
 	a.initLogger()
+	a.initLifecycle()
 	a.initMetrics()
 	a.initResponder()
 	a.initDatabase()
 	a.initMessageBroker(ctx)
+	a.initObjectStorage(ctx)
 
 	// Layers registration
 	a.registerRepositories()
 	a.registerServices()
 	a.registerHTTPHandlers()
+	a.registerGRPCHandlers()
 
 	// Run workers
-	a.runWorkers(ctx)
+	return a.runWorkers(ctx)
 }
@@ -0,0 +1,69 @@
+package app
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/config"
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigUsage - usage of the config command.
+const ConfigUsage = `usage: products-service [-c file]... config print [--redacted]
+
+Prints the effective configuration: files given by -c merged in order and overridden by env.
+
+flags:
+`
+
+// Config – runs the config command with args, e.g. ["print", "--redacted"].
+func (a *App) Config(args []string) error {
+	fs := flag.NewFlagSet("config", flag.ContinueOnError)
+	redacted := fs.Bool("redacted", false, "replace passwords of secrets")
+	fs.Usage = func() {
+		_, _ = fmt.Fprint(fs.Output(), ConfigUsage)
+		fs.PrintDefaults()
+	}
+
+	if len(args) == 0 || args[0] != "print" {
+		fs.Usage()
+		return errors.New("config print is the only command")
+	}
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	cfg, err := config.Load(a.meta.ConfigPaths...)
+	if err != nil {
+		return err
+	}
+
+	if err = printConfig(os.Stdout, cfg, *redacted); err != nil {
+		return err
+	}
+
+	if errs := cfg.Validate(); len(errs) > 0 {
+		return fmt.Errorf("configuration validation error: %v", errs)
+	}
+
+	return nil
+}
+
+// printConfig writes config as YAML.
+func printConfig(w io.Writer, cfg *config.Config, redacted bool) error {
+	if redacted {
+		r := cfg.Redacted()
+		cfg = &r
+	}
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(cfg); err != nil {
+		return fmt.Errorf("failed to encode config: %w", err)
+	}
+
+	return enc.Close()
+}
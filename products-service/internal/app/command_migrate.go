@@ -0,0 +1,222 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	migrate "github.com/rubenv/sql-migrate"
+	"go.uber.org/zap"
+)
+
+// MigrateUsage - usage of the migrate command.
+const MigrateUsage = `usage: products-service migrate <command> [flags]
+
+commands:
+  up [N]       apply N or all pending migrations
+  down [N]     roll back N (1 by default) migrations, requires -confirm
+  redo         roll back and apply the last migration again, requires -confirm
+  status       list migrations and when they were applied
+  new <name>   create an empty migration in -dir
+
+flags:
+`
+
+// migrationTemplate - content of migrations created by the migrate new command.
+const migrationTemplate = `-- +migrate Up
+
+-- +migrate Down
+`
+
+// migrationNameRe - allowed names of new migrations.
+var migrationNameRe = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// Migrate – runs the migrate command with args, e.g. ["down", "2", "-confirm"], using migrations embedded
+// into the binary. Migrations are applied holding the same lock as auto-migration on startup.
+func (a *App) Migrate(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	confirm := fs.Bool("confirm", false, "confirm rolling back migrations")
+	dir := fs.String("dir", "cmd/dbschema/migrations", "directory of migrations created by new")
+	fs.Usage = func() {
+		_, _ = fmt.Fprint(fs.Output(), MigrateUsage)
+		fs.PrintDefaults()
+	}
+
+	// flags are accepted after commands too: migrate down 2 -confirm
+	var flags, positional []string
+	for i := 0; i < len(args); i++ {
+		if !strings.HasPrefix(args[i], "-") {
+			positional = append(positional, args[i])
+			continue
+		}
+
+		flags = append(flags, args[i])
+		if name := strings.TrimLeft(args[i], "-"); !strings.Contains(name, "=") && i+1 < len(args) {
+			if f := fs.Lookup(name); f != nil && !isBoolFlag(f) {
+				i++
+				flags = append(flags, args[i])
+			}
+		}
+	}
+	if err := fs.Parse(flags); err != nil {
+		return err
+	}
+	if len(positional) == 0 {
+		fs.Usage()
+		return errors.New("migrate command is required")
+	}
+
+	command, params := positional[0], positional[1:]
+	if command == "new" {
+		if len(params) != 1 {
+			return errors.New("migrate new requires a name")
+		}
+
+		return newMigration(os.Stdout, *dir, params[0], time.Now())
+	}
+
+	switch command {
+	case "up", "down", "redo", "status":
+	default:
+		fs.Usage()
+		return fmt.Errorf("unknown migrate command %q", command)
+	}
+
+	n, err := migrationsCount(command, params)
+	if err != nil {
+		return err
+	}
+	if (command == "down" || command == "redo") && !*confirm {
+		return fmt.Errorf("migrate %s rolls back data, run it with -confirm", command)
+	}
+
+	a.initConfig()
+	a.initLogger()
+
+	db, err := a.connectDB()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = db.Close() }()
+
+	switch command {
+	case "up":
+		return a.migrateExec(ctx, db, migrate.Up, n)
+	case "down":
+		return a.migrateExec(ctx, db, migrate.Down, n)
+	case "redo":
+		if err = a.migrateExec(ctx, db, migrate.Down, 1); err != nil {
+			return err
+		}
+		return a.migrateExec(ctx, db, migrate.Up, 1)
+	default:
+		return a.migrateStatus(os.Stdout, db)
+	}
+}
+
+// isBoolFlag reports whether a flag takes no value.
+func isBoolFlag(f *flag.Flag) bool {
+	b, ok := f.Value.(interface{ IsBoolFlag() bool })
+	return ok && b.IsBoolFlag()
+}
+
+// migrationsCount returns number of migrations to apply by a command, 0 is all of them.
+func migrationsCount(command string, params []string) (int, error) {
+	n := 0
+	if command == "down" {
+		n = 1
+	}
+
+	switch {
+	case len(params) == 0:
+		return n, nil
+	case len(params) > 1 || (command != "up" && command != "down"):
+		return 0, fmt.Errorf("unexpected arguments of migrate %s: %v", command, params)
+	}
+
+	n, err := strconv.Atoi(params[0])
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("number of migrations must be positive, got %q", params[0])
+	}
+
+	return n, nil
+}
+
+// migrateExec applies migrations and logs how many were applied.
+func (a *App) migrateExec(ctx context.Context, db *sqlx.DB, direction migrate.MigrationDirection, n int) error {
+	applied, err := a.execMigrations(ctx, db, direction, n)
+	if err != nil {
+		return fmt.Errorf("migration failed: %w", err)
+	}
+
+	a.logger.Info("migrations applied", zap.Int("count", applied), zap.Bool("down", direction == migrate.Down))
+
+	return nil
+}
+
+// migrateStatus writes embedded migrations with time they were applied at.
+func (a *App) migrateStatus(w io.Writer, db *sqlx.DB) error {
+	migrations, err := a.migrationSource().FindMigrations()
+	if err != nil {
+		return fmt.Errorf("failed to find migrations: %w", err)
+	}
+
+	records, err := migrate.GetMigrationRecords(db.DB, a.cfg.Storage.Postgres.Dialect)
+	if err != nil {
+		return fmt.Errorf("failed to get applied migrations: %w", err)
+	}
+
+	appliedAt := make(map[string]time.Time, len(records))
+	for _, r := range records {
+		appliedAt[r.Id] = r.AppliedAt
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "MIGRATION\tAPPLIED AT")
+	for _, m := range migrations {
+		applied := "pending"
+		if at, ok := appliedAt[m.Id]; ok {
+			applied = at.UTC().Format(time.RFC3339)
+		}
+		_, _ = fmt.Fprintf(tw, "%s\t%s\n", m.Id, applied)
+	}
+
+	return tw.Flush()
+}
+
+// newMigration creates an empty migration named by now in dir, migrations are embedded into the binary
+// when it is built.
+func newMigration(w io.Writer, dir, name string, now time.Time) error {
+	if !migrationNameRe.MatchString(name) {
+		return fmt.Errorf("migration name must be kebab-case, got %q", name)
+	}
+
+	path := filepath.Join(dir, now.UTC().Format("20060102150405")+"-"+name+".sql")
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to create migration: %w", err)
+	}
+
+	if _, err = f.WriteString(migrationTemplate); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to write migration: %w", err)
+	}
+	if err = f.Close(); err != nil {
+		return fmt.Errorf("failed to write migration: %w", err)
+	}
+
+	_, _ = fmt.Fprintln(w, "created", path)
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMigrateArgs tests that commands rolling back migrations require confirmation and arguments are checked
+// before connecting to the database.
+func TestMigrateArgs(t *testing.T) {
+	a := &App{}
+
+	for want, args := range map[string][]string{
+		"migrate command is required":                        nil,
+		`unknown migrate command "sideways"`:                 {"sideways"},
+		"migrate down rolls back data, run it with -confirm": {"down", "2"},
+		"migrate redo rolls back data, run it with -confirm": {"redo"},
+		`number of migrations must be positive, got "0"`:     {"up", "0"},
+		"unexpected arguments of migrate status: [1]":        {"status", "1"},
+		"migrate new requires a name":                        {"new"},
+	} {
+		err := a.Migrate(context.Background(), args)
+		assert.EqualError(t, err, want)
+	}
+}
+
+// TestMigrationsCount tests default numbers of migrations of commands.
+func TestMigrationsCount(t *testing.T) {
+	n, err := migrationsCount("up", nil)
+	require.NoError(t, err)
+	assert.Zero(t, n, "all pending migrations are applied")
+
+	n, err = migrationsCount("down", nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n, "only the last migration is rolled back")
+
+	n, err = migrationsCount("down", []string{"3"})
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+}
+
+// TestNewMigration tests that new migrations are named by time and are never overwritten.
+func TestNewMigration(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Date(2026, 10, 19, 15, 0, 0, 0, time.UTC)
+
+	var out bytes.Buffer
+	require.NoError(t, newMigration(&out, dir, "add-stock-alerts", now))
+
+	data, err := os.ReadFile(filepath.Join(dir, "20261019150000-add-stock-alerts.sql"))
+	require.NoError(t, err)
+	assert.Equal(t, migrationTemplate, string(data))
+
+	assert.Error(t, newMigration(&out, dir, "add-stock-alerts", now))
+	assert.Error(t, newMigration(&out, dir, "Add Stock", now))
+}
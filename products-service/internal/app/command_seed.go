@@ -0,0 +1,161 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/pg_cache"
+	repoproducts "github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/products"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/products_cache"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/sqs_publisher"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/config"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/seed"
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/pgerrs"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// SeedUsage - usage of the seed command.
+const SeedUsage = `usage: products-service seed [flags]
+
+Inserts products of -fixtures and -count generated products, products which already exist by name and vendor
+are skipped, so seeding with the same seed twice inserts nothing.
+
+flags:
+`
+
+// defaultSeedCount - number of generated products when neither -count nor -fixtures is set.
+const defaultSeedCount = 1000
+
+// Seed – runs the seed command with args, e.g. ["-count", "10000", "-seed", "7"].
+func (a *App) Seed(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("seed", flag.ContinueOnError)
+	count := fs.Int("count", defaultSeedCount, "number of generated products, 0 when -fixtures is set")
+	seedValue := fs.Uint64("seed", 1, "seed of generated products, the same seed generates the same products")
+	batch := fs.Int("batch", 500, "number of products inserted by a transaction")
+	fixtures := fs.String("fixtures", "", "JSON or YAML file of products to insert")
+	publish := fs.Bool("publish", false, "publish created events of inserted products to notifications")
+	fs.Usage = func() {
+		_, _ = fmt.Fprint(fs.Output(), SeedUsage)
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		fs.Usage()
+		return fmt.Errorf("unexpected arguments of seed: %v", fs.Args())
+	}
+
+	countSet := false
+	fs.Visit(func(f *flag.Flag) { countSet = countSet || f.Name == "count" })
+	if *fixtures != "" && !countSet {
+		*count = 0
+	}
+
+	if *count < 0 || *batch <= 0 {
+		return errors.New("count must not be negative and batch must be positive")
+	}
+
+	var items products.Products
+	if *fixtures != "" {
+		loaded, err := seed.LoadFixtures(*fixtures)
+		if err != nil {
+			return err
+		}
+		items = loaded
+	}
+	items = append(items, seed.NewGenerator(*seedValue).Products(*count)...)
+
+	a.initConfig()
+	a.initLogger()
+
+	db, err := a.connectDB()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = db.Close() }()
+
+	var publisher repositories.SQSPublisherRepository
+	if *publish {
+		a.initMessageBroker(ctx)
+		publisher = sqs_publisher.NewRepository(a.sqsClient, a.cfg.Delivery.Broker.URL, a.logger)
+	}
+
+	created := 0
+	for start := 0; start < len(items); start += *batch {
+		end := min(start+*batch, len(items))
+
+		ids, err := a.seedBatch(ctx, db, items[start:end])
+		if err != nil {
+			return fmt.Errorf("failed to insert products %d-%d: %w", start+1, end, err)
+		}
+		created += len(ids)
+
+		if publisher == nil {
+			continue
+		}
+		for _, id := range ids {
+			if err = publisher.CreateToNotificationsService(ctx, id); err != nil {
+				return fmt.Errorf("failed to publish created event of product %s: %w", id, err)
+			}
+		}
+	}
+
+	a.invalidateSharedCache(ctx, db)
+
+	a.logger.Info("products seeded",
+		zap.Int("created", created),
+		zap.Int("skipped", len(items)-created),
+		zap.Uint64("seed", *seedValue),
+		zap.Bool("published", *publish))
+
+	return nil
+}
+
+// seedBatch inserts products in a transaction, it is retried on serialization failures and deadlocks.
+func (a *App) seedBatch(ctx context.Context, db *sqlx.DB, items products.Products) (ids []uuid.UUID, err error) {
+	err = pgerrs.Retry(ctx, func() (txErr error) {
+		tx, txErr := db.BeginTxx(ctx, &sql.TxOptions{})
+		if txErr != nil {
+			return txErr
+		}
+		defer func() {
+			if txErr != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil && !errors.Is(rollbackErr, sql.ErrTxDone) {
+					a.logger.Error("failed to rollback transaction", zap.Error(rollbackErr))
+				}
+			}
+		}()
+
+		if ids, txErr = repoproducts.NewRepository(tx, a.logger).CreateBatch(ctx, items); txErr != nil {
+			return txErr
+		}
+
+		return tx.Commit()
+	})
+
+	return ids, err
+}
+
+// invalidateSharedCache invalidates product reads cached in Postgres, which running instances share,
+// caches of instances in memory expire by their ttl.
+func (a *App) invalidateSharedCache(ctx context.Context, db *sqlx.DB) {
+	cfg := a.cfg.Storage.Cache
+	if cfg.Driver != config.CacheDriverPostgres {
+		return
+	}
+
+	cache := products_cache.NewRepository(nil, nil, pg_cache.NewRepository(db, a.logger), cfg.ProductTTL, cfg.ListTTL,
+		nil, a.logger)
+	if err := cache.Invalidate(ctx); err != nil {
+		a.logger.Warn("failed to invalidate cached product lists", zap.Error(err))
+	}
+}
@@ -5,11 +5,15 @@ import (
 	"github.com/gofiber/fiber/v2/log"
 )
 
-// initConfig - init config from yaml and env with validation.
+// initConfig - init config from yaml files and env with validation, unless it is set by WithConfig.
 func (a *App) initConfig() {
-	cfg, err := config.InitConfig(config.DefaultPath)
+	if a.cfg != nil {
+		return
+	}
+
+	cfg, err := config.InitConfig(a.meta.ConfigPaths...)
 	if err != nil {
-		log.Fatal("config error: %v", err)
+		log.Fatalf("config error: %v", err)
 	}
 
 	a.cfg = cfg
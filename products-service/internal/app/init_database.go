@@ -6,13 +6,18 @@ import (
 	"strings"
 	"time"
 
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/dbrouter"
 	"github.com/jmoiron/sqlx"
 	migrate "github.com/rubenv/sql-migrate"
 	"go.uber.org/zap"
 )
 
-// initDatabase initializes database connection and migrations.
+// initDatabase initializes database connection and migrations, unless data is stored in memory.
 func (a *App) initDatabase() {
+	if a.memoryStorage {
+		return
+	}
+
 	var (
 		db  *sqlx.DB
 		err error
@@ -59,23 +64,34 @@ func (a *App) initDatabase() {
 	}
 
 	a.db = db
+	a.initReplicas()
 }
 
-// connectDB creates and returns a configured DB connection.
-func (a *App) connectDB() (*sqlx.DB, error) {
-	db, err := sqlx.Open(a.cfg.Storage.Postgres.Driver, a.cfg.Storage.Postgres.DSN)
-	if err != nil {
-		return nil, fmt.Errorf("sql.Open error: %w", err)
-	}
+// initReplicas opens connections to read replicas, reads are routed to them once their health checks pass.
+func (a *App) initReplicas() {
+	replicas := make([]*sqlx.DB, 0, len(a.cfg.Storage.Postgres.ReplicaDSNs))
+	for i, dsn := range a.cfg.Storage.Postgres.ReplicaDSNs {
+		db, err := a.openDB(dsn)
+		if err != nil {
+			a.logger.Fatal("couldn't open replica db", zap.Int("replica", i), zap.Error(err))
+		}
 
-	if a.cfg.Storage.Postgres.ConnMaxOpenNum > 0 {
-		db.SetMaxOpenConns(a.cfg.Storage.Postgres.ConnMaxOpenNum)
+		replicas = append(replicas, db)
 	}
-	if a.cfg.Storage.Postgres.ConnMaxIdleNum > 0 {
-		db.SetMaxIdleConns(a.cfg.Storage.Postgres.ConnMaxIdleNum)
-	}
-	if a.cfg.Storage.Postgres.ConnMaxLifetime > 0 {
-		db.SetConnMaxLifetime(a.cfg.Storage.Postgres.ConnMaxLifetime)
+
+	a.dbRouter = dbrouter.New(a.db, replicas, a.cfg.Storage.Postgres.ReplicaMaxLag, a.logger)
+
+	ctx, cancel := context.WithTimeout(context.Background(), a.cfg.Storage.Postgres.ReplicaCheckInterval)
+	defer cancel()
+
+	a.dbRouter.Check(ctx)
+}
+
+// connectDB creates and returns a configured DB connection to the primary.
+func (a *App) connectDB() (*sqlx.DB, error) {
+	db, err := a.openDB(a.cfg.Storage.Postgres.DSN)
+	if err != nil {
+		return nil, err
 	}
 
 	ctx := context.Background()
@@ -92,32 +108,35 @@ func (a *App) connectDB() (*sqlx.DB, error) {
 	return db, nil
 }
 
-// runMigrationsWithDB applies database migrations from embed.FS
-func (a *App) runMigrationsWithDB(db *sqlx.DB) error {
-	migrations := &migrate.AssetMigrationSource{
-		Asset: func(name string) ([]byte, error) {
-			return a.dbMigrationsFS.ReadFile(name)
-		},
-		AssetDir: func(name string) ([]string, error) {
-			entries, err := a.dbMigrationsFS.ReadDir(name)
-			if err != nil {
-				return nil, err
-			}
-			names := make([]string, 0, len(entries))
-			for _, e := range entries {
-				names = append(names, e.Name())
-			}
-			return names, nil
-		},
-		Dir: a.cfg.Storage.Postgres.MigrationDirectory,
+// openDB opens a DB connection pool configured for dsn without connecting.
+func (a *App) openDB(dsn string) (*sqlx.DB, error) {
+	db, err := sqlx.Open(a.cfg.Storage.Postgres.Driver, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("sql.Open error: %w", err)
+	}
+
+	if a.cfg.Storage.Postgres.ConnMaxOpenNum > 0 {
+		db.SetMaxOpenConns(a.cfg.Storage.Postgres.ConnMaxOpenNum)
+	}
+	if a.cfg.Storage.Postgres.ConnMaxIdleNum > 0 {
+		db.SetMaxIdleConns(a.cfg.Storage.Postgres.ConnMaxIdleNum)
+	}
+	if a.cfg.Storage.Postgres.ConnMaxLifetime > 0 {
+		db.SetConnMaxLifetime(a.cfg.Storage.Postgres.ConnMaxLifetime)
 	}
 
-	direction := migrate.Up
+	return db, nil
+}
+
+// runMigrationsWithDB applies database migrations on startup. Migrations are only applied up,
+// they are rolled back by the migrate down command.
+func (a *App) runMigrationsWithDB(db *sqlx.DB) error {
 	if strings.ToLower(a.cfg.Storage.Postgres.MigrationDirection) == "down" {
-		direction = migrate.Down
+		a.logger.Warn("migration direction down is not applied on startup, use the migrate down command")
+		return nil
 	}
 
-	applied, err := migrate.Exec(db.DB, a.cfg.Storage.Postgres.Dialect, migrations, direction)
+	applied, err := a.execMigrations(context.Background(), db, migrate.Up, 0)
 	if err != nil {
 		return fmt.Errorf("migration failed: %w", err)
 	}
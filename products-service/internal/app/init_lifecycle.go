@@ -0,0 +1,10 @@
+package app
+
+import (
+	"github.com/at-kh/guru-apps-test-services/platform/lifecycle"
+)
+
+// initLifecycle initializes the manager of startup and shutdown of components.
+func (a *App) initLifecycle() {
+	a.lifecycle = lifecycle.New(a.cfg.Lifecycle.ReadinessDelay, a.logger)
+}
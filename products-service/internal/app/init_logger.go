@@ -2,58 +2,25 @@ package app
 
 import (
 	"log"
-	"time"
 
+	"github.com/at-kh/guru-apps-test-services/platform/logger"
 	"go.uber.org/zap"
-	"go.uber.org/zap/zapcore"
 )
 
-type customEncoder struct{}
-
-func (e *customEncoder) encodeTime(t time.Time, enc zapcore.PrimitiveArrayEncoder) {
-	enc.AppendString(t.Format(time.RFC3339Nano))
-}
-
-func (e *customEncoder) encodeDuration(d time.Duration, enc zapcore.PrimitiveArrayEncoder) {
-	enc.AppendString(d.String())
-}
-
-// InitLogger initializes logger for application.
+// InitLogger initializes logger for application, unless it is set by WithLogger.
 func (a *App) initLogger() {
-	ce := customEncoder{}
-
-	cfg := zap.Config{
-		Level:            zap.NewAtomicLevelAt(zap.InfoLevel),
-		Development:      false,
-		Encoding:         "json",
-		OutputPaths:      []string{"stdout"},
-		ErrorOutputPaths: []string{"stderr"},
-		EncoderConfig: zapcore.EncoderConfig{
-			TimeKey:        "timestamp",
-			LevelKey:       "level",
-			NameKey:        "logger",
-			CallerKey:      "caller",
-			MessageKey:     "msg",
-			StacktraceKey:  "stack",
-			EncodeTime:     ce.encodeTime,
-			EncodeDuration: ce.encodeDuration,
-			EncodeLevel:    zapcore.LowercaseLevelEncoder,
-			EncodeCaller:   zapcore.ShortCallerEncoder,
-		},
+	if a.logger != nil {
+		a.logLevel = zap.NewAtomicLevel()
+		return
 	}
 
-	cfg.EncoderConfig.EncodeCaller = zapcore.ShortCallerEncoder
-
-	logger, err := cfg.Build(
-		zap.AddCaller(),
-		zap.AddCallerSkip(1),
-		zap.AddStacktrace(zap.DPanicLevel),
-	)
+	l, level, err := logger.New(a.cfg.Logger.Level)
 	if err != nil {
-		log.Fatalf("failed to build logger: %v", err)
+		log.Fatal(err)
 	}
 
-	a.logger = logger
+	a.logger = l
+	a.logLevel = level
 
 	a.logger.Info("logger initialized",
 		zap.String("app", a.meta.Info.Name),
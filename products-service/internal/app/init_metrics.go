@@ -1,6 +1,7 @@
 package app
 
 import (
+	platformmetrics "github.com/at-kh/guru-apps-test-services/platform/metrics"
 	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/metrics"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -8,20 +9,47 @@ import (
 
 // initMetrics - initialize metrics for application.
 func (a *App) initMetrics() {
+	registerer, _ := platformmetrics.Registry(a.metricsReg)
+	factory := promauto.With(registerer)
+
 	a.metrics = &metrics.Metrics{
-		ProductCreatedCounter: promauto.NewCounter(
+		ProductCreatedCounter: factory.NewCounter(
 			prometheus.CounterOpts{
 				Namespace: metrics.Namespace,
 				Name:      "created_products_cnt",
 				Help:      "Total number of products created",
 			},
 		),
-		ProductDeletedCounter: promauto.NewCounter(
+		ProductDeletedCounter: factory.NewCounter(
 			prometheus.CounterOpts{
 				Namespace: metrics.Namespace,
 				Name:      "deleted_products_cnt",
 				Help:      "Total number of products deleted",
 			},
 		),
+		CacheHitsCounter: factory.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: metrics.Namespace,
+				Name:      "cache_hits_cnt",
+				Help:      "Total number of reads served from cache",
+			},
+			[]string{"cache"},
+		),
+		CacheMissesCounter: factory.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: metrics.Namespace,
+				Name:      "cache_misses_cnt",
+				Help:      "Total number of reads missed in cache",
+			},
+			[]string{"cache"},
+		),
+		ConfigReloadsCounter: factory.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: metrics.Namespace,
+				Name:      "config_reloads_cnt",
+				Help:      "Total number of reloads of the configuration",
+			},
+			[]string{"result"},
+		),
 	}
 }
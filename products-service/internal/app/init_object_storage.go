@@ -0,0 +1,56 @@
+package app
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"go.uber.org/zap"
+)
+
+// initObjectStorage - initialize S3 object storage, unless objects are stored in memory.
+func (a *App) initObjectStorage(ctx context.Context) {
+	if a.memoryStorage {
+		return
+	}
+
+	s3Cfg := a.cfg.Storage.S3
+
+	awsCfg, err := config.LoadDefaultConfig(ctx,
+		config.WithRegion(s3Cfg.Region),
+		config.WithCredentialsProvider(
+			credentials.NewStaticCredentialsProvider("test", "test", ""),
+		),
+	)
+	if err != nil {
+		a.logger.Fatal("cannot load AWS config", zap.Error(err))
+	}
+
+	newClient := func(endpoint string) *s3.Client {
+		return s3.NewFromConfig(awsCfg, func(o *s3.Options) {
+			if endpoint != "" {
+				o.BaseEndpoint = aws.String(endpoint)
+			}
+			o.UsePathStyle = s3Cfg.UsePathStyle
+		})
+	}
+
+	a.s3Client = newClient(s3Cfg.Endpoint)
+
+	// presigned URLs are opened by API clients, which may reach S3 by another address than the service
+	publicEndpoint := s3Cfg.PublicEndpoint
+	if publicEndpoint == "" {
+		publicEndpoint = s3Cfg.Endpoint
+	}
+	a.s3Presigner = s3.NewPresignClient(newClient(publicEndpoint))
+
+	if _, err = a.s3Client.HeadBucket(ctx, &s3.HeadBucketInput{Bucket: aws.String(s3Cfg.Bucket)}); err != nil {
+		a.logger.Fatal("cannot access S3 bucket", zap.Error(err), zap.String("bucket", s3Cfg.Bucket))
+	}
+
+	a.logger.Info("S3 LocalStack initialized",
+		zap.String("aws_region", s3Cfg.Region),
+		zap.String("bucket", s3Cfg.Bucket))
+}
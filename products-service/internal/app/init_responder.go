@@ -1,8 +1,16 @@
 package app
 
-import "github.com/at-kh/guru-apps-test-services/products-service/pkg/responder"
+import (
+	"github.com/at-kh/guru-apps-test-services/platform/responder"
+	"go.uber.org/zap"
+)
 
 // initResponder initializes responder.
 func (a *App) initResponder() {
-	a.responder = responder.New()
+	format := a.cfg.Delivery.HTTPServer.ErrorFormat
+	if !responder.IsErrorFormat(format) {
+		a.logger.Fatal("unknown error format", zap.String("error_format", format))
+	}
+
+	a.responder = responder.New(responder.WithDefaultErrorFormat(format))
 }
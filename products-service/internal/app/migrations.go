@@ -0,0 +1,76 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/jmoiron/sqlx"
+	migrate "github.com/rubenv/sql-migrate"
+	"go.uber.org/zap"
+)
+
+// migrationsLockKey - key of the advisory lock held while migrations are applied, so instances starting at
+// the same time apply them one by one.
+const migrationsLockKey int64 = 0x70726f6475637473 // "products"
+
+// migrationSource returns migrations of the migration filesystem, they are embedded into the binary.
+func (a *App) migrationSource() migrate.MigrationSource {
+	return &migrate.AssetMigrationSource{
+		Asset: func(name string) ([]byte, error) {
+			return fs.ReadFile(a.dbMigrationsFS, name)
+		},
+		AssetDir: func(name string) ([]string, error) {
+			entries, err := fs.ReadDir(a.dbMigrationsFS, name)
+			if err != nil {
+				return nil, err
+			}
+			names := make([]string, 0, len(entries))
+			for _, e := range entries {
+				names = append(names, e.Name())
+			}
+			return names, nil
+		},
+		Dir: a.cfg.Storage.Postgres.MigrationDirectory,
+	}
+}
+
+// execMigrations applies at most max migrations in direction holding the migrations lock, 0 applies all.
+func (a *App) execMigrations(
+	ctx context.Context,
+	db *sqlx.DB,
+	direction migrate.MigrationDirection,
+	max int,
+) (applied int, err error) {
+	err = a.withMigrationsLock(ctx, db, func() error {
+		applied, err = migrate.ExecMaxContext(ctx, db.DB, a.cfg.Storage.Postgres.Dialect, a.migrationSource(),
+			direction, max)
+		return err
+	})
+
+	return applied, err
+}
+
+// withMigrationsLock runs fn holding the advisory lock of migrations, it waits while another instance holds it.
+func (a *App) withMigrationsLock(ctx context.Context, db *sqlx.DB, fn func() error) (err error) {
+	// advisory locks belong to sessions, so the lock is taken and released on the same connection
+	conn, err := db.Connx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get connection for migrations lock: %w", err)
+	}
+	defer func() { err = errors.Join(err, conn.Close()) }()
+
+	a.logger.Debug("waiting for migrations lock")
+	if _, err = conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, migrationsLockKey); err != nil {
+		return fmt.Errorf("failed to take migrations lock: %w", err)
+	}
+	defer func() {
+		if _, unlockErr := conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`,
+			migrationsLockKey); unlockErr != nil {
+			a.logger.Error("failed to release migrations lock", zap.Error(unlockErr))
+		}
+	}()
+
+	return fn()
+}
@@ -0,0 +1,10 @@
+package app
+
+import (
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery/grpc/products"
+)
+
+// registerGRPCHandlers initializes the gRPC handlers.
+func (a *App) registerGRPCHandlers() {
+	a.productsGRPCHandler = products.NewHandler(a.productsService, a.logger)
+}
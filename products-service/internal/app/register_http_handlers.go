@@ -1,12 +1,39 @@
 package app
 
 import (
-	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery/http/health"
+	"github.com/at-kh/guru-apps-test-services/platform/health"
+	"github.com/at-kh/guru-apps-test-services/platform/reload"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery/http/categories"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery/http/changes"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery/http/currencies"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery/http/docs"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery/http/events"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery/http/images"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery/http/inventory"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery/http/prices"
 	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery/http/products"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery/http/vendors"
 )
 
 // registerHTTPHandlers initializes the http handlers.
 func (a *App) registerHTTPHandlers() {
-	a.productsHTTPHandler = products.NewHandler(a.responder, a.productsService, a.logger)
-	a.healthHTTPHandler = health.NewHandler(a.meta.Info)
+	a.productsHTTPHandler = products.NewHandler(a.responder, a.productsService, a.cfg.Storage.Cache.HTTPMaxAge,
+		a.logger)
+	a.vendorsHTTPHandler = vendors.NewHandler(a.responder, a.vendorsService, a.logger)
+	a.categoriesHTTPHandler = categories.NewHandler(a.responder, a.categoriesService, a.logger)
+	a.pricesHTTPHandler = prices.NewHandler(a.responder, a.pricesService, a.logger)
+	a.inventoryHTTPHandler = inventory.NewHandler(a.responder, a.inventoryService, a.logger)
+	a.imagesHTTPHandler = images.NewHandler(a.responder, a.imagesService, a.cfg.Storage.S3.MaxImageSizeBytes,
+		a.logger)
+	a.currenciesHTTPHandler = currencies.NewHandler(a.responder, a.currenciesService, a.logger)
+	a.configsHTTPHandler = reload.NewHandler(a.responder, a.configsService)
+	a.eventsHTTPHandler = events.NewHandler(a.responder, a.eventsService, a.logger)
+	a.changesHTTPHandler = changes.NewHandler(a.responder, a.changesService, changes.Options{
+		PollInterval:        a.cfg.Delivery.ChangeFeed.PollInterval,
+		HeartbeatInterval:   a.cfg.Delivery.ChangeFeed.HeartbeatInterval,
+		WriteTimeout:        a.cfg.Delivery.HTTPServer.WriteTimeout,
+		MaxStreamsPerClient: a.cfg.Delivery.ChangeFeed.MaxStreamsPerClient,
+	}, a.logger)
+	a.healthHTTPHandler = health.NewHandler(a.meta.Info, a.lifecycle.Ready)
+	a.docsHTTPHandler = docs.NewHandler(a.openAPIDocument())
 }
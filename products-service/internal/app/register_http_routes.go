@@ -1,23 +1,71 @@
 package app
 
 import (
+	"github.com/at-kh/guru-apps-test-services/platform/auth"
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/platform/metrics"
 	"github.com/gofiber/fiber/v2"
-	"github.com/prometheus/client_golang/prometheus/promhttp"
-	"github.com/valyala/fasthttp/fasthttpadaptor"
 )
 
-// registerHTTPRoutes registers http routes.
+// apiBasePath is a path prefix of all http routes.
+const apiBasePath = "/products-api/v1"
+
+// registerHTTPRoutes registers http routes, every route must be described in openAPIDocument as well.
 func (a *App) registerHTTPRoutes(app *fiber.App) {
-	r := app.Group("/products-api/v1")
+	r := app.Group(apiBasePath)
 	r.Get("/health", a.healthHTTPHandler.Health)
+	r.Get("/ready", a.healthHTTPHandler.Ready)
+	r.Get("/openapi.json", a.docsHTTPHandler.OpenAPI)
+	r.Get("/docs", a.docsHTTPHandler.UI)
 
 	products := r.Group("/products")
 	products.Get("", a.productsHTTPHandler.GetAll)
 	products.Post("", a.productsHTTPHandler.Create)
+	products.Get("/events", a.changesHTTPHandler.Stream) // before /:id, which would match it
+	products.Get("/:id", a.productsHTTPHandler.GetByID)
+	products.Put("/:id", a.productsHTTPHandler.Update)
 	products.Delete("/:id", a.productsHTTPHandler.Delete)
+	products.Get("/:id/prices", a.requirePostgres, a.pricesHTTPHandler.GetAll)
+	products.Post("/:id/prices", a.requirePostgres, a.pricesHTTPHandler.Create)
+	products.Get("/:id/images", a.imagesHTTPHandler.GetAll)
+	products.Post("/:id/images", a.imagesHTTPHandler.Upload)
+	products.Delete("/:id/images/:image_id", a.imagesHTTPHandler.Delete)
+	products.Get("/:id/stock", a.requirePostgres, a.inventoryHTTPHandler.GetAll)
+	products.Post("/:id/stock/adjustments", a.requirePostgres, a.inventoryHTTPHandler.Adjust)
+	products.Post("/:id/reservations", a.requirePostgres, a.inventoryHTTPHandler.Reserve)
+	products.Delete("/:id/reservations/:reservation_id", a.requirePostgres, a.inventoryHTTPHandler.Release)
+
+	vendors := r.Group("/vendors", a.requirePostgres)
+	vendors.Get("", a.vendorsHTTPHandler.GetAll)
+	vendors.Post("", a.vendorsHTTPHandler.Create)
+	vendors.Get("/:id", a.vendorsHTTPHandler.GetByID)
+	vendors.Put("/:id", a.vendorsHTTPHandler.Update)
+	vendors.Delete("/:id", a.vendorsHTTPHandler.Delete)
+	vendors.Get("/:id/products", a.productsHTTPHandler.GetAllByVendor)
+
+	categories := r.Group("/categories", a.requirePostgres)
+	categories.Get("", a.categoriesHTTPHandler.GetAll)
+	categories.Post("", a.categoriesHTTPHandler.Create)
+	categories.Get("/:id", a.categoriesHTTPHandler.GetByID)
+	categories.Put("/:id", a.categoriesHTTPHandler.Update)
+	categories.Delete("/:id", a.categoriesHTTPHandler.Delete)
+
+	admin := r.Group("/admin", auth.BearerToken(a.cfg.Delivery.HTTPServer.AdminToken))
+	admin.Get("/currency-rates", a.requirePostgres, a.currenciesHTTPHandler.GetRates)
+	admin.Put("/currency-rates/:currency", a.requirePostgres, a.currenciesHTTPHandler.SetRate)
+	admin.Get("/config", a.configsHTTPHandler.Get)
+	admin.Post("/config/reload", a.configsHTTPHandler.Reload)
+	admin.Post("/events/republish", a.eventsHTTPHandler.Republish)
+
+	r.Get("/metrics", metrics.Handler(metrics.Registry(a.metricsReg)))
+}
+
+// requirePostgres responds 501 Not Implemented to requests of routes needing Postgres with memory storage, whose
+// repositories are not registered.
+func (a *App) requirePostgres(ctx *fiber.Ctx) error {
+	if a.memoryStorage {
+		return errs.NotImplemented{Cause: "the route needs Postgres, which is not used with memory storage"}
+	}
 
-	r.Get("/metrics", func(c *fiber.Ctx) error {
-		fasthttpadaptor.NewFastHTTPHandler(promhttp.Handler())(c.Context())
-		return c.SendStatus(fiber.StatusOK)
-	})
+	return ctx.Next()
 }
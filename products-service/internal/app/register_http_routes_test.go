@@ -0,0 +1,77 @@
+package app
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/at-kh/guru-apps-test-services/platform/responder"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/config"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// TestAdminRoutesRequireToken fails when a route of the admin API, e.g. changing currency rates, is registered
+// outside of the group authorized by the admin token.
+func TestAdminRoutesRequireToken(t *testing.T) {
+	cfg := &config.Config{Delivery: config.Delivery{HTTPServer: config.HTTPServer{AdminToken: "secret"}}}
+	a := &App{cfg: cfg, logger: zap.NewNop(), responder: responder.New()}
+	a.registerHTTPHandlers()
+
+	router := fiber.New(fiber.Config{ErrorHandler: a.responder.HandleError})
+	a.registerHTTPRoutes(router)
+
+	var checked int
+	for _, route := range router.GetRoutes(true) {
+		if route.Method == http.MethodHead || !strings.HasPrefix(route.Path, apiBasePath+"/admin/") {
+			continue
+		}
+
+		segments := strings.Split(route.Path, "/")
+		for i, segment := range segments {
+			if strings.HasPrefix(segment, ":") {
+				segments[i] = "USD"
+			}
+		}
+
+		for _, authorization := range []string{"", "Bearer wrong"} {
+			req := httptest.NewRequest(route.Method, strings.Join(segments, "/"), http.NoBody)
+			if authorization != "" {
+				req.Header.Set(fiber.HeaderAuthorization, authorization)
+			}
+
+			resp, err := router.Test(req)
+			require.NoError(t, err)
+			_ = resp.Body.Close()
+			assert.Equal(t, http.StatusUnauthorized, resp.StatusCode, "%s %s %q", route.Method, route.Path, authorization)
+		}
+		checked++
+	}
+
+	assert.NotZero(t, checked, "admin routes are registered")
+}
+
+// TestMemoryStorageRoutes tests that routes needing Postgres respond 501 Not Implemented with memory storage
+// instead of reaching repositories which are not registered.
+func TestMemoryStorageRoutes(t *testing.T) {
+	a := &App{cfg: &config.Config{}, logger: zap.NewNop(), responder: responder.New(), memoryStorage: true}
+	a.registerHTTPHandlers()
+
+	router := fiber.New(fiber.Config{ErrorHandler: a.responder.HandleError})
+	a.registerHTTPRoutes(router)
+
+	id := uuid.NewString()
+	for _, target := range []string{
+		"/vendors", "/vendors/" + id + "/products", "/categories", "/products/" + id + "/prices",
+		"/products/" + id + "/stock",
+	} {
+		resp, err := router.Test(httptest.NewRequest(http.MethodGet, apiBasePath+target, http.NoBody))
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		assert.Equal(t, http.StatusNotImplemented, resp.StatusCode, target)
+	}
+}
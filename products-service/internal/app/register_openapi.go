@@ -0,0 +1,95 @@
+package app
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery/http/categories"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery/http/changes"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery/http/configs"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery/http/currencies"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery/http/docs"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery/http/events"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery/http/health"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery/http/images"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery/http/inventory"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery/http/prices"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery/http/products"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery/http/vendors"
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/openapi"
+)
+
+// openAPIDocument builds OpenAPI document of routes registered in registerHTTPRoutes.
+func (a *App) openAPIDocument() openapi.Document {
+	info := openapi.Info{
+		Title:       a.meta.Info.Name,
+		Description: "Products catalog with vendors, categories, prices, inventory and images.",
+		Version:     a.meta.Info.BuildVersion,
+	}
+	if info.Title == "" {
+		info.Title = "products-service"
+	}
+	if info.Version == "" {
+		info.Version = "dev"
+	}
+
+	b := openapi.NewBuilder(info, apiBasePath)
+
+	b.Add(http.MethodGet, "/health", "health", health.Endpoints["Health"])
+	b.Add(http.MethodGet, "/ready", "health", health.Endpoints["Ready"])
+	b.Add(http.MethodGet, "/openapi.json", "docs", docs.Endpoints["OpenAPI"])
+	b.Add(http.MethodGet, "/docs", "docs", docs.Endpoints["UI"])
+
+	b.Add(http.MethodGet, "/products", "products", products.Endpoints["GetAll"])
+	b.Add(http.MethodPost, "/products", "products", products.Endpoints["Create"])
+	b.Add(http.MethodGet, "/products/events", "changes", changes.Endpoints["Stream"])
+	b.Add(http.MethodGet, "/products/:id", "products", products.Endpoints["GetByID"])
+	b.Add(http.MethodPut, "/products/:id", "products", products.Endpoints["Update"])
+	b.Add(http.MethodDelete, "/products/:id", "products", products.Endpoints["Delete"])
+	b.Add(http.MethodGet, "/products/:id/prices", "prices", prices.Endpoints["GetAll"])
+	b.Add(http.MethodPost, "/products/:id/prices", "prices", prices.Endpoints["Create"])
+	b.Add(http.MethodGet, "/products/:id/images", "images", images.Endpoints["GetAll"])
+	b.Add(http.MethodPost, "/products/:id/images", "images", images.Endpoints["Upload"])
+	b.Add(http.MethodDelete, "/products/:id/images/:image_id", "images", images.Endpoints["Delete"])
+	b.Add(http.MethodGet, "/products/:id/stock", "inventory", inventory.Endpoints["GetAll"])
+	b.Add(http.MethodPost, "/products/:id/stock/adjustments", "inventory", inventory.Endpoints["Adjust"])
+	b.Add(http.MethodPost, "/products/:id/reservations", "inventory", inventory.Endpoints["Reserve"])
+	b.Add(http.MethodDelete, "/products/:id/reservations/:reservation_id", "inventory", inventory.Endpoints["Release"])
+
+	b.Add(http.MethodGet, "/vendors", "vendors", vendors.Endpoints["GetAll"])
+	b.Add(http.MethodPost, "/vendors", "vendors", vendors.Endpoints["Create"])
+	b.Add(http.MethodGet, "/vendors/:id", "vendors", vendors.Endpoints["GetByID"])
+	b.Add(http.MethodPut, "/vendors/:id", "vendors", vendors.Endpoints["Update"])
+	b.Add(http.MethodDelete, "/vendors/:id", "vendors", vendors.Endpoints["Delete"])
+	b.Add(http.MethodGet, "/vendors/:id/products", "vendors", products.Endpoints["GetAllByVendor"])
+
+	b.Add(http.MethodGet, "/categories", "categories", categories.Endpoints["GetAll"])
+	b.Add(http.MethodPost, "/categories", "categories", categories.Endpoints["Create"])
+	b.Add(http.MethodGet, "/categories/:id", "categories", categories.Endpoints["GetByID"])
+	b.Add(http.MethodPut, "/categories/:id", "categories", categories.Endpoints["Update"])
+	b.Add(http.MethodDelete, "/categories/:id", "categories", categories.Endpoints["Delete"])
+
+	b.Add(http.MethodGet, "/admin/currency-rates", "admin", admin(currencies.Endpoints["GetRates"]))
+	b.Add(http.MethodPut, "/admin/currency-rates/:currency", "admin", admin(currencies.Endpoints["SetRate"]))
+	b.Add(http.MethodGet, "/admin/config", "admin", admin(configs.Endpoints["Get"]))
+	b.Add(http.MethodPost, "/admin/config/reload", "admin", admin(configs.Endpoints["Reload"]))
+	b.Add(http.MethodPost, "/admin/events/republish", "admin", admin(events.Endpoints["Republish"]))
+
+	b.Add(http.MethodGet, "/metrics", "metrics", openapi.Endpoint{
+		Summary:      "Get Prometheus metrics",
+		Status:       http.StatusOK,
+		Response:     "",
+		ResponseType: openapi.MIMETextPlain,
+	})
+
+	return b.Document()
+}
+
+// admin returns an endpoint of the /admin group, which requires the bearer token of the admin API.
+func admin(e openapi.Endpoint) openapi.Endpoint {
+	e.Description = strings.TrimSpace(e.Description + " Requires `Authorization: Bearer <admin token>`.")
+	e.Errors = append(slices.Clone(e.Errors), http.StatusUnauthorized, http.StatusForbidden)
+
+	return e
+}
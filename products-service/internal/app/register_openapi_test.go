@@ -0,0 +1,56 @@
+package app
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/at-kh/guru-apps-test-services/platform/responder"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/config"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// TestOpenAPIDocumentCoversRoutes fails when a registered route is not described in the OpenAPI document or
+// the document describes a route which is not registered.
+func TestOpenAPIDocumentCoversRoutes(t *testing.T) {
+	a := &App{cfg: &config.Config{}, logger: zap.NewNop(), responder: responder.New()}
+	a.registerHTTPHandlers()
+
+	router := fiber.New()
+	a.registerHTTPRoutes(router)
+
+	doc := a.openAPIDocument()
+
+	registered := map[string]bool{}
+	for _, route := range router.GetRoutes(true) {
+		if route.Method == http.MethodHead {
+			continue
+		}
+
+		specPath, ok := strings.CutPrefix(route.Path, apiBasePath)
+		require.True(t, ok, "route %s %s is outside of %s", route.Method, route.Path, apiBasePath)
+
+		segments := strings.Split(specPath, "/")
+		for i, segment := range segments {
+			if name, ok := strings.CutPrefix(segment, ":"); ok {
+				segments[i] = "{" + name + "}"
+			}
+		}
+		specPath = strings.Join(segments, "/")
+
+		method := strings.ToLower(route.Method)
+		registered[method+" "+specPath] = true
+
+		_, ok = doc.Paths[specPath][method]
+		assert.True(t, ok, "route %s %s is not described in the OpenAPI document", route.Method, route.Path)
+	}
+
+	for specPath, item := range doc.Paths {
+		for method := range item {
+			assert.True(t, registered[method+" "+specPath], "%s %s is described but not registered", method, specPath)
+		}
+	}
+}
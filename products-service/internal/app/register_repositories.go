@@ -1,12 +1,92 @@
 package app
 
 import (
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/categories"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/currency_rates"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/images"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/inventory"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/lru_cache"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/memory_images"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/memory_products"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/memory_storage"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/pg_cache"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/prices"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/product_changes"
 	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/products"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/products_cache"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/s3_storage"
 	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/sqs_publisher"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/vendors"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/config"
+	"go.uber.org/zap"
 )
 
 // registerRepositories registers repositories.
 func (a *App) registerRepositories() {
-	a.productsRepository = products.NewRepository(a.db, a.logger)
+	if a.memoryStorage {
+		a.registerMemoryRepositories()
+		return
+	}
+
+	a.productsRepository = products.NewRepositoryWithReads(a.db, a.dbRouter, a.logger)
+	a.productsTxRepository = products.NewTxRepository(a.db, a.logger)
+	a.productsPrimaryRepository = products.NewRepository(a.db, a.logger)
+	a.productChangesRepository = product_changes.NewRepository(a.db, a.logger)
+	a.registerProductsCache()
+	a.vendorsRepository = vendors.NewRepository(a.db, a.logger)
+	a.categoriesRepository = categories.NewRepository(a.db, a.logger)
+	a.pricesRepository = prices.NewRepository(a.db, a.logger)
+	a.inventoryRepository = inventory.NewRepository(a.db, a.logger)
+	a.currencyRatesRepository = currency_rates.NewRepository(a.db, a.logger)
+	a.imagesRepository = images.NewRepository(a.db, a.logger)
+	a.objectStorageRepository = s3_storage.NewRepository(a.s3Client, a.s3Presigner, a.cfg.Storage.S3.Bucket,
+		a.cfg.Storage.S3.PresignTTL, a.logger)
+	a.sqsPublisherRepository = sqs_publisher.NewRepository(a.sqsClient, a.cfg.Delivery.Broker.URL, a.logger)
+}
+
+// registerMemoryRepositories registers repositories storing products, their changes, images and objects in memory,
+// messages are published to the broker as usual.
+func (a *App) registerMemoryRepositories() {
+	if a.cfg.Storage.Cache.Driver == config.CacheDriverPostgres {
+		a.logger.Fatal("postgres cache driver can't be used with memory storage")
+	}
+
+	memoryProducts := memory_products.NewRepository(a.logger)
+	a.productsRepository = memoryProducts
+	a.productsTxRepository = memoryProducts
+	a.productsPrimaryRepository = memoryProducts
+	a.productChangesRepository = memoryProducts
+	a.registerProductsCache()
+	a.imagesRepository = memory_images.NewRepository(a.logger)
+	a.objectStorageRepository = memory_storage.NewRepository(a.logger)
 	a.sqsPublisherRepository = sqs_publisher.NewRepository(a.sqsClient, a.cfg.Delivery.Broker.URL, a.logger)
 }
+
+// registerProductsCache wraps products repositories with cache of reads configured by the cache driver.
+func (a *App) registerProductsCache() {
+	cfg := a.cfg.Storage.Cache
+
+	switch cfg.Driver {
+	case config.CacheDriverNone:
+		return
+	case config.CacheDriverLRU:
+		if cfg.LRUSize <= 0 {
+			a.logger.Fatal("lru-size of cache must be positive", zap.Int("lru_size", cfg.LRUSize))
+		}
+		a.cacheRepository = lru_cache.NewRepository(cfg.LRUSize, a.logger)
+	case config.CacheDriverPostgres:
+		a.cacheRepository = pg_cache.NewRepository(a.db, a.logger)
+	default:
+		a.logger.Fatal("unknown cache driver", zap.String("driver", cfg.Driver))
+	}
+
+	var primary products_cache.PrimaryLSNReader // nil when reads are not routed to replicas
+	if a.dbRouter.HasReplicas() {
+		primary = a.dbRouter
+	}
+
+	cached := products_cache.NewRepository(a.productsRepository, primary, a.cacheRepository,
+		cfg.ProductTTL, cfg.ListTTL, a.metrics, a.logger)
+	a.productsRepository = cached
+	a.productsCacheRepository = cached
+}
@@ -1,10 +1,34 @@
 package app
 
 import (
+	"github.com/at-kh/guru-apps-test-services/platform/reload"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/services/categories"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/services/changes"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/services/currencies"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/services/events"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/services/images"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/services/inventory"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/services/prices"
 	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/services/products"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/services/vendors"
 )
 
 // registerServices register services in-app struct.
 func (a *App) registerServices() {
-	a.productsService = products.NewService(a.db, a.productsRepository, a.sqsPublisherRepository, a.metrics, a.logger)
+	a.productsService = products.NewService(a.productsRepository, a.productsTxRepository, a.productsCacheRepository,
+		a.vendorsRepository, a.imagesRepository, a.objectStorageRepository, a.currencyRatesRepository,
+		a.sqsPublisherRepository, a.metrics, a.logger)
+	a.imagesService = images.NewService(a.productsRepository, a.imagesRepository, a.objectStorageRepository,
+		a.logger)
+	a.vendorsService = vendors.NewService(a.vendorsRepository, a.productsCacheRepository, a.logger)
+	a.categoriesService = categories.NewService(a.db, a.categoriesRepository, a.productsCacheRepository, a.logger)
+	a.pricesService = prices.NewService(a.db, a.productsRepository, a.productsCacheRepository, a.pricesRepository,
+		a.currencyRatesRepository, a.logger)
+	a.inventoryService = inventory.NewService(a.db, a.productsRepository, a.inventoryRepository,
+		a.sqsPublisherRepository, a.logger)
+	a.currenciesService = currencies.NewService(a.currencyRatesRepository, a.logger)
+	a.eventsService = events.NewService(a.productsPrimaryRepository, a.sqsPublisherRepository, a.logger)
+	a.changesService = changes.NewService(a.productChangesRepository, a.productsPrimaryRepository, a.logger)
+	a.configsService = reload.NewService(a.loadConfig, a.cfg, a.applyLiveConfig, a.metrics.ConfigReloadsCounter,
+		a.logger)
 }
@@ -2,24 +2,61 @@ package app
 
 import (
 	"context"
-	"sync"
+	"errors"
+	"syscall"
+
+	"github.com/at-kh/guru-apps-test-services/platform/lifecycle"
 )
 
-// runWorkers run workers.
-func (a *App) runWorkers(ctx context.Context) {
-	workers := []worker{
-		serveHTTP,
+// runWorkers runs components until ctx is done. They are started in order and stopped in reverse order once
+// readiness turns false: servers stop accepting and drain requests, background workers stop, connections
+// to the database are closed and the logger is flushed. Metrics are scraped and events are published
+// within requests, so nothing is left to flush for them.
+func (a *App) runWorkers(ctx context.Context) error {
+	a.lifecycle.Add(a.closer("logger", a.syncLogger))
+	if a.dbRouter != nil {
+		a.lifecycle.Add(a.closer("database", a.dbRouter.Close))
+	}
+	a.lifecycle.Add(
+		a.worker("replicas check", checkReplicas),
+		a.worker("cache purge", purgeCache),
+		a.worker("changes purge", purgeChanges),
+		a.worker("configuration reload", reloadConfig),
+		serveGRPC(a),
+		serveHTTP(a),
+		// change streams are long-lived, they are closed before the HTTP server drains requests
+		a.closer("change streams", a.changesHTTPHandler.Close),
+	)
+
+	return a.lifecycle.Run(ctx)
+}
+
+// worker returns a component running work until it is stopped.
+func (a *App) worker(name string, work worker) lifecycle.Component {
+	return lifecycle.Component{
+		Name: name,
+		Run: func(ctx context.Context) error {
+			work(ctx, a)
+			return nil
+		},
+		StopTimeout: a.cfg.Lifecycle.StopTimeout,
 	}
+}
 
-	wg := new(sync.WaitGroup)
-	wg.Add(len(workers))
+// closer returns a component closed by closeFn when it is stopped.
+func (a *App) closer(name string, closeFn func() error) lifecycle.Component {
+	return lifecycle.Component{
+		Name:        name,
+		Stop:        func(context.Context) error { return closeFn() },
+		StopTimeout: a.cfg.Lifecycle.StopTimeout,
+	}
+}
 
-	for _, work := range workers {
-		go func(ctx context.Context, work func(context.Context, *App), t *App) {
-			work(ctx, t)
-			wg.Done()
-		}(ctx, work, a)
+// syncLogger flushes buffered logs, errors of syncing terminals which don't support it are ignored.
+func (a *App) syncLogger() error {
+	if err := a.logger.Sync(); err != nil && !errors.Is(err, syscall.EINVAL) && !errors.Is(err, syscall.ENOTTY) {
+		return err
 	}
 
-	wg.Wait()
+	return nil
 }
@@ -0,0 +1,14 @@
+package app
+
+import (
+	"context"
+)
+
+// checkReplicas checks health and lag of read replicas until ctx is done.
+func checkReplicas(ctx context.Context, app *App) {
+	if !app.dbRouter.HasReplicas() {
+		return
+	}
+
+	app.dbRouter.Run(ctx, app.cfg.Storage.Postgres.ReplicaCheckInterval)
+}
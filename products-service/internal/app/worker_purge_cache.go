@@ -0,0 +1,35 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/pg_cache"
+	"go.uber.org/zap"
+)
+
+// purgeCache removes expired entries of the cache stored in Postgres every purge interval until ctx is done.
+func purgeCache(ctx context.Context, app *App) {
+	cache, ok := app.cacheRepository.(*pg_cache.Repository)
+	if !ok || app.cfg.Storage.Cache.PurgeInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(app.cfg.Storage.Cache.PurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := cache.Purge(ctx)
+			if err != nil {
+				app.logger.Error("failed to purge expired cache entries", zap.Error(err))
+				continue
+			}
+
+			app.logger.Debug("expired cache entries purged", zap.Int64("count", purged))
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// purgeChanges removes changes of products older than the retention of the change feed every purge interval
+// until ctx is done, streams can't resume from purged changes.
+func purgeChanges(ctx context.Context, app *App) {
+	feed := app.cfg.Delivery.ChangeFeed
+	if feed.Retention <= 0 || feed.PurgeInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(feed.PurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := app.productChangesRepository.Purge(ctx, time.Now().Add(-feed.Retention))
+			if err != nil {
+				app.logger.Error("failed to purge changes of products", zap.Error(err))
+				continue
+			}
+
+			app.logger.Debug("changes of products purged", zap.Int64("count", purged))
+		}
+	}
+}
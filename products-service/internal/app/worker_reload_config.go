@@ -0,0 +1,49 @@
+package app
+
+import (
+	"context"
+
+	"github.com/at-kh/guru-apps-test-services/platform/reload"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/config"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// reloadConfig reloads the configuration on SIGHUP and on changes of configuration files, which are checked
+// every watch interval, until ctx is done.
+func reloadConfig(ctx context.Context, app *App) {
+	paths := app.meta.ConfigPaths
+	if len(paths) == 0 {
+		paths = []string{config.DefaultPath}
+	}
+
+	reload.Watch(ctx, app.configsService, paths, app.cfg.Reload.WatchInterval)
+}
+
+// loadConfig loads the configuration to reload from yaml files and env with validation.
+func (a *App) loadConfig() (*config.Config, error) {
+	cfg, err := config.InitConfig(a.meta.ConfigPaths...)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = zapcore.ParseLevel(cfg.Logger.Level); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// applyLiveConfig applies settings of the configuration which are safe to change without restart,
+// they are tagged reload:"live" in config.Config.
+func (a *App) applyLiveConfig(cfg *config.Config) {
+	if err := a.logLevel.UnmarshalText([]byte(cfg.Logger.Level)); err != nil {
+		a.logger.Error("failed to set log level", zap.Error(err))
+	}
+	if a.dbRouter != nil {
+		a.dbRouter.SetMaxLag(cfg.Storage.Postgres.ReplicaMaxLag)
+	}
+	a.imagesHTTPHandler.SetMaxSizeBytes(cfg.Storage.S3.MaxImageSizeBytes)
+	a.productsHTTPHandler.SetCacheMaxAge(cfg.Storage.Cache.HTTPMaxAge)
+	a.changesHTTPHandler.SetMaxStreamsPerClient(cfg.Delivery.ChangeFeed.MaxStreamsPerClient)
+}
@@ -0,0 +1,73 @@
+package app
+
+import (
+	"context"
+	"net"
+
+	"github.com/at-kh/guru-apps-test-services/platform/lifecycle"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/delivery/grpc/interceptors"
+	productsv1 "github.com/at-kh/guru-apps-test-services/products-service/pkg/pb/products/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+// newGRPCServer creates gRPC server with registered services, health checking and reflection.
+func (a *App) newGRPCServer() (*grpc.Server, *health.Server) {
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(interceptors.UnaryRecover(a.logger), interceptors.UnaryErrors()),
+		grpc.ChainStreamInterceptor(interceptors.StreamRecover(a.logger), interceptors.StreamErrors()),
+	)
+
+	productsv1.RegisterProductServiceServer(server, a.productsGRPCHandler)
+
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus(productsv1.ProductService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(server, healthServer)
+
+	reflection.Register(server)
+
+	return server, healthServer
+}
+
+// serveGRPC serves gRPC, health checks report not serving once the application is not ready, on stop the server
+// drains in-flight calls until the graceful timeout and closes remaining connections after it.
+func serveGRPC(app *App) lifecycle.Component {
+	var (
+		server, healthServer = app.newGRPCServer()
+		listener             net.Listener
+		address              = app.cfg.Delivery.GRPCServer.ListenAddress
+	)
+
+	return lifecycle.Component{
+		Name: "gRPC server",
+		Start: func(context.Context) (err error) {
+			if listener, err = net.Listen("tcp", address); err != nil {
+				return err
+			}
+
+			app.logger.Info("gRPC server started", zap.String("address", address))
+			return nil
+		},
+		Run:     func(context.Context) error { return server.Serve(listener) },
+		Unready: healthServer.Shutdown,
+		Stop: func(ctx context.Context) error {
+			stopped := make(chan struct{})
+			go func() {
+				server.GracefulStop()
+				close(stopped)
+			}()
+
+			select {
+			case <-stopped:
+				return nil
+			case <-ctx.Done():
+				server.Stop()
+				return ctx.Err()
+			}
+		},
+		StopTimeout: app.cfg.Delivery.GRPCServer.GracefulTimeout,
+	}
+}
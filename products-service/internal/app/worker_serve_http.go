@@ -1,57 +1,52 @@
 package app
 
 import (
-	"context"
-	"errors"
-	"net/http"
-
+	"github.com/at-kh/guru-apps-test-services/platform/lifecycle"
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/dbrouter"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/compress"
 	"github.com/gofiber/fiber/v2/middleware/favicon"
 	"github.com/gofiber/fiber/v2/middleware/recover"
 	"github.com/gofiber/fiber/v2/middleware/requestid"
-	"go.uber.org/zap"
 )
 
-// serveHTTP serves HTTP.
-func serveHTTP(ctx context.Context, app *App) {
+// newHTTPRouter creates HTTP router with middlewares and registered routes.
+func (a *App) newHTTPRouter() *fiber.App {
 	router := fiber.New(fiber.Config{
 		Prefork:                  false,
-		ReadTimeout:              app.cfg.Delivery.HTTPServer.ReadTimeout,
-		WriteTimeout:             app.cfg.Delivery.HTTPServer.WriteTimeout,
+		ReadTimeout:              a.cfg.Delivery.HTTPServer.ReadTimeout,
+		WriteTimeout:             a.cfg.Delivery.HTTPServer.WriteTimeout,
 		Network:                  fiber.NetworkTCP4,
-		BodyLimit:                app.cfg.Delivery.HTTPServer.BodySizeLimitBytes,
-		AppName:                  app.meta.Info.Name,
-		ErrorHandler:             app.responder.HandleError,
+		BodyLimit:                a.cfg.Delivery.HTTPServer.BodySizeLimitBytes,
+		AppName:                  a.meta.Info.Name,
+		ErrorHandler:             a.responder.HandleError,
 		EnableTrustedProxyCheck:  true,
+		ProxyHeader:              a.cfg.Delivery.HTTPServer.ProxyHeader,
+		TrustedProxies:           a.cfg.Delivery.HTTPServer.TrustedProxies,
+		EnableIPValidation:       true,
 		EnableSplittingOnParsers: true,
+		DisableStartupMessage:    true,
 	})
 
-	app.registerHTTPRoutes(router)
-
-	// Middlewares
-	router.Use(compress.New(compress.Config{Level: compress.LevelBestSpeed}))
+	// Middlewares, registered before routes to run for them
+	router.Use(compress.New(compress.Config{
+		// events of the change feed are flushed one by one, compressed ones would be buffered
+		Next:  func(c *fiber.Ctx) bool { return c.Path() == apiBasePath+"/products/events" },
+		Level: compress.LevelBestSpeed,
+	}))
 	router.Use(requestid.New())
+	router.Use(dbrouter.Middleware(a.dbRouter))
 	router.Use(recover.New())
 	router.Use(favicon.New())
 
-	go func() {
-		<-ctx.Done()
-		app.logger.Info("HTTP server: initiating graceful shutdown")
-
-		sdCtx, cancel := context.WithTimeout(context.Background(), app.cfg.Delivery.HTTPServer.GracefulTimeout)
-		defer cancel()
+	a.registerHTTPRoutes(router)
 
-		if err := router.Shutdown(); err != nil {
-			app.logger.Error("HTTP server shutdown error", zap.Error(err))
-		}
-
-		<-sdCtx.Done()
-		app.logger.Info("HTTP server stopped gracefully")
-	}()
+	return router
+}
 
-	err := router.Listen(app.cfg.Delivery.HTTPServer.ListenAddress)
-	if err != nil && !errors.Is(err, http.ErrServerClosed) {
-		app.logger.Fatal("failed to start HTTP server", zap.Error(err))
-	}
+// serveHTTP serves HTTP, on stop the server stops accepting connections and drains in-flight requests
+// until the graceful timeout.
+func serveHTTP(app *App) lifecycle.Component {
+	cfg := app.cfg.Delivery.HTTPServer
+	return lifecycle.HTTPServer(app.newHTTPRouter(), cfg.ListenAddress, cfg.GracefulTimeout, app.logger)
 }
@@ -9,17 +9,48 @@ import (
 // DefaultPath - default path for config.
 const DefaultPath = "./cmd/config.yaml"
 
+// Drivers of cache of product reads.
+const (
+	CacheDriverNone     = "none"     // reads are not cached
+	CacheDriverLRU      = "lru"      // in-process cache, invalidations are not seen by other instances
+	CacheDriverPostgres = "postgres" // cache shared by instances in the cache_entries table
+)
+
 type (
 	// Config defines the properties of the application configuration.
 	Config struct {
-		Delivery Delivery `yaml:"delivery" valid:"check,deep"`
-		Storage  Storage  `yaml:"storage"  valid:"check,deep"`
+		Delivery  Delivery  `yaml:"delivery"  valid:"check,deep"`
+		Storage   Storage   `yaml:"storage"   valid:"check,deep"`
+		Logger    Logger    `yaml:"logger"    valid:"check,deep"`
+		Reload    Reload    `yaml:"reload"`
+		Lifecycle Lifecycle `yaml:"lifecycle" valid:"check,deep"`
+	}
+
+	// Logger defines the logger section of the application configuration.
+	Logger struct {
+		Level string `yaml:"level" valid:"required" reload:"live"` // debug, info, warn or error
+	}
+
+	// Reload defines reloads of the configuration without restart, on SIGHUP or changes of files.
+	Reload struct {
+		WatchInterval time.Duration `yaml:"watch-interval"` // interval of checks of files, 0 disables them
+	}
+
+	// Lifecycle defines startup and shutdown of the application, HTTP and gRPC servers drain requests by their
+	// graceful timeouts.
+	Lifecycle struct {
+		// time between readiness turns false and servers stop accepting, so load balancers stop routing to them
+		ReadinessDelay time.Duration `yaml:"readiness-delay"`
+		// deadline of stopping other components, e.g. background workers or connections to the database
+		StopTimeout time.Duration `yaml:"stop-timeout" valid:"required"`
 	}
 
 	// Delivery defines API server configuration.
 	Delivery struct {
 		HTTPServer HTTPServer `yaml:"http-server" valid:"check,deep"`
+		GRPCServer GRPCServer `yaml:"grpc-server" valid:"check,deep"`
 		Broker     Broker     `yaml:"broker" valid:"check,deep"`
+		ChangeFeed ChangeFeed `yaml:"change-feed" valid:"check,deep"`
 	}
 
 	// HTTPServer defines the HTTP section of the API server configuration.
@@ -29,6 +60,17 @@ type (
 		WriteTimeout       time.Duration `yaml:"write-timeout"   valid:"required"`
 		GracefulTimeout    time.Duration `yaml:"graceful-timeout" valid:"required"`
 		BodySizeLimitBytes int           `yaml:"body-size-limit" valid:"required"`
+		AdminToken         string        `yaml:"admin-token" secret:"true"` // bearer token of /admin, disabled when empty
+		ErrorFormat        string        `yaml:"error-format"`              // problem or legacy, problem by default
+		// header of the client address set by proxies, e.g. X-Real-IP, it is trusted from TrustedProxies only
+		ProxyHeader    string   `yaml:"proxy-header"`
+		TrustedProxies []string `yaml:"trusted-proxies"` // addresses or CIDR ranges of proxies
+	}
+
+	// GRPCServer defines the gRPC section of the API server configuration.
+	GRPCServer struct {
+		ListenAddress   string        `yaml:"listen-address"   valid:"required"`
+		GracefulTimeout time.Duration `yaml:"graceful-timeout" valid:"required"`
 	}
 
 	// Broker defines the message queue section of the API server configuration.
@@ -37,24 +79,63 @@ type (
 		Region string `valid:"check,deep"`
 	}
 
+	// ChangeFeed defines streams of committed changes of products to clients.
+	ChangeFeed struct {
+		// interval of checks of new changes
+		PollInterval time.Duration `yaml:"poll-interval" valid:"required"`
+		// interval of heartbeats, which keep idle streams open
+		HeartbeatInterval time.Duration `yaml:"heartbeat-interval" valid:"required"`
+		// concurrent streams per client, its address forwarded by trusted proxies when http-server.proxy-header is set
+		MaxStreamsPerClient int `yaml:"max-streams-per-client" valid:"required" reload:"live"`
+		// streams resume from changes younger than retention, changes are kept forever when it is 0
+		Retention     time.Duration `yaml:"retention"`
+		PurgeInterval time.Duration `yaml:"purge-interval"` // interval of purges of changes older than retention
+	}
+
 	// Storage defines the storage section of the API server configuration.
 	Storage struct {
 		Postgres Postgres `yaml:"postgres" valid:"check,deep"`
+		S3       S3       `yaml:"s3"       valid:"check,deep"`
+		Cache    Cache    `yaml:"cache"    valid:"check,deep"`
 	}
 
 	// Postgres defines the Postgres section of the API server configuration.
 	Postgres struct {
-		DSN                string        `yaml:"dsn"                   valid:"required"`
-		Driver             string        `yaml:"driver"                valid:"required"`
-		Dialect            string        `yaml:"dialect"               valid:"required"`
-		MigrationDirectory string        `yaml:"migration-directory"   valid:"required"`
-		MigrationDirection string        `yaml:"migration-direction"   valid:"required"`
-		ConnMaxLifetime    time.Duration `yaml:"conn-max-lifetime"`
-		RetryDelay         time.Duration `yaml:"retry-delay"           valid:"required"`
-		QueryTimeout       time.Duration `yaml:"query-timeout"`
-		ConnMaxIdleNum     int           `yaml:"conn-max-idle-num"     valid:"required"`
-		ConnMaxOpenNum     int           `yaml:"conn-max-open-num"     valid:"required"`
-		MaxRetries         int           `yaml:"max-retries"           valid:"required,min=0"`
-		AutoMigrate        bool          `yaml:"auto-migrate"`
+		DSN                  string        `yaml:"dsn"                    valid:"required" secret:"true"`
+		ReplicaDSNs          []string      `yaml:"replica-dsns"                            secret:"true"`
+		Driver               string        `yaml:"driver"                 valid:"required"`
+		Dialect              string        `yaml:"dialect"                valid:"required"`
+		MigrationDirectory   string        `yaml:"migration-directory"    valid:"required"`
+		MigrationDirection   string        `yaml:"migration-direction"    valid:"required"`
+		ConnMaxLifetime      time.Duration `yaml:"conn-max-lifetime"`
+		RetryDelay           time.Duration `yaml:"retry-delay"            valid:"required"`
+		QueryTimeout         time.Duration `yaml:"query-timeout"`
+		ReplicaMaxLag        time.Duration `yaml:"replica-max-lag"        valid:"required" reload:"live"`
+		ReplicaCheckInterval time.Duration `yaml:"replica-check-interval" valid:"required"`
+		ConnMaxIdleNum       int           `yaml:"conn-max-idle-num"      valid:"required"`
+		ConnMaxOpenNum       int           `yaml:"conn-max-open-num"      valid:"required"`
+		MaxRetries           int           `yaml:"max-retries"            valid:"required,min=0"`
+		AutoMigrate          bool          `yaml:"auto-migrate"`
+	}
+
+	// S3 defines the S3 object storage section of the API server configuration.
+	S3 struct {
+		Endpoint          string        `yaml:"endpoint"`
+		PublicEndpoint    string        `yaml:"public-endpoint"` // endpoint in presigned URLs, Endpoint when empty
+		Region            string        `yaml:"region"         valid:"required"`
+		Bucket            string        `yaml:"bucket"         valid:"required"`
+		UsePathStyle      bool          `yaml:"use-path-style"`
+		PresignTTL        time.Duration `yaml:"presign-ttl"    valid:"required"`
+		MaxImageSizeBytes int64         `yaml:"max-image-size" valid:"required" reload:"live"`
+	}
+
+	// Cache defines the cache section of product reads of the API server configuration.
+	Cache struct {
+		Driver        string        `yaml:"driver"         valid:"required"` // one of CacheDriver* values
+		LRUSize       int           `yaml:"lru-size"`
+		ProductTTL    time.Duration `yaml:"product-ttl"`
+		ListTTL       time.Duration `yaml:"list-ttl"`
+		PurgeInterval time.Duration `yaml:"purge-interval"`
+		HTTPMaxAge    time.Duration `yaml:"http-max-age"   reload:"live"` // max-age of Cache-Control of product reads
 	}
 )
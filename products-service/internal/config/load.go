@@ -1,55 +1,53 @@
 package config
 
 import (
-	"errors"
 	"fmt"
-	"os"
-	"reflect"
 
-	"gopkg.in/yaml.v3"
+	platformconfig "github.com/at-kh/guru-apps-test-services/platform/config"
 )
 
-// InitConfig - init configuration from yaml and env.
-func InitConfig(configPath string) (*Config, error) {
-	cfg := &Config{}
-
-	if err := loadYAML(configPath, cfg); err != nil {
-		return nil, fmt.Errorf("failed to load yaml: %w", err)
-	}
+// envAliases - short env names of fields kept for compatibility, derived names take precedence over them.
+var envAliases = map[string]string{
+	"DELIVERY_HTTP_SERVER_LISTEN_ADDRESS": "HTTP_ADDRESS",
+	"DELIVERY_HTTP_SERVER_ADMIN_TOKEN":    "ADMIN_TOKEN",
+	"DELIVERY_GRPC_SERVER_LISTEN_ADDRESS": "GRPC_ADDRESS",
+	"DELIVERY_BROKER_URL":                 "SQS_URL",
+	"DELIVERY_BROKER_REGION":              "SQS_REGION",
+	"STORAGE_POSTGRES_DSN":                "DB_DSN",
+	"STORAGE_POSTGRES_REPLICA_DSNS":       "DB_REPLICA_DSNS",
+	"STORAGE_S3_ENDPOINT":                 "S3_ENDPOINT",
+	"STORAGE_S3_PUBLIC_ENDPOINT":          "S3_PUBLIC_ENDPOINT",
+	"STORAGE_S3_REGION":                   "S3_REGION",
+	"STORAGE_S3_BUCKET":                   "S3_BUCKET",
+	"STORAGE_CACHE_DRIVER":                "CACHE_DRIVER",
+}
 
-	if err := cfg.overrideEnv(); err != nil {
-		return nil, fmt.Errorf("failed to load env: %w", err)
+// InitConfig - init configuration from yaml and env. Files are merged in order, so later files overlay keys
+// they set, e.g. an environment overlay over the base file. DefaultPath is loaded when no paths are given.
+func InitConfig(paths ...string) (*Config, error) {
+	cfg, err := Load(paths...)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := cfg.Validate(); err != nil {
-		return nil, fmt.Errorf("configuration validation error: %v", err)
+	if errs := cfg.Validate(); len(errs) > 0 {
+		return nil, fmt.Errorf("configuration validation error: %v", errs)
 	}
 
 	return cfg, nil
 }
 
-// loadYAML - load config from yaml.
-func loadYAML(path string, dest any) error {
-	if reflect.TypeOf(dest).Kind() != reflect.Ptr {
-		return errors.New("config destination must be pointer")
+// Load - load configuration from yaml files and env without validation, names of env are derived from yaml keys
+// of fields, e.g. STORAGE_POSTGRES_CONN_MAX_OPEN_NUM, or are their aliases, e.g. DB_DSN.
+func Load(paths ...string) (*Config, error) {
+	if len(paths) == 0 {
+		paths = []string{DefaultPath}
 	}
 
-	data, err := os.ReadFile(path)
-	if err != nil {
-		if os.IsNotExist(err) {
-			return fmt.Errorf("configuration file not found: %s", path)
-		}
-
-		return fmt.Errorf("failed to read configuration file %s: %w", path, err)
-	}
-
-	if len(data) == 0 {
-		return fmt.Errorf("configuration file is empty: %s", path)
-	}
-
-	if err = yaml.Unmarshal(data, dest); err != nil {
-		return fmt.Errorf("failed to parse YAML configuration file %s: %w", path, err)
+	cfg := &Config{}
+	if err := platformconfig.Load(cfg, envAliases, paths...); err != nil {
+		return nil, err
 	}
 
-	return nil
+	return cfg, nil
 }
@@ -0,0 +1,9 @@
+package config
+
+import (
+	platformconfig "github.com/at-kh/guru-apps-test-services/platform/config"
+)
+
+// Redacted returns a copy of the config with passwords of fields tagged secret:"true" replaced, so it can
+// be printed or logged.
+func (cfg Config) Redacted() Config { return platformconfig.Redact(cfg) }
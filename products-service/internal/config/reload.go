@@ -0,0 +1,11 @@
+package config
+
+import (
+	platformconfig "github.com/at-kh/guru-apps-test-services/platform/config"
+)
+
+// MergeLive returns a copy of current with fields tagged reload:"live" taken from loaded, see
+// platformconfig.MergeLive.
+func MergeLive(current, loaded *Config) (merged *Config, applied, rejected []string) {
+	return platformconfig.MergeLive(current, loaded)
+}
@@ -11,6 +11,32 @@ func (c Config) Validate() []string {
 	if e := c.Storage.Validate(); len(e) > 0 {
 		errs = append(errs, e...)
 	}
+	if e := c.Logger.Validate(); len(e) > 0 {
+		errs = append(errs, e...)
+	}
+	if e := c.Lifecycle.Validate(); len(e) > 0 {
+		errs = append(errs, e...)
+	}
+
+	return errs
+}
+
+// Validate validates struct accordingly to fields tags
+func (l Logger) Validate() []string {
+	var errs []string
+	if l.Level == "" {
+		errs = append(errs, "level::is_required")
+	}
+
+	return errs
+}
+
+// Validate validates struct accordingly to fields tags
+func (l Lifecycle) Validate() []string {
+	var errs []string
+	if l.StopTimeout == 0 {
+		errs = append(errs, "stop_timeout::is_required")
+	}
 
 	return errs
 }
@@ -21,9 +47,15 @@ func (d Delivery) Validate() []string {
 	if e := d.HTTPServer.Validate(); len(e) > 0 {
 		errs = append(errs, e...)
 	}
+	if e := d.GRPCServer.Validate(); len(e) > 0 {
+		errs = append(errs, e...)
+	}
 	if e := d.Broker.Validate(); len(e) > 0 {
 		errs = append(errs, e...)
 	}
+	if e := d.ChangeFeed.Validate(); len(e) > 0 {
+		errs = append(errs, e...)
+	}
 
 	return errs
 }
@@ -50,6 +82,19 @@ func (h HTTPServer) Validate() []string {
 	return errs
 }
 
+// Validate validates struct accordingly to fields tags
+func (g GRPCServer) Validate() []string {
+	var errs []string
+	if g.ListenAddress == "" {
+		errs = append(errs, "listen_address::is_required")
+	}
+	if g.GracefulTimeout == 0 {
+		errs = append(errs, "graceful_timeout::is_required")
+	}
+
+	return errs
+}
+
 // Validate validates struct accordingly to fields tags
 func (b Broker) Validate() []string {
 	var errs []string
@@ -57,12 +102,34 @@ func (b Broker) Validate() []string {
 	return errs
 }
 
+// Validate validates struct accordingly to fields tags
+func (c ChangeFeed) Validate() []string {
+	var errs []string
+	if c.PollInterval == 0 {
+		errs = append(errs, "poll_interval::is_required")
+	}
+	if c.HeartbeatInterval == 0 {
+		errs = append(errs, "heartbeat_interval::is_required")
+	}
+	if c.MaxStreamsPerClient == 0 {
+		errs = append(errs, "max_streams_per_client::is_required")
+	}
+
+	return errs
+}
+
 // Validate validates struct accordingly to fields tags
 func (s Storage) Validate() []string {
 	var errs []string
 	if e := s.Postgres.Validate(); len(e) > 0 {
 		errs = append(errs, e...)
 	}
+	if e := s.S3.Validate(); len(e) > 0 {
+		errs = append(errs, e...)
+	}
+	if e := s.Cache.Validate(); len(e) > 0 {
+		errs = append(errs, e...)
+	}
 
 	return errs
 }
@@ -88,6 +155,12 @@ func (p Postgres) Validate() []string {
 	if p.RetryDelay == 0 {
 		errs = append(errs, "retry_delay::is_required")
 	}
+	if p.ReplicaMaxLag == 0 {
+		errs = append(errs, "replica_max_lag::is_required")
+	}
+	if p.ReplicaCheckInterval == 0 {
+		errs = append(errs, "replica_check_interval::is_required")
+	}
 	if p.ConnMaxIdleNum == 0 {
 		errs = append(errs, "conn_max_idle_num::is_required")
 	}
@@ -103,3 +176,32 @@ func (p Postgres) Validate() []string {
 
 	return errs
 }
+
+// Validate validates struct accordingly to fields tags
+func (s S3) Validate() []string {
+	var errs []string
+	if s.Region == "" {
+		errs = append(errs, "region::is_required")
+	}
+	if s.Bucket == "" {
+		errs = append(errs, "bucket::is_required")
+	}
+	if s.PresignTTL == 0 {
+		errs = append(errs, "presign_ttl::is_required")
+	}
+	if s.MaxImageSizeBytes == 0 {
+		errs = append(errs, "max_image_size_bytes::is_required")
+	}
+
+	return errs
+}
+
+// Validate validates struct accordingly to fields tags
+func (c Cache) Validate() []string {
+	var errs []string
+	if c.Driver == "" {
+		errs = append(errs, "driver::is_required")
+	}
+
+	return errs
+}
@@ -0,0 +1,208 @@
+package loadgen
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"slices"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+)
+
+// lagMetric - histogram of notifications-service observing time from publishing of events to their handling.
+const lagMetric = "notifications_service_event_lag_seconds"
+
+// lagPollInterval - interval of scraping metrics while published events are waited for.
+const lagPollInterval = 500 * time.Millisecond
+
+type (
+	// LagReport - end-to-end lag of events published by the load, estimated by buckets of the histogram as
+	// Prometheus histogram_quantile does. Events published by other clients during the load are included.
+	LagReport struct {
+		Published int           // events published by successful requests
+		Handled   int           // events handled during the load and the wait
+		Mean      time.Duration // mean lag
+		P50       time.Duration
+		P90       time.Duration
+		P99       time.Duration
+	}
+
+	// lagMeter - measures lag by the difference of the histogram before and after the load.
+	lagMeter struct {
+		httpClient *http.Client
+		url        string
+		before     histogram
+	}
+
+	// histogram - a histogram summed over its labels.
+	histogram struct {
+		count   uint64
+		sum     float64
+		buckets []bucket // sorted by upper bounds, +Inf is not included
+	}
+
+	// bucket - a bucket of a histogram with the cumulative count.
+	bucket struct {
+		upperBound float64
+		count      uint64
+	}
+)
+
+// startLag scrapes the histogram of lag before the load.
+func startLag(ctx context.Context, httpClient *http.Client, url string) (*lagMeter, error) {
+	m := &lagMeter{httpClient: httpClient, url: url}
+
+	before, err := m.scrape(ctx)
+	if err != nil {
+		return nil, err
+	}
+	m.before = before
+
+	return m, nil
+}
+
+// wait scrapes the histogram until published events are handled or timeout passes, lag is reported for events
+// handled by then.
+func (m *lagMeter) wait(ctx context.Context, published int, timeout time.Duration) (LagReport, error) {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		after, err := m.scrape(ctx)
+		if err != nil {
+			return LagReport{}, err
+		}
+
+		diff := after.sub(m.before)
+		if diff.count >= uint64(published) || !time.Now().Before(deadline) {
+			return diff.report(published), nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return diff.report(published), nil
+		case <-time.After(lagPollInterval):
+		}
+	}
+}
+
+// scrape reads the histogram from the metrics endpoint, it is empty until an event is handled.
+func (m *lagMeter) scrape(ctx context.Context) (histogram, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, m.url, http.NoBody)
+	if err != nil {
+		return histogram{}, err
+	}
+
+	resp, err := m.httpClient.Do(req)
+	if err != nil {
+		return histogram{}, fmt.Errorf("failed to scrape metrics: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return histogram{}, fmt.Errorf("failed to scrape metrics: unexpected status %d", resp.StatusCode)
+	}
+
+	parser := expfmt.NewTextParser(model.UTF8Validation)
+	families, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return histogram{}, fmt.Errorf("failed to parse metrics: %w", err)
+	}
+
+	return parseHistogram(families[lagMetric]), nil
+}
+
+// parseHistogram sums histograms of a family over their labels, buckets of all of them must be the same.
+func parseHistogram(family *dto.MetricFamily) histogram {
+	var h histogram
+	if family == nil {
+		return h
+	}
+
+	counts := make(map[float64]uint64)
+	for _, metric := range family.GetMetric() {
+		hist := metric.GetHistogram()
+		h.count += hist.GetSampleCount()
+		h.sum += hist.GetSampleSum()
+
+		for _, b := range hist.GetBucket() {
+			if !math.IsInf(b.GetUpperBound(), 1) {
+				counts[b.GetUpperBound()] += b.GetCumulativeCount()
+			}
+		}
+	}
+
+	for upperBound, count := range counts {
+		h.buckets = append(h.buckets, bucket{upperBound: upperBound, count: count})
+	}
+	slices.SortFunc(h.buckets, func(a, b bucket) int {
+		switch {
+		case a.upperBound < b.upperBound:
+			return -1
+		case a.upperBound > b.upperBound:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	return h
+}
+
+// sub returns observations of h which are not in before.
+func (h histogram) sub(before histogram) histogram {
+	diff := histogram{count: h.count - before.count, sum: h.sum - before.sum}
+
+	prev := make(map[float64]uint64, len(before.buckets))
+	for _, b := range before.buckets {
+		prev[b.upperBound] = b.count
+	}
+	for _, b := range h.buckets {
+		diff.buckets = append(diff.buckets, bucket{upperBound: b.upperBound, count: b.count - prev[b.upperBound]})
+	}
+
+	return diff
+}
+
+// quantile estimates the q quantile interpolating linearly within buckets, observations above the last bucket
+// are estimated by its upper bound.
+func (h histogram) quantile(q float64) float64 {
+	if h.count == 0 || len(h.buckets) == 0 {
+		return 0
+	}
+
+	rank := q * float64(h.count)
+	lowerBound, lowerCount := 0.0, uint64(0)
+	for _, b := range h.buckets {
+		if float64(b.count) >= rank {
+			if b.count == lowerCount {
+				return b.upperBound
+			}
+			return lowerBound + (b.upperBound-lowerBound)*(rank-float64(lowerCount))/float64(b.count-lowerCount)
+		}
+		lowerBound, lowerCount = b.upperBound, b.count
+	}
+
+	return h.buckets[len(h.buckets)-1].upperBound
+}
+
+// report returns the lag report of the histogram.
+func (h histogram) report(published int) LagReport {
+	r := LagReport{
+		Published: published,
+		Handled:   int(h.count),
+		P50:       seconds(h.quantile(0.5)),
+		P90:       seconds(h.quantile(0.9)),
+		P99:       seconds(h.quantile(0.99)),
+	}
+	if h.count > 0 {
+		r.Mean = seconds(h.sum / float64(h.count))
+	}
+
+	return r
+}
+
+func seconds(s float64) time.Duration { return time.Duration(s * float64(time.Second)) }
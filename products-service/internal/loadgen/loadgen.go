@@ -0,0 +1,348 @@
+// Package loadgen drives a mix of create, list and delete requests against the products API and reports
+// latency percentiles, errors by errs type and, when notifications-service metrics are set, end-to-end lag
+// of the published events.
+//
+// Requests are sent at a fixed rate when RPS is set, latencies are measured from the time requests are
+// scheduled at, so waiting for a busy worker is included. Otherwise workers send requests in a closed loop.
+package loadgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/seed"
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/client"
+	"github.com/google/uuid"
+)
+
+// Operations of the load.
+const (
+	OpCreate Op = "create"
+	OpList   Op = "list"
+	OpDelete Op = "delete"
+)
+
+// ops - operations in order of reports.
+var ops = []Op{OpCreate, OpList, OpDelete}
+
+// listLimit - page size of list requests, pages start at random offsets below listMaxOffset.
+const (
+	listLimit     = 20
+	listMaxOffset = 100
+)
+
+type (
+	// Op - operation of the load.
+	Op string
+
+	// Mix - weights of operations, e.g. create=2,list=7,delete=1 sends 70% of requests to list products.
+	Mix map[Op]int
+
+	// Config - configuration of the load.
+	Config struct {
+		URL         string        // base URL of products-service, e.g. http://localhost:10000
+		Mix         Mix           // weights of operations
+		RPS         float64       // requests per second, workers send requests in a closed loop when 0
+		Concurrency int           // number of workers, it bounds requests in flight
+		Duration    time.Duration // how long requests are sent
+		Timeout     time.Duration // timeout of requests
+		Seed        uint64        // seed of generated products and of the mix
+
+		MetricsURL string        // metrics of notifications-service, event lag is not measured when empty
+		LagTimeout time.Duration // how long published events are waited for to be handled after the load
+	}
+
+	// runner - state of a run shared by workers.
+	runner struct {
+		cfg    Config
+		client *client.Client
+		stats  map[Op]*OpStats // written under mu
+		runID  string          // suffix of product names, so runs with the same seed don't conflict
+
+		mu        sync.Mutex
+		rng       *rand.Rand
+		generator *seed.Generator
+		created   []uuid.UUID // products to delete
+		dropped   int
+	}
+)
+
+// ParseMix parses weights of operations, e.g. create=2,list=7,delete=1, omitted operations are not sent.
+func ParseMix(s string) (Mix, error) {
+	mix := Mix{}
+	for _, part := range strings.Split(s, ",") {
+		name, weight, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid mix %q, expected op=weight", part)
+		}
+
+		op := Op(strings.TrimSpace(name))
+		if !op.valid() {
+			return nil, fmt.Errorf("unknown operation %q, expected one of create, list, delete", op)
+		}
+
+		w, err := strconv.Atoi(strings.TrimSpace(weight))
+		if err != nil || w < 0 {
+			return nil, fmt.Errorf("weight of %s must be a non-negative integer, got %q", op, weight)
+		}
+		mix[op] = w
+	}
+
+	if mix.total() == 0 {
+		return nil, errors.New("mix must have a positive weight")
+	}
+
+	return mix, nil
+}
+
+// String implements fmt.Stringer.
+func (m Mix) String() string {
+	parts := make([]string, 0, len(m))
+	for _, op := range ops {
+		if w, ok := m[op]; ok {
+			parts = append(parts, string(op)+"="+strconv.Itoa(w))
+		}
+	}
+
+	return strings.Join(parts, ",")
+}
+
+func (m Mix) total() int {
+	total := 0
+	for _, w := range m {
+		total += w
+	}
+
+	return total
+}
+
+// pick returns an operation with probability proportional to its weight.
+func (m Mix) pick(rng *rand.Rand) Op {
+	n := rng.IntN(m.total())
+	for _, op := range ops {
+		if n < m[op] {
+			return op
+		}
+		n -= m[op]
+	}
+
+	return ops[len(ops)-1]
+}
+
+func (op Op) valid() bool { return op == OpCreate || op == OpList || op == OpDelete }
+
+// Validate returns errors of the configuration.
+func (cfg Config) Validate() error {
+	switch {
+	case cfg.URL == "":
+		return errors.New("url is required")
+	case cfg.Mix.total() <= 0:
+		return errors.New("mix must have a positive weight")
+	case cfg.RPS < 0:
+		return errors.New("rps must not be negative")
+	case cfg.Concurrency <= 0:
+		return errors.New("concurrency must be positive")
+	case cfg.Duration <= 0:
+		return errors.New("duration must be positive")
+	}
+
+	return nil
+}
+
+// Run sends requests for the duration and returns the report, the load is stopped early when ctx is done.
+// Published events are waited for until LagTimeout when MetricsURL is set.
+func Run(ctx context.Context, cfg Config) (Report, error) {
+	if err := cfg.Validate(); err != nil {
+		return Report{}, err
+	}
+
+	httpClient := &http.Client{
+		Timeout: cfg.Timeout,
+		Transport: &http.Transport{
+			MaxIdleConns:        cfg.Concurrency,
+			MaxIdleConnsPerHost: cfg.Concurrency,
+			IdleConnTimeout:     90 * time.Second,
+		},
+	}
+	defer httpClient.CloseIdleConnections()
+
+	r := &runner{
+		cfg: cfg,
+		// requests are not retried, so errors are reported as they are responded
+		client:    client.New(cfg.URL, client.WithHTTPClient(httpClient), client.WithRetries(0, 0, 0)),
+		stats:     make(map[Op]*OpStats, len(ops)),
+		runID:     uuid.NewString()[:8],
+		rng:       rand.New(rand.NewPCG(cfg.Seed, cfg.Seed)),
+		generator: seed.NewGenerator(cfg.Seed),
+	}
+	for _, op := range ops {
+		r.stats[op] = &OpStats{Errors: make(map[string]int)}
+	}
+
+	var lag *lagMeter
+	if cfg.MetricsURL != "" {
+		var err error
+		if lag, err = startLag(ctx, httpClient, cfg.MetricsURL); err != nil {
+			return Report{}, err
+		}
+	}
+
+	started := time.Now()
+	loadCtx, cancel := context.WithTimeout(ctx, cfg.Duration)
+	defer cancel()
+
+	if cfg.RPS > 0 {
+		r.runRate(loadCtx)
+	} else {
+		r.runClosed(loadCtx)
+	}
+
+	report := Report{
+		Config:   cfg,
+		Elapsed:  time.Since(started),
+		Ops:      r.stats,
+		Dropped:  r.dropped,
+		Finished: ctx.Err() == nil,
+	}
+
+	if lag != nil {
+		// every successful create and delete publishes an event
+		published := r.stats[OpCreate].Succeeded() + r.stats[OpDelete].Succeeded()
+
+		lagReport, err := lag.wait(ctx, published, cfg.LagTimeout)
+		if err != nil {
+			return report, err
+		}
+		report.Lag = &lagReport
+	}
+
+	return report, nil
+}
+
+// runClosed runs workers sending requests one after another until ctx is done.
+func (r *runner) runClosed(ctx context.Context) {
+	var wg sync.WaitGroup
+	for range r.cfg.Concurrency {
+		wg.Go(func() {
+			for ctx.Err() == nil {
+				r.do(ctx, time.Now())
+			}
+		})
+	}
+	wg.Wait()
+}
+
+// runRate schedules requests at the fixed rate until ctx is done, requests which can't be scheduled because all
+// workers are busy and the queue is full are dropped.
+func (r *runner) runRate(ctx context.Context) {
+	scheduled := make(chan time.Time, r.cfg.Concurrency)
+
+	var wg sync.WaitGroup
+	for range r.cfg.Concurrency {
+		wg.Go(func() {
+			for at := range scheduled {
+				r.do(ctx, at)
+			}
+		})
+	}
+
+	interval := time.Duration(float64(time.Second) / r.cfg.RPS)
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for next := time.Now(); ; next = next.Add(interval) {
+		timer.Reset(time.Until(next))
+		select {
+		case <-ctx.Done():
+			close(scheduled)
+			wg.Wait()
+			return
+		case <-timer.C:
+		}
+
+		select {
+		case scheduled <- next:
+		default:
+			r.mu.Lock()
+			r.dropped++
+			r.mu.Unlock()
+		}
+	}
+}
+
+// do sends a request of a random operation and records its latency since the time it was scheduled at.
+func (r *runner) do(ctx context.Context, scheduledAt time.Time) {
+	op, id := r.next()
+
+	var err error
+	switch op {
+	case OpCreate:
+		var p client.Product
+		if p, err = r.client.CreateProduct(ctx, r.product()); err == nil {
+			r.mu.Lock()
+			r.created = append(r.created, p.ID)
+			r.mu.Unlock()
+		}
+	case OpList:
+		r.mu.Lock()
+		offset := uint64(r.rng.IntN(listMaxOffset))
+		r.mu.Unlock()
+		_, err = r.client.GetProducts(ctx, client.ProductFilter{Limit: listLimit, Offset: offset})
+	case OpDelete:
+		err = r.client.DeleteProduct(ctx, id)
+	}
+
+	// requests interrupted by the end of the load are not results of the service
+	if err != nil && ctx.Err() != nil {
+		return
+	}
+
+	r.mu.Lock()
+	r.stats[op].record(time.Since(scheduledAt), err)
+	r.mu.Unlock()
+}
+
+// next picks an operation, products to delete are taken from created ones, a product is created instead when
+// there are none.
+func (r *runner) next() (Op, uuid.UUID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	op := r.cfg.Mix.pick(r.rng)
+	if op != OpDelete {
+		return op, uuid.Nil
+	}
+	if len(r.created) == 0 {
+		return OpCreate, uuid.Nil
+	}
+
+	i := r.rng.IntN(len(r.created))
+	id := r.created[i]
+	r.created[i] = r.created[len(r.created)-1]
+	r.created = r.created[:len(r.created)-1]
+
+	return OpDelete, id
+}
+
+// product returns a request creating a generated product.
+func (r *runner) product() client.CreateProductRequest {
+	r.mu.Lock()
+	p := r.generator.Product()
+	r.mu.Unlock()
+
+	return client.CreateProductRequest{
+		Name:        p.Name + " " + r.runID,
+		Vendor:      p.Vendor,
+		Description: p.Description,
+		Price:       p.Price,
+		Currency:    p.Currency,
+		Tags:        p.Tags,
+	}
+}
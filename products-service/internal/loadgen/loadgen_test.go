@@ -0,0 +1,190 @@
+package loadgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMix(t *testing.T) {
+	mix, err := ParseMix("create=2, list=7,delete=1")
+	require.NoError(t, err)
+	assert.Equal(t, Mix{OpCreate: 2, OpList: 7, OpDelete: 1}, mix)
+	assert.Equal(t, "create=2,list=7,delete=1", mix.String())
+
+	mix, err = ParseMix("list=1")
+	require.NoError(t, err)
+	assert.Equal(t, Mix{OpList: 1}, mix)
+
+	for _, s := range []string{"", "list", "update=1", "list=-1", "list=x", "list=0,create=0"} {
+		_, err = ParseMix(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestMixPick(t *testing.T) {
+	mix, rng := Mix{OpList: 3, OpDelete: 1}, rand.New(rand.NewPCG(1, 1))
+
+	picked := map[Op]int{}
+	for range 4000 {
+		picked[mix.pick(rng)]++
+	}
+
+	assert.Zero(t, picked[OpCreate])
+	assert.InDelta(t, 3000, picked[OpList], 200)
+	assert.InDelta(t, 1000, picked[OpDelete], 200)
+}
+
+func TestOpStats(t *testing.T) {
+	s := &OpStats{Errors: map[string]int{}}
+	for i := 100; i >= 1; i-- {
+		var err error
+		if i%10 == 0 {
+			err = errs.Conflict{What: "product"}
+		}
+		s.record(time.Duration(i)*time.Millisecond, err)
+	}
+
+	assert.Equal(t, 100, s.Requests())
+	assert.Equal(t, 10, s.Failed())
+	assert.Equal(t, 90, s.Succeeded())
+	assert.Equal(t, map[string]int{"errs.Conflict": 10}, s.Errors)
+	assert.Equal(t, time.Millisecond, s.Percentile(0))
+	assert.Equal(t, 50*time.Millisecond, s.Percentile(50))
+	assert.Equal(t, 99*time.Millisecond, s.Percentile(99))
+	assert.Equal(t, 100*time.Millisecond, s.Percentile(100))
+
+	assert.Zero(t, (&OpStats{}).Percentile(50))
+}
+
+func TestErrorKind(t *testing.T) {
+	assert.Equal(t, "errs.NotFound", ErrorKind(errs.NotFound{What: "product"}))
+	assert.Equal(t, "errs.FieldsValidation", ErrorKind(fmt.Errorf("create: %w", errs.FieldsValidation{})))
+	assert.Equal(t, errKindTimeout, ErrorKind(context.DeadlineExceeded))
+	assert.Equal(t, errKindTransport, ErrorKind(errors.New("connection refused")))
+}
+
+func TestHistogramQuantile(t *testing.T) {
+	before := histogram{count: 10, sum: 1, buckets: []bucket{{0.1, 10}, {0.2, 10}, {0.4, 10}}}
+	after := histogram{count: 110, sum: 21, buckets: []bucket{{0.1, 60}, {0.2, 110}, {0.4, 110}}}
+
+	diff := after.sub(before)
+	assert.Equal(t, uint64(100), diff.count)
+	assert.InDelta(t, 0.1, diff.quantile(0.5), 1e-9)  // the end of the first bucket
+	assert.InDelta(t, 0.18, diff.quantile(0.9), 1e-9) // 40% of the way through the second bucket
+	assert.InDelta(t, 0.05, diff.quantile(0.25), 1e-9)
+
+	report := diff.report(120)
+	assert.Equal(t, 120, report.Published)
+	assert.Equal(t, 100, report.Handled)
+	assert.Equal(t, 200*time.Millisecond, report.Mean)
+
+	assert.Zero(t, histogram{}.quantile(0.5))
+}
+
+func TestRun(t *testing.T) {
+	api := newFakeAPI()
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+
+	for name, cfg := range map[string]Config{
+		"closed loop": {Concurrency: 4},
+		"fixed rate":  {Concurrency: 4, RPS: 200},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cfg.URL = srv.URL
+			cfg.MetricsURL = srv.URL + "/metrics"
+			cfg.Mix = Mix{OpCreate: 2, OpList: 1, OpDelete: 1}
+			cfg.Duration = 300 * time.Millisecond
+			cfg.Timeout = time.Second
+			cfg.LagTimeout = 5 * time.Second
+
+			report, err := Run(context.Background(), cfg)
+			require.NoError(t, err)
+
+			assert.True(t, report.Finished)
+			assert.Positive(t, report.Ops[OpCreate].Requests())
+			assert.Positive(t, report.Ops[OpList].Requests())
+			assert.Positive(t, report.Ops[OpDelete].Requests())
+			assert.Positive(t, report.Ops[OpCreate].Errors["errs.Conflict"])
+			assert.Equal(t, report.Ops[OpCreate].Failed(), report.Ops[OpCreate].Errors["errs.Conflict"])
+
+			require.NotNil(t, report.Lag)
+			assert.GreaterOrEqual(t, report.Lag.Handled, report.Lag.Published)
+			assert.Positive(t, report.Lag.P99)
+
+			var out strings.Builder
+			require.NoError(t, report.Write(&out))
+			assert.Contains(t, out.String(), "errs.Conflict")
+			assert.Contains(t, out.String(), "PUBLISHED")
+		})
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	valid := Config{URL: "http://localhost", Mix: Mix{OpList: 1}, Concurrency: 1, Duration: time.Second}
+	require.NoError(t, valid.Validate())
+
+	for _, mutate := range []func(c *Config){
+		func(c *Config) { c.URL = "" },
+		func(c *Config) { c.Mix = nil },
+		func(c *Config) { c.RPS = -1 },
+		func(c *Config) { c.Concurrency = 0 },
+		func(c *Config) { c.Duration = 0 },
+	} {
+		cfg := valid
+		mutate(&cfg)
+		assert.Error(t, cfg.Validate())
+	}
+}
+
+// fakeAPI - products API conflicting on every third create, events of successful writes are reported as handled
+// with 5ms lag by its metrics.
+type fakeAPI struct {
+	creates atomic.Int64
+	events  atomic.Int64
+}
+
+func newFakeAPI() *fakeAPI { return &fakeAPI{} }
+
+func (f *fakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == "/metrics":
+		n := f.events.Load()
+		_, _ = fmt.Fprintf(w, "# TYPE %s histogram\n", lagMetric)
+		_, _ = fmt.Fprintf(w, "%s_bucket{event_type=\"create_product\",le=\"0.001\"} 0\n", lagMetric)
+		_, _ = fmt.Fprintf(w, "%s_bucket{event_type=\"create_product\",le=\"0.01\"} %d\n", lagMetric, n)
+		_, _ = fmt.Fprintf(w, "%s_bucket{event_type=\"create_product\",le=\"+Inf\"} %d\n", lagMetric, n)
+		_, _ = fmt.Fprintf(w, "%s_sum{event_type=\"create_product\"} %g\n", lagMetric, 0.005*float64(n))
+		_, _ = fmt.Fprintf(w, "%s_count{event_type=\"create_product\"} %d\n", lagMetric, n)
+	case r.Method == http.MethodPost:
+		if f.creates.Add(1)%3 == 0 {
+			w.Header().Set("Content-Type", "application/problem+json")
+			w.WriteHeader(http.StatusConflict)
+			_, _ = fmt.Fprint(w, `{"type":"about:blank","status":409,"detail":"conflict - product already exists"}`)
+			return
+		}
+		f.events.Add(1)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprintf(w, `{"id":%q}`, uuid.NewString())
+	case r.Method == http.MethodGet:
+		_, _ = fmt.Fprint(w, `{"pagination":{"total":0},"products":[]}`)
+	case r.Method == http.MethodDelete:
+		f.events.Add(1)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
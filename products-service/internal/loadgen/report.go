@@ -0,0 +1,115 @@
+package loadgen
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"text/tabwriter"
+	"time"
+)
+
+// Report - results of a run.
+type Report struct {
+	Config   Config
+	Elapsed  time.Duration   // time requests were sent for
+	Ops      map[Op]*OpStats // results by operation
+	Dropped  int             // requests not sent at the rate because all workers were busy
+	Finished bool            // false when the load was interrupted
+	Lag      *LagReport      // nil when metrics of notifications-service are not set
+}
+
+// Total returns results of all operations.
+func (r Report) Total() *OpStats {
+	total := &OpStats{Errors: make(map[string]int)}
+	for _, op := range ops {
+		if s, ok := r.Ops[op]; ok {
+			total.merge(s)
+		}
+	}
+
+	return total
+}
+
+// Write writes the report as tables.
+func (r Report) Write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+
+	mode := fmt.Sprintf("%d workers in a closed loop", r.Config.Concurrency)
+	if r.Config.RPS > 0 {
+		mode = fmt.Sprintf("%g rps by %d workers", r.Config.RPS, r.Config.Concurrency)
+	}
+	_, _ = fmt.Fprintf(w, "load of %s: %s, mix %s, %s\n", r.Config.URL, mode, r.Config.Mix,
+		r.Elapsed.Round(time.Millisecond))
+	if !r.Finished {
+		_, _ = fmt.Fprintln(w, "the load was interrupted")
+	}
+	_, _ = fmt.Fprintln(w)
+
+	_, _ = fmt.Fprintln(tw, "OPERATION\tREQUESTS\tERRORS\tRPS\tP50\tP90\tP99\tMAX\t")
+	for _, op := range ops {
+		if s := r.Ops[op]; s != nil && s.Requests() > 0 {
+			r.writeOp(tw, string(op), s)
+		}
+	}
+	r.writeOp(tw, "total", r.Total())
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if r.Dropped > 0 {
+		_, _ = fmt.Fprintf(w, "\n%d requests were dropped because all workers were busy, "+
+			"increase concurrency to reach the rate\n", r.Dropped)
+	}
+
+	if total := r.Total(); total.Failed() > 0 {
+		_, _ = fmt.Fprintln(w)
+		_, _ = fmt.Fprintln(tw, "OPERATION\tERROR\tCOUNT\t")
+		for _, op := range ops {
+			s := r.Ops[op]
+			if s == nil {
+				continue
+			}
+			for _, kind := range slices.Sorted(maps.Keys(s.Errors)) {
+				_, _ = fmt.Fprintf(tw, "%s\t%s\t%d\t\n", op, kind, s.Errors[kind])
+			}
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if r.Lag != nil {
+		_, _ = fmt.Fprintln(w)
+		_, _ = fmt.Fprintln(tw, "EVENTS\tPUBLISHED\tHANDLED\tMEAN\tP50\tP90\tP99\t")
+		_, _ = fmt.Fprintf(tw, "lag\t%d\t%d\t%s\t%s\t%s\t%s\t\n", r.Lag.Published, r.Lag.Handled,
+			round(r.Lag.Mean), round(r.Lag.P50), round(r.Lag.P90), round(r.Lag.P99))
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r Report) writeOp(w io.Writer, name string, s *OpStats) {
+	rps := 0.0
+	if r.Elapsed > 0 {
+		rps = float64(s.Requests()) / r.Elapsed.Seconds()
+	}
+
+	_, _ = fmt.Fprintf(w, "%s\t%d\t%d\t%.1f\t%s\t%s\t%s\t%s\t\n", name, s.Requests(), s.Failed(), rps,
+		round(s.Percentile(50)), round(s.Percentile(90)), round(s.Percentile(99)), round(s.Percentile(100)))
+}
+
+// round rounds durations to be readable in tables, e.g. 1.234ms or 12.3s.
+func round(d time.Duration) time.Duration {
+	switch {
+	case d >= time.Second:
+		return d.Round(100 * time.Millisecond)
+	case d >= time.Millisecond:
+		return d.Round(time.Microsecond)
+	default:
+		return d.Round(100 * time.Nanosecond)
+	}
+}
@@ -0,0 +1,90 @@
+package loadgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+)
+
+// Kinds of errors which are not errs types.
+const (
+	errKindTimeout   = "timeout"
+	errKindTransport = "transport"
+)
+
+// OpStats - results of requests of an operation.
+type OpStats struct {
+	Latencies []time.Duration // latencies of all requests in order they finished
+	Errors    map[string]int  // number of failed requests by kind, e.g. errs.Conflict
+	sorted    []time.Duration // sorted latencies, computed on demand
+}
+
+// Requests returns number of sent requests.
+func (s *OpStats) Requests() int { return len(s.Latencies) }
+
+// Failed returns number of failed requests.
+func (s *OpStats) Failed() int {
+	n := 0
+	for _, count := range s.Errors {
+		n += count
+	}
+
+	return n
+}
+
+// Succeeded returns number of successful requests.
+func (s *OpStats) Succeeded() int { return s.Requests() - s.Failed() }
+
+// Percentile returns the latency p percents of requests are faster than, p is in [0, 100].
+func (s *OpStats) Percentile(p float64) time.Duration {
+	if len(s.Latencies) == 0 {
+		return 0
+	}
+	if len(s.sorted) != len(s.Latencies) {
+		s.sorted = slices.Clone(s.Latencies)
+		slices.Sort(s.sorted)
+	}
+
+	// nearest-rank method
+	rank := int(math.Ceil(p / 100 * float64(len(s.sorted))))
+
+	return s.sorted[min(max(rank, 1), len(s.sorted))-1]
+}
+
+// record records a finished request.
+func (s *OpStats) record(latency time.Duration, err error) {
+	s.Latencies = append(s.Latencies, latency)
+	if err != nil {
+		s.Errors[ErrorKind(err)]++
+	}
+}
+
+// merge adds results of other to s.
+func (s *OpStats) merge(other *OpStats) {
+	s.Latencies = append(s.Latencies, other.Latencies...)
+	for kind, n := range other.Errors {
+		s.Errors[kind] += n
+	}
+}
+
+// ErrorKind returns the kind of an error of the client: the errs type of error responses, e.g. errs.Conflict,
+// timeout or transport for errors of requests without responses.
+func ErrorKind(err error) string {
+	var (
+		httpErr errs.HTTPError
+		netErr  interface{ Timeout() bool }
+	)
+	switch {
+	case errors.As(err, &httpErr):
+		return fmt.Sprintf("%T", httpErr)
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		return errKindTimeout
+	default:
+		return errKindTransport
+	}
+}
@@ -0,0 +1,104 @@
+package seed
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gopkg.in/yaml.v3"
+)
+
+type (
+	// Fixtures - defines a file of fixtures, e.g.:
+	//
+	//	products:
+	//	  - name: Laptop Pro 14
+	//	    vendor: Acme
+	//	    price: "1299.00"
+	//	    tags: [electronics]
+	Fixtures struct {
+		Products []Fixture `json:"products" yaml:"products"`
+	}
+
+	// Fixture - defines a product of fixtures.
+	Fixture struct {
+		Name        string          `json:"name"         yaml:"name"`
+		Vendor      string          `json:"vendor"       yaml:"vendor"`
+		Description string          `json:"description"  yaml:"description"`
+		Price       decimal.Decimal `json:"price"        yaml:"price"`
+		Currency    string          `json:"currency"     yaml:"currency"`
+		CategoryIDs []uuid.UUID     `json:"category_ids" yaml:"category_ids"`
+		Tags        []string        `json:"tags"         yaml:"tags"`
+	}
+)
+
+// LoadFixtures reads products from a JSON or YAML file chosen by its extension, unknown fields are rejected
+// so typos don't silently drop data.
+func LoadFixtures(path string) (products.Products, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fixtures: %w", err)
+	}
+
+	var fixtures Fixtures
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json":
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(&fixtures)
+	case ".yaml", ".yml":
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		err = dec.Decode(&fixtures)
+	default:
+		return nil, fmt.Errorf("fixtures must be a .json, .yaml or .yml file, got %q", ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse fixtures %s: %w", path, err)
+	}
+
+	items := make(products.Products, 0, len(fixtures.Products))
+	for i, f := range fixtures.Products {
+		product, err := f.toDomain()
+		if err != nil {
+			return nil, fmt.Errorf("fixture %d of %s: %w", i+1, path, err)
+		}
+
+		items = append(items, product)
+	}
+
+	return items, nil
+}
+
+// toDomain validates a fixture and converts it to a product.
+func (f Fixture) toDomain() (products.Product, error) {
+	p := products.Product{
+		Name:        strings.TrimSpace(f.Name),
+		Vendor:      strings.TrimSpace(f.Vendor),
+		Description: strings.TrimSpace(f.Description),
+		Price:       f.Price,
+		Currency:    f.Currency,
+		CategoryIDs: f.CategoryIDs,
+		Tags:        f.Tags,
+	}
+
+	switch {
+	case p.Name == "":
+		return products.Product{}, errors.New("name is required")
+	case p.Vendor == "":
+		return products.Product{}, errors.New("vendor is required")
+	case p.Price.IsNegative():
+		return products.Product{}, errors.New("price must not be negative")
+	case !p.Price.Equal(p.Price.Round(products.PriceDecimals)):
+		return products.Product{}, fmt.Errorf("price must have at most %d decimals", products.PriceDecimals)
+	}
+
+	return p, nil
+}
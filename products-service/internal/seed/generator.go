@@ -0,0 +1,114 @@
+package seed
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/currencies"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	"github.com/shopspring/decimal"
+)
+
+type (
+	// kind - defines a kind of generated products with its price range in cents.
+	kind struct {
+		noun     string
+		tag      string
+		minCents int
+		maxCents int
+	}
+
+	// Generator - defines a generator of a synthetic catalog, generators with the same seed generate the same
+	// products, so catalogs of demo and load-test environments are reproducible.
+	Generator struct {
+		rng *rand.Rand
+		n   int // number of generated products, it makes names unique
+	}
+)
+
+var (
+	vendorNames = []string{
+		"Acme", "Northwind", "Globex", "Initech", "Umbrella Works", "Stark Goods", "Wayne Supply", "Hooli",
+		"Vandelay Industries", "Soylent", "Cyberdyne", "Tyrell", "Wonka", "Gringotts Trading", "Oscorp",
+		"Aperture", "Black Mesa", "Massive Dynamic", "Pied Piper", "Monarch",
+	}
+
+	adjectives = []string{
+		"Compact", "Classic", "Smart", "Ultra", "Portable", "Premium", "Eco", "Pro", "Lightweight", "Rugged",
+		"Wireless", "Modular", "Essential", "Deluxe", "Slim",
+	}
+
+	kinds = []kind{
+		{noun: "Laptop", tag: "electronics", minCents: 49900, maxCents: 299900},
+		{noun: "Headphones", tag: "audio", minCents: 1999, maxCents: 39900},
+		{noun: "Speaker", tag: "audio", minCents: 2999, maxCents: 49900},
+		{noun: "Monitor", tag: "electronics", minCents: 9999, maxCents: 129900},
+		{noun: "Keyboard", tag: "accessories", minCents: 1499, maxCents: 24900},
+		{noun: "Mouse", tag: "accessories", minCents: 999, maxCents: 14900},
+		{noun: "Backpack", tag: "bags", minCents: 2499, maxCents: 19900},
+		{noun: "Water Bottle", tag: "outdoor", minCents: 799, maxCents: 4999},
+		{noun: "Tent", tag: "outdoor", minCents: 5999, maxCents: 69900},
+		{noun: "Desk Lamp", tag: "home", minCents: 1599, maxCents: 12900},
+		{noun: "Coffee Grinder", tag: "kitchen", minCents: 2499, maxCents: 29900},
+		{noun: "Blender", tag: "kitchen", minCents: 3499, maxCents: 39900},
+		{noun: "Running Shoes", tag: "sports", minCents: 3999, maxCents: 21900},
+		{noun: "Yoga Mat", tag: "sports", minCents: 1299, maxCents: 8999},
+		{noun: "Smartwatch", tag: "wearables", minCents: 7999, maxCents: 79900},
+	}
+
+	features = []string{
+		"a long-lasting battery", "a water-resistant body", "a recycled aluminium frame", "a two-year warranty",
+		"a soft-touch finish", "USB-C charging", "a carrying case", "noise reduction", "a tool-free setup",
+		"replaceable parts", "a matte finish", "an ergonomic design",
+	}
+
+	extraTags = []string{"new", "bestseller", "sale", "gift", "eco", "limited"}
+)
+
+// NewGenerator creates a new generator of products.
+func NewGenerator(seed uint64) *Generator {
+	return &Generator{rng: rand.New(rand.NewPCG(seed, seed))}
+}
+
+// Products generates n products.
+func (g *Generator) Products(n int) products.Products {
+	items := make(products.Products, 0, n)
+	for range n {
+		items = append(items, g.Product())
+	}
+
+	return items
+}
+
+// Product generates a product, its name is unique among products of the generator.
+func (g *Generator) Product() products.Product {
+	g.n++
+
+	k := kinds[g.rng.IntN(len(kinds))]
+	adjective := pick(g.rng, adjectives)
+	model := fmt.Sprintf("%c%d", 'A'+rune(g.rng.IntN(26)), 100+g.n)
+
+	first, second := pick(g.rng, features), pick(g.rng, features)
+	for second == first {
+		second = pick(g.rng, features)
+	}
+
+	tags := []string{k.tag}
+	if g.rng.IntN(3) == 0 {
+		tags = append(tags, pick(g.rng, extraTags))
+	}
+
+	return products.Product{
+		Name:   strings.Join([]string{adjective, k.noun, model}, " "),
+		Vendor: pick(g.rng, vendorNames),
+		Description: fmt.Sprintf("%s %s with %s and %s.",
+			adjective, strings.ToLower(k.noun), first, second),
+		Price:    decimal.New(int64(k.minCents+g.rng.IntN(k.maxCents-k.minCents+1)), -products.PriceDecimals),
+		Currency: currencies.Base,
+		Tags:     tags,
+	}
+}
+
+// pick returns a random item of items.
+func pick(rng *rand.Rand, items []string) string { return items[rng.IntN(len(items))] }
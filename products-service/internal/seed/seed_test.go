@@ -0,0 +1,87 @@
+package seed
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenerator tests that generators with the same seed generate the same valid products.
+func TestGenerator(t *testing.T) {
+	first, second := NewGenerator(42).Products(500), NewGenerator(42).Products(500)
+	require.Len(t, first, 500)
+	assert.Equal(t, first, second)
+	assert.NotEqual(t, first, NewGenerator(43).Products(500))
+
+	names := make(map[string]bool, len(first))
+	for _, p := range first {
+		assert.False(t, names[p.Name], "name %q is generated twice", p.Name)
+		names[p.Name] = true
+
+		assert.NotEmpty(t, p.Vendor)
+		assert.NotEmpty(t, p.Description)
+		assert.NotEmpty(t, p.Tags)
+		assert.True(t, p.Price.IsPositive())
+		assert.LessOrEqual(t, -p.Price.Exponent(), int32(2))
+	}
+}
+
+// TestLoadFixtures tests loading of JSON and YAML fixtures.
+func TestLoadFixtures(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+
+	tests := map[string]struct {
+		path    string
+		wantErr string
+	}{
+		"json": {
+			path: write("products.json",
+				`{"products": [{"name": " Laptop ", "vendor": "Acme", "price": 10.5, "tags": ["a"]}]}`),
+		},
+		"yaml": {
+			path: write("products.yaml", "products:\n  - name: Laptop\n    vendor: Acme\n    price: 10.50\n"+
+				"    tags: [a]\n"),
+		},
+		"unknown field": {
+			path:    write("unknown.yml", "products:\n  - name: Laptop\n    vendr: Acme\n"),
+			wantErr: "vendr",
+		},
+		"missing vendor": {
+			path:    write("vendor.json", `{"products": [{"name": "Laptop", "price": "1"}]}`),
+			wantErr: "fixture 1 of " + filepath.Join(dir, "vendor.json") + ": vendor is required",
+		},
+		"price precision": {
+			path:    write("price.json", `{"products": [{"name": "Laptop", "vendor": "Acme", "price": "1.001"}]}`),
+			wantErr: "price must have at most 2 decimals",
+		},
+		"extension": {
+			path:    write("products.csv", ""),
+			wantErr: `got ".csv"`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			items, err := LoadFixtures(tt.path)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, items, 1)
+			assert.Equal(t, "Laptop", items[0].Name)
+			assert.Equal(t, "Acme", items[0].Vendor)
+			assert.Equal(t, "10.5", items[0].Price.String())
+			assert.Equal(t, []string{"a"}, items[0].Tags)
+		})
+	}
+}
@@ -0,0 +1,174 @@
+// Package apptest starts the products-service application in-process for tests of other packages and modules,
+// e.g. end-to-end tests of the services together:
+//
+//	srv, err := apptest.Start(apptest.Options{QueueURL: queueURL})
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	defer srv.Close()
+//
+//	c := client.New(srv.URL)
+//
+// Products, images and objects are stored in memory, messages are published to QueueURL, which may be served
+// by a fake SQS server. Routes needing Postgres, e.g. of vendors or categories, respond 501 Not Implemented.
+package apptest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/platform/health"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/app"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/config"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+// StartTimeout - deadline of the application to become ready.
+const StartTimeout = 10 * time.Second
+
+type (
+	// Options - options of the started application.
+	Options struct {
+		QueueURL   string      // URL of the SQS queue of notifications, e.g. http://127.0.0.1:4566/000000000000/queue
+		AdminToken string      // bearer token of the admin API, it is disabled when empty
+		Logger     *zap.Logger // zap.NewNop when nil
+
+		ProxyHeader    string   // header of client addresses, e.g. X-Real-IP, not trusted when empty
+		TrustedProxies []string // proxies the header is trusted from, e.g. 127.0.0.1
+	}
+
+	// Server - the application running in background.
+	Server struct {
+		URL         string // base URL of the HTTP API, e.g. http://127.0.0.1:10000
+		GRPCAddress string // address of the gRPC server, e.g. 127.0.0.1:10002
+
+		cancel context.CancelFunc
+		done   chan error
+	}
+)
+
+// init sets process-wide settings of the application before tests start goroutines reading them.
+func init() { app.SetGlobals() }
+
+// Start starts the application on random local ports and waits until it is ready. The application exits
+// the process on fatal errors of initialization, e.g. when the queue doesn't exist.
+func Start(opts Options) (*Server, error) {
+	if opts.Logger == nil {
+		opts.Logger = zap.NewNop()
+	}
+
+	httpAddress, err := freeAddress()
+	if err != nil {
+		return nil, err
+	}
+	grpcAddress, err := freeAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	a := app.New(app.Meta{Info: health.Info{Name: "products-service"}}).
+		WithConfig(newConfig(httpAddress, grpcAddress, opts)).
+		WithLogger(opts.Logger).
+		WithMetricsRegistry(prometheus.NewRegistry()).
+		WithMemoryStorage()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &Server{
+		URL:         "http://" + httpAddress,
+		GRPCAddress: grpcAddress,
+		cancel:      cancel,
+		done:        make(chan error, 1),
+	}
+
+	go func() { s.done <- a.Run(ctx) }()
+
+	if err = s.waitReady(); err != nil {
+		return nil, errors.Join(err, s.Close())
+	}
+
+	return s, nil
+}
+
+// Close stops the application and waits until it is stopped.
+func (s *Server) Close() error {
+	s.cancel()
+	return <-s.done
+}
+
+// waitReady polls the readiness endpoint until it responds 200 or the application fails.
+func (s *Server) waitReady() error {
+	deadline := time.After(StartTimeout)
+	for {
+		resp, err := http.Get(s.URL + "/products-api/v1/ready")
+		if err == nil {
+			_ = resp.Body.Close()
+			if resp.StatusCode == http.StatusOK {
+				return nil
+			}
+		}
+
+		select {
+		case err = <-s.done:
+			s.done <- err
+			return fmt.Errorf("application stopped before it was ready: %w", err)
+		case <-deadline:
+			return errors.New("application is not ready after " + StartTimeout.String())
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+// newConfig returns the configuration of the application listening on the addresses.
+func newConfig(httpAddress, grpcAddress string, opts Options) *config.Config {
+	return &config.Config{
+		Delivery: config.Delivery{
+			HTTPServer: config.HTTPServer{
+				ListenAddress:      httpAddress,
+				ReadTimeout:        10 * time.Second,
+				WriteTimeout:       10 * time.Second,
+				GracefulTimeout:    5 * time.Second,
+				BodySizeLimitBytes: 6 << 20,
+				AdminToken:         opts.AdminToken,
+				ProxyHeader:        opts.ProxyHeader,
+				TrustedProxies:     opts.TrustedProxies,
+			},
+			GRPCServer: config.GRPCServer{
+				ListenAddress:   grpcAddress,
+				GracefulTimeout: 5 * time.Second,
+			},
+			Broker: config.Broker{URL: opts.QueueURL, Region: "us-east-1"},
+			ChangeFeed: config.ChangeFeed{
+				PollInterval:        20 * time.Millisecond,
+				HeartbeatInterval:   time.Second,
+				MaxStreamsPerClient: 5,
+			},
+		},
+		Storage: config.Storage{
+			S3: config.S3{
+				Region:            "us-east-1",
+				Bucket:            "product-images",
+				PresignTTL:        15 * time.Minute,
+				MaxImageSizeBytes: 5 << 20,
+			},
+			Cache: config.Cache{Driver: config.CacheDriverNone},
+		},
+		Logger:    config.Logger{Level: "debug"},
+		Lifecycle: config.Lifecycle{StopTimeout: 5 * time.Second},
+	}
+}
+
+// freeAddress returns a local address with a port which is free at the moment.
+func freeAddress() (string, error) {
+	l, err := net.Listen("tcp4", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+	defer l.Close()
+
+	return l.Addr().String(), nil
+}
@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+)
+
+// GetCategories returns the categories tree.
+func (c *Client) GetCategories(ctx context.Context) (CategoryTree, error) {
+	var out CategoryTree
+	err := c.call(ctx, http.MethodGet, "/categories", nil, nil, &out)
+	return out, err
+}
+
+// GetCategory returns a category.
+func (c *Client) GetCategory(ctx context.Context, id uuid.UUID) (Category, error) {
+	var out Category
+	err := c.call(ctx, http.MethodGet, "/categories/"+id.String(), nil, nil, &out)
+	return out, err
+}
+
+// CreateCategory creates a category.
+func (c *Client) CreateCategory(ctx context.Context, req CategoryRequest) (Category, error) {
+	var out Category
+	err := c.call(ctx, http.MethodPost, "/categories", nil, req, &out)
+	return out, err
+}
+
+// UpdateCategory renames or moves a category.
+func (c *Client) UpdateCategory(ctx context.Context, id uuid.UUID, req CategoryRequest) (Category, error) {
+	var out Category
+	err := c.call(ctx, http.MethodPut, "/categories/"+id.String(), nil, req, &out)
+	return out, err
+}
+
+// DeleteCategory deletes a category, its products and subcategories are moved to reassignTo if it is not nil.
+func (c *Client) DeleteCategory(ctx context.Context, id uuid.UUID, reassignTo *uuid.UUID) error {
+	q := url.Values{}
+	if reassignTo != nil {
+		q.Set("reassign_to", reassignTo.String())
+	}
+
+	return c.call(ctx, http.MethodDelete, "/categories/"+id.String(), q, nil, nil)
+}
@@ -0,0 +1,235 @@
+// Package client is a typed Go client of the products-service HTTP API.
+//
+// Every method accepts a context, retries requests failed with 5xx and 429 responses with exponential backoff
+// and returns error responses as errs types, so callers can check them with errors.As:
+//
+//	var conflict errs.Conflict
+//	if errors.As(err, &conflict) { ... }
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+// BasePath is a path prefix of the API.
+const BasePath = "/products-api/v1"
+
+// headerLastWriteLSN - WAL position of the last write responded by the service, it is sent back with requests
+// so reads served by replicas observe writes of the client.
+const headerLastWriteLSN = "X-Last-Write-LSN"
+
+// Defaults of retries.
+const (
+	DefaultMaxRetries = 3
+	DefaultMinBackoff = 100 * time.Millisecond
+	DefaultMaxBackoff = 2 * time.Second
+	DefaultTimeout    = 10 * time.Second
+)
+
+type (
+	// Client is a client of the products-service API, it is safe for concurrent use.
+	Client struct {
+		baseURL    string
+		httpClient *http.Client
+		maxRetries int
+		minBackoff time.Duration
+		maxBackoff time.Duration
+		adminToken string
+
+		lastWriteLSN atomic.Pointer[string]
+	}
+
+	// Option configures a Client.
+	Option func(c *Client)
+
+	// request describes an API request, body is kept in memory so the request can be retried.
+	request struct {
+		method      string
+		path        string
+		query       url.Values
+		body        []byte
+		contentType string
+	}
+)
+
+// WithHTTPClient sets HTTP client used for requests, a client with DefaultTimeout is used by default.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) { c.httpClient = httpClient }
+}
+
+// WithRetries sets max number of retries and bounds of backoff between them, 0 retries disables retrying.
+func WithRetries(maxRetries int, minBackoff, maxBackoff time.Duration) Option {
+	return func(c *Client) {
+		c.maxRetries = maxRetries
+		c.minBackoff = minBackoff
+		c.maxBackoff = maxBackoff
+	}
+}
+
+// WithAdminToken sets the bearer token sent with requests of the admin API, e.g. GetCurrencyRates.
+func WithAdminToken(token string) Option {
+	return func(c *Client) { c.adminToken = token }
+}
+
+// New creates a client of the service at baseURL, e.g. http://localhost:10000.
+func New(baseURL string, opts ...Option) *Client {
+	c := &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/") + BasePath,
+		httpClient: &http.Client{Timeout: DefaultTimeout},
+		maxRetries: DefaultMaxRetries,
+		minBackoff: DefaultMinBackoff,
+		maxBackoff: DefaultMaxBackoff,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// newJSONRequest creates a request with JSON encoded payload, nil payload means no body.
+func newJSONRequest(method, path string, query url.Values, payload any) (request, error) {
+	r := request{method: method, path: path, query: query}
+	if payload == nil {
+		return r, nil
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return r, err
+	}
+	r.body = body
+	r.contentType = "application/json"
+
+	return r, nil
+}
+
+// call sends a request with JSON payload and decodes JSON response into out, nil out means no response body.
+func (c *Client) call(ctx context.Context, method, path string, query url.Values, payload, out any) error {
+	r, err := newJSONRequest(method, path, query, payload)
+	if err != nil {
+		return err
+	}
+
+	return c.do(ctx, r, out)
+}
+
+// do sends a request retrying it while the response is retryable, POST requests are retried only when
+// the server did not process them (429 and 503), so a resource is never created twice.
+func (c *Client) do(ctx context.Context, r request, out any) error {
+	for attempt := 0; ; attempt++ {
+		status, header, body, err := c.send(ctx, r)
+		if err == nil && status < http.StatusBadRequest {
+			if out == nil || status == http.StatusNoContent {
+				return nil
+			}
+
+			return json.Unmarshal(body, out)
+		}
+		if err == nil {
+			err = decodeError(status, body)
+		}
+
+		if attempt >= c.maxRetries || !retryable(r.method, status, err) {
+			return err
+		}
+
+		timer := time.NewTimer(c.backoff(attempt, header))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.Join(err, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// send sends a request once and reads the response.
+func (c *Client) send(ctx context.Context, r request) (int, http.Header, []byte, error) {
+	u := c.baseURL + r.path
+	if len(r.query) > 0 {
+		u += "?" + r.query.Encode()
+	}
+
+	var body io.Reader
+	if r.body != nil {
+		body = bytes.NewReader(r.body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, r.method, u, body)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	req.Header.Set("Accept", "application/json, application/problem+json")
+	if r.contentType != "" {
+		req.Header.Set("Content-Type", r.contentType)
+	}
+	if lsn := c.lastWriteLSN.Load(); lsn != nil {
+		req.Header.Set(headerLastWriteLSN, *lsn)
+	}
+	if c.adminToken != "" && strings.HasPrefix(r.path, "/admin/") {
+		req.Header.Set("Authorization", "Bearer "+c.adminToken)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	if lsn := resp.Header.Get(headerLastWriteLSN); lsn != "" {
+		c.lastWriteLSN.Store(&lsn)
+	}
+
+	return resp.StatusCode, resp.Header, data, nil
+}
+
+// retryable reports whether a request should be retried after a response with status or a transport error.
+func retryable(method string, status int, err error) bool {
+	if status == 0 {
+		// transport error, the request may not have reached the server
+		return method != http.MethodPost && !errors.Is(err, context.Canceled) &&
+			!errors.Is(err, context.DeadlineExceeded)
+	}
+
+	switch {
+	case status == http.StatusTooManyRequests, status == http.StatusServiceUnavailable:
+		return true
+	case status >= http.StatusInternalServerError:
+		return method != http.MethodPost
+	default:
+		return false
+	}
+}
+
+// backoff returns delay before a retry: Retry-After of the response if set, otherwise exponential backoff
+// with full jitter.
+func (c *Client) backoff(attempt int, header http.Header) time.Duration {
+	if seconds, err := strconv.Atoi(header.Get("Retry-After")); err == nil && seconds >= 0 {
+		return min(time.Duration(seconds)*time.Second, c.maxBackoff)
+	}
+
+	limit := min(c.minBackoff<<attempt, c.maxBackoff)
+	if limit <= 0 {
+		return 0
+	}
+
+	return time.Duration(rand.Int64N(int64(limit))) + 1
+}
@@ -0,0 +1,154 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/platform/responder"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDecodeError tests that errors responded by the service in both formats are decoded to the same errs values.
+func TestDecodeError(t *testing.T) {
+	cases := []error{
+		errs.BadRequest{Cause: "invalid product id"},
+		errs.FieldsValidation{Errors: []string{"name::is_required", "price::min_value_is::0"}},
+		errs.NotFound{What: "product"},
+		errs.NotFound{},
+		errs.Conflict{What: "insufficient stock"},
+		errs.Gone{What: "reservation"},
+		errs.PayloadTooLarge{Cause: "image is larger than 10 bytes"},
+		errs.UnsupportedMediaType{Cause: "multipart/form-data is expected"},
+		errs.TooManyRequests{},
+		errs.Internal{Cause: "boom"},
+		errs.ServiceUnavailable{Cause: "database"},
+	}
+
+	for _, accept := range []string{responder.MIMEProblemJSON, responder.MIMELegacyErrorJSON} {
+		for _, want := range cases {
+			t.Run(accept+" "+want.Error(), func(t *testing.T) {
+				app := fiber.New()
+				app.Get("/", func(ctx *fiber.Ctx) error { return responder.New().HandleError(ctx, want) })
+
+				req := httptest.NewRequest(http.MethodGet, "/", nil)
+				req.Header.Set("Accept", accept)
+				resp, err := app.Test(req)
+				require.NoError(t, err)
+				body, err := io.ReadAll(resp.Body)
+				require.NoError(t, err)
+
+				assert.Equal(t, want, decodeError(resp.StatusCode, body))
+			})
+		}
+	}
+}
+
+// TestClientRetries tests that retryable responses are retried and errors are returned as errs types.
+func TestClientRetries(t *testing.T) {
+	id := uuid.New()
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && calls.Add(1) < 3:
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"error":"service_unavailable"}`))
+		case r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(`{"id":"` + id.String() + `","name":"Laptop","price":10.5}`))
+		default:
+			calls.Add(1)
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"error":"internal_server_error - boom"}`))
+		}
+	}))
+	defer srv.Close()
+
+	c := New(srv.URL, WithRetries(3, time.Millisecond, 5*time.Millisecond))
+
+	product, err := c.GetProduct(context.Background(), id, "")
+	require.NoError(t, err)
+	assert.Equal(t, id, product.ID)
+	assert.Equal(t, "10.5", product.Price.String())
+	assert.Equal(t, int32(3), calls.Load())
+
+	calls.Store(0)
+	_, err = c.CreateVendor(context.Background(), VendorRequest{Name: "Acme"})
+	var internal errs.Internal
+	require.ErrorAs(t, err, &internal)
+	assert.Equal(t, "boom", internal.Cause)
+	assert.Equal(t, int32(1), calls.Load(), "POST must not be retried after 500")
+}
+
+// TestClientContextCanceled tests that waiting for a retry stops when the context is canceled.
+func TestClientContextCanceled(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Retry-After", "1")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := New(srv.URL).GetCategories(ctx)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.True(t, errors.As(err, &errs.TooManyRequests{}))
+}
+
+// TestClientReadsOwnWrites tests that WAL position of writes is sent with the following requests.
+func TestClientReadsOwnWrites(t *testing.T) {
+	var sent []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent = append(sent, r.Header.Get(headerLastWriteLSN))
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			w.Header().Set(headerLastWriteLSN, "0/16B3748")
+			w.WriteHeader(http.StatusCreated)
+		}
+		_, _ = w.Write([]byte(`{"id":"` + uuid.NewString() + `","name":"Acme"}`))
+	}))
+	defer srv.Close()
+
+	c := New(srv.URL)
+
+	vendor, err := c.CreateVendor(context.Background(), VendorRequest{Name: "Acme"})
+	require.NoError(t, err)
+	_, err = c.GetVendor(context.Background(), vendor.ID)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"", "0/16B3748"}, sent)
+}
+
+// TestClientAdminToken tests that the admin token is sent with requests of the admin API only.
+func TestClientAdminToken(t *testing.T) {
+	sent := map[string]string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent[r.URL.Path] = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	c := New(srv.URL, WithAdminToken("secret"))
+
+	_, err := c.RepublishEvents(context.Background(), RepublishRequest{ProductID: &uuid.Nil})
+	require.NoError(t, err)
+	_, err = c.GetCategories(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		BasePath + "/admin/events/republish": "Bearer secret",
+		BasePath + "/categories":             "",
+	}, sent)
+}
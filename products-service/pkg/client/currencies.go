@@ -0,0 +1,26 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/shopspring/decimal"
+)
+
+// GetCurrencyRates returns rates of all currencies.
+func (c *Client) GetCurrencyRates(ctx context.Context) (RateList, error) {
+	var out RateList
+	err := c.call(ctx, http.MethodGet, "/admin/currency-rates", nil, nil, &out)
+	return out, err
+}
+
+// SetCurrencyRate creates or updates a rate of a currency.
+func (c *Client) SetCurrencyRate(ctx context.Context, currency string, rate decimal.Decimal) (Rate, error) {
+	var out Rate
+	err := c.call(ctx, http.MethodPut, "/admin/currency-rates/"+url.PathEscape(currency), nil,
+		struct {
+			Rate decimal.Decimal `json:"rate"`
+		}{Rate: rate}, &out)
+	return out, err
+}
@@ -0,0 +1,118 @@
+package client
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/goccy/go-json"
+)
+
+const (
+	// divider separates error kind and its details in error messages of the API, e.g. "not_found - product".
+	divider = " - "
+	// problemTypeValidation is a type of problem details of validation errors.
+	problemTypeValidation = "urn:problem-type:validation-error"
+)
+
+// errorResponse is a model of error responses of the API: problem details or legacy {"error": "..."}.
+type errorResponse struct {
+	Type   string            `json:"type"`
+	Detail string            `json:"detail"`
+	Errors []errs.FieldError `json:"errors"`
+	Error  string            `json:"error"`
+}
+
+// decodeError converts an error response to the errs type of its status code.
+func decodeError(status int, body []byte) error {
+	var resp errorResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		resp = errorResponse{}
+	}
+
+	if resp.Type == problemTypeValidation {
+		validation := errs.FieldsValidation{}
+		for _, field := range resp.Errors {
+			validation.Errors = append(validation.Errors, field.String())
+		}
+		return validation
+	}
+
+	msg := resp.Detail
+	if msg == "" {
+		msg = resp.Error
+	}
+	if msg == "" && resp.Type == "" {
+		msg = strings.TrimSpace(string(body))
+	}
+
+	switch status {
+	case http.StatusBadRequest:
+		// legacy format of validation errors
+		if details, ok := strings.CutPrefix(msg, errs.FieldsValidation{}.Error()); ok {
+			details = strings.TrimPrefix(details, divider)
+			if details == "" {
+				return errs.FieldsValidation{}
+			}
+			return errs.FieldsValidation{Errors: strings.Split(details, ",")}
+		}
+		return errs.BadRequest{Cause: msg}
+	case http.StatusUnauthorized:
+		return errs.Unauthorized{Cause: details(msg, errs.Unauthorized{})}
+	case http.StatusPaymentRequired:
+		return errs.PaymentRequired{Cause: details(msg, errs.PaymentRequired{})}
+	case http.StatusForbidden:
+		return errs.Forbidden{Cause: details(msg, errs.Forbidden{})}
+	case http.StatusNotFound:
+		return errs.NotFound{What: details(msg, errs.NotFound{})}
+	case http.StatusMethodNotAllowed:
+		return errs.MethodNotAllowed{Cause: details(msg, errs.MethodNotAllowed{})}
+	case http.StatusNotAcceptable:
+		return errs.NotAcceptable{Cause: details(msg, errs.NotAcceptable{})}
+	case http.StatusRequestTimeout:
+		return errs.RequestTimeout{Cause: details(msg, errs.RequestTimeout{})}
+	case http.StatusConflict:
+		return errs.Conflict{What: details(msg, errs.Conflict{})}
+	case http.StatusGone:
+		return errs.Gone{What: details(msg, errs.Gone{})}
+	case http.StatusRequestEntityTooLarge:
+		return errs.PayloadTooLarge{Cause: details(msg, errs.PayloadTooLarge{})}
+	case http.StatusUnsupportedMediaType:
+		return errs.UnsupportedMediaType{Cause: details(msg, errs.UnsupportedMediaType{})}
+	case http.StatusTooManyRequests:
+		return errs.TooManyRequests{Cause: details(msg, errs.TooManyRequests{})}
+	case http.StatusInternalServerError:
+		return errs.Internal{Cause: details(msg, errs.Internal{})}
+	case http.StatusNotImplemented:
+		return errs.NotImplemented{Cause: details(msg, errs.NotImplemented{})}
+	case http.StatusBadGateway:
+		return errs.BadGateway{Cause: details(msg, errs.BadGateway{})}
+	case http.StatusServiceUnavailable:
+		return errs.ServiceUnavailable{Cause: details(msg, errs.ServiceUnavailable{})}
+	case http.StatusGatewayTimeout:
+		return errs.GatewayTimeout{Cause: details(msg, errs.GatewayTimeout{})}
+	case http.StatusHTTPVersionNotSupported:
+		return errs.HTTPVersionNotSupported{Cause: details(msg, errs.HTTPVersionNotSupported{})}
+	}
+
+	if status >= http.StatusInternalServerError {
+		return errs.Internal{Cause: "unexpected status " + strconv.Itoa(status) + ": " + msg}
+	}
+
+	return errs.BadRequest{Cause: "unexpected status " + strconv.Itoa(status) + ": " + msg}
+}
+
+// details strips the kind of an error from its message, the kind is the message of the empty error,
+// e.g. "not_found - product" -> "product".
+func details(msg string, empty error) string {
+	kind := empty.Error()
+	if msg == kind {
+		return ""
+	}
+	if rest, ok := strings.CutPrefix(msg, kind+divider); ok {
+		return rest
+	}
+
+	return msg
+}
@@ -0,0 +1,14 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// RepublishEvents publishes again create_product and update_product events of products by their stored state,
+// it needs the admin token.
+func (c *Client) RepublishEvents(ctx context.Context, req RepublishRequest) (RepublishResult, error) {
+	var out RepublishResult
+	err := c.call(ctx, http.MethodPost, "/admin/events/republish", nil, req, &out)
+	return out, err
+}
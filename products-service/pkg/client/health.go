@@ -0,0 +1,22 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/goccy/go-json"
+)
+
+// Health returns meta information about the service.
+func (c *Client) Health(ctx context.Context) (Health, error) {
+	var out Health
+	err := c.call(ctx, http.MethodGet, "/health", nil, nil, &out)
+	return out, err
+}
+
+// OpenAPI returns OpenAPI document of the API.
+func (c *Client) OpenAPI(ctx context.Context) (json.RawMessage, error) {
+	var out json.RawMessage
+	err := c.call(ctx, http.MethodGet, "/openapi.json", nil, nil, &out)
+	return out, err
+}
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// GetImages returns images of a product.
+func (c *Client) GetImages(ctx context.Context, productID uuid.UUID) (ImageList, error) {
+	var out ImageList
+	err := c.call(ctx, http.MethodGet, "/products/"+productID.String()+"/images", nil, nil, &out)
+	return out, err
+}
+
+// UploadImage uploads an image of a product, the image is read into memory so the request can be retried.
+func (c *Client) UploadImage(
+	ctx context.Context, productID uuid.UUID, filename string, image io.Reader,
+) (Image, error) {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+
+	part, err := w.CreateFormFile("file", filename)
+	if err != nil {
+		return Image{}, err
+	}
+	if _, err = io.Copy(part, image); err != nil {
+		return Image{}, err
+	}
+	if err = w.Close(); err != nil {
+		return Image{}, err
+	}
+
+	var out Image
+	err = c.do(ctx, request{
+		method:      http.MethodPost,
+		path:        "/products/" + productID.String() + "/images",
+		body:        body.Bytes(),
+		contentType: w.FormDataContentType(),
+	}, &out)
+	return out, err
+}
+
+// DeleteImage deletes an image of a product.
+func (c *Client) DeleteImage(ctx context.Context, productID, imageID uuid.UUID) error {
+	return c.call(ctx, http.MethodDelete, "/products/"+productID.String()+"/images/"+imageID.String(), nil, nil, nil)
+}
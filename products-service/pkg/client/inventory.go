@@ -0,0 +1,35 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// GetStock returns stock levels of a product.
+func (c *Client) GetStock(ctx context.Context, productID uuid.UUID) (StockList, error) {
+	var out StockList
+	err := c.call(ctx, http.MethodGet, "/products/"+productID.String()+"/stock", nil, nil, &out)
+	return out, err
+}
+
+// AdjustStock adds or removes units of a product.
+func (c *Client) AdjustStock(ctx context.Context, productID uuid.UUID, req AdjustmentRequest) (Stock, error) {
+	var out Stock
+	err := c.call(ctx, http.MethodPost, "/products/"+productID.String()+"/stock/adjustments", nil, req, &out)
+	return out, err
+}
+
+// Reserve reserves units of a product, errs.Conflict is returned when there are not enough units.
+func (c *Client) Reserve(ctx context.Context, productID uuid.UUID, req ReservationRequest) (Reservation, error) {
+	var out Reservation
+	err := c.call(ctx, http.MethodPost, "/products/"+productID.String()+"/reservations", nil, req, &out)
+	return out, err
+}
+
+// ReleaseReservation releases a reservation.
+func (c *Client) ReleaseReservation(ctx context.Context, productID, reservationID uuid.UUID) error {
+	return c.call(ctx, http.MethodDelete,
+		"/products/"+productID.String()+"/reservations/"+reservationID.String(), nil, nil, nil)
+}
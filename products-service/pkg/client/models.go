@@ -0,0 +1,239 @@
+package client
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type (
+	// Health is meta information about the service.
+	Health struct {
+		Name    string `json:"name"`
+		Commit  string `json:"commit,omitempty"`
+		Date    string `json:"date,omitempty"`
+		Version string `json:"version"`
+	}
+
+	// Pagination describes a page of a list.
+	Pagination struct {
+		Offset uint64 `json:"offset"`
+		Limit  uint64 `json:"limit"`
+		Total  uint64 `json:"total"`
+	}
+
+	// ProductFilter describes query parameters of product lists, zero values are omitted.
+	ProductFilter struct {
+		Limit      uint64
+		Offset     uint64
+		Currency   string     // ISO 4217 code to convert prices to
+		CategoryID *uuid.UUID // products of subcategories are included
+		Tag        string
+	}
+
+	// CreateProductRequest is a model for creating a product, the vendor is referenced by VendorID or Vendor name.
+	CreateProductRequest struct {
+		Name        string          `json:"name"`
+		VendorID    *uuid.UUID      `json:"vendor_id,omitempty"`
+		Vendor      string          `json:"vendor,omitempty"`
+		Description string          `json:"description"`
+		Price       decimal.Decimal `json:"price"`
+		Currency    string          `json:"currency,omitempty"`
+		CategoryIDs []uuid.UUID     `json:"category_ids,omitempty"`
+		Tags        []string        `json:"tags,omitempty"`
+	}
+
+	// UpdateProductRequest is a model for updating a product.
+	UpdateProductRequest struct {
+		Name        string      `json:"name"`
+		VendorID    *uuid.UUID  `json:"vendor_id,omitempty"`
+		Vendor      string      `json:"vendor,omitempty"`
+		Description string      `json:"description"`
+		CategoryIDs []uuid.UUID `json:"category_ids,omitempty"`
+		Tags        []string    `json:"tags,omitempty"`
+	}
+
+	// Product is a product.
+	Product struct {
+		ID          uuid.UUID       `json:"id"`
+		Name        string          `json:"name"`
+		VendorID    uuid.UUID       `json:"vendor_id"`
+		Vendor      string          `json:"vendor"`
+		Description string          `json:"description"`
+		Price       decimal.Decimal `json:"price"`
+		Currency    string          `json:"currency"`
+		CategoryIDs []uuid.UUID     `json:"category_ids"`
+		Tags        []string        `json:"tags"`
+		Images      []ProductImage  `json:"images"`
+		CreatedAt   time.Time       `json:"created_at"`
+		UpdatedAt   time.Time       `json:"updated_at"`
+	}
+
+	// ProductImage is an image listed in a product.
+	ProductImage struct {
+		ID          uuid.UUID `json:"id"`
+		ContentType string    `json:"content_type"`
+		Size        int64     `json:"size"`
+		Checksum    string    `json:"checksum"`
+		URL         string    `json:"url"`
+	}
+
+	// ProductList is a page of products.
+	ProductList struct {
+		Pagination Pagination `json:"pagination"`
+		Products   []Product  `json:"products"`
+	}
+
+	// VendorRequest is a model for creating and renaming a vendor.
+	VendorRequest struct {
+		Name string `json:"name"`
+	}
+
+	// Vendor is a vendor of products.
+	Vendor struct {
+		ID        uuid.UUID `json:"id"`
+		Name      string    `json:"name"`
+		CreatedAt time.Time `json:"created_at"`
+		UpdatedAt time.Time `json:"updated_at"`
+	}
+
+	// VendorList is a page of vendors.
+	VendorList struct {
+		Pagination Pagination `json:"pagination"`
+		Vendors    []Vendor   `json:"vendors"`
+	}
+
+	// CategoryRequest is a model for creating and updating a category, nil ParentID makes a root category.
+	CategoryRequest struct {
+		Name     string     `json:"name"`
+		ParentID *uuid.UUID `json:"parent_id"`
+	}
+
+	// Category is a category of products, Children are set only in the categories tree.
+	Category struct {
+		ID        uuid.UUID  `json:"id"`
+		ParentID  *uuid.UUID `json:"parent_id"`
+		Name      string     `json:"name"`
+		CreatedAt time.Time  `json:"created_at"`
+		UpdatedAt time.Time  `json:"updated_at"`
+		Children  []Category `json:"children,omitempty"`
+	}
+
+	// CategoryTree is the tree of root categories.
+	CategoryTree struct {
+		Categories []Category `json:"categories"`
+	}
+
+	// CreatePriceRequest is a model for scheduling a price of a product.
+	CreatePriceRequest struct {
+		Price         decimal.Decimal `json:"price"`
+		Currency      string          `json:"currency,omitempty"`
+		EffectiveFrom *time.Time      `json:"effective_from,omitempty"`
+		EffectiveTo   *time.Time      `json:"effective_to,omitempty"`
+	}
+
+	// Price is a price of a product effective in a period.
+	Price struct {
+		ID            uuid.UUID       `json:"id"`
+		ProductID     uuid.UUID       `json:"product_id"`
+		Price         decimal.Decimal `json:"price"`
+		Currency      string          `json:"currency"`
+		EffectiveFrom time.Time       `json:"effective_from"`
+		EffectiveTo   *time.Time      `json:"effective_to,omitempty"`
+		CreatedAt     time.Time       `json:"created_at"`
+	}
+
+	// PriceList is a price history of a product.
+	PriceList struct {
+		Prices []Price `json:"prices"`
+	}
+
+	// Image is an image of a product.
+	Image struct {
+		ID          uuid.UUID `json:"id"`
+		ProductID   uuid.UUID `json:"product_id"`
+		Key         string    `json:"key"`
+		ContentType string    `json:"content_type"`
+		Size        int64     `json:"size"`
+		Checksum    string    `json:"checksum"`
+		URL         string    `json:"url"`
+		CreatedAt   time.Time `json:"created_at"`
+	}
+
+	// ImageList is a list of images of a product.
+	ImageList struct {
+		Images []Image `json:"images"`
+	}
+
+	// AdjustmentRequest is a model for adjusting stock, negative Delta removes units.
+	AdjustmentRequest struct {
+		Warehouse         string `json:"warehouse,omitempty"`
+		Delta             int64  `json:"delta"`
+		LowStockThreshold *int64 `json:"low_stock_threshold,omitempty"`
+	}
+
+	// ReservationRequest is a model for reserving stock, zero TTLSeconds means the default TTL.
+	ReservationRequest struct {
+		Warehouse  string `json:"warehouse,omitempty"`
+		Quantity   int64  `json:"quantity"`
+		TTLSeconds int64  `json:"ttl_seconds,omitempty"`
+	}
+
+	// Stock is a stock level of a product in a warehouse.
+	Stock struct {
+		ProductID         uuid.UUID `json:"product_id"`
+		Warehouse         string    `json:"warehouse"`
+		Quantity          int64     `json:"quantity"`
+		Reserved          int64     `json:"reserved"`
+		Available         int64     `json:"available"`
+		LowStockThreshold int64     `json:"low_stock_threshold"`
+		UpdatedAt         time.Time `json:"updated_at"`
+	}
+
+	// StockList is stock levels of a product in all warehouses.
+	StockList struct {
+		ProductID  uuid.UUID `json:"product_id"`
+		Available  int64     `json:"available"`
+		Warehouses []Stock   `json:"warehouses"`
+	}
+
+	// Reservation is a reservation of stock.
+	Reservation struct {
+		ID         uuid.UUID  `json:"id"`
+		ProductID  uuid.UUID  `json:"product_id"`
+		Warehouse  string     `json:"warehouse"`
+		Quantity   int64      `json:"quantity"`
+		ExpiresAt  time.Time  `json:"expires_at"`
+		ReleasedAt *time.Time `json:"released_at"`
+		CreatedAt  time.Time  `json:"created_at"`
+	}
+
+	// Rate is a rate of a currency: units of currency per one unit of the base currency.
+	Rate struct {
+		Currency  string          `json:"currency"`
+		Rate      decimal.Decimal `json:"rate"`
+		UpdatedAt time.Time       `json:"updated_at"`
+	}
+
+	// RateList is rates of all currencies.
+	RateList struct {
+		Base  string `json:"base"`
+		Rates []Rate `json:"rates"`
+	}
+
+	// RepublishRequest selects events of products to publish again: events of the product of ProductID, or of
+	// products created or updated within [From, To). To is not bounded when nil.
+	RepublishRequest struct {
+		ProductID *uuid.UUID `json:"product_id,omitempty"`
+		From      *time.Time `json:"from,omitempty"`
+		To        *time.Time `json:"to,omitempty"`
+	}
+
+	// RepublishResult is numbers of products found and of their events published again.
+	RepublishResult struct {
+		Products int `json:"products"`
+		Created  int `json:"created"`
+		Updated  int `json:"updated"`
+	}
+)
@@ -0,0 +1,22 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// GetPrices returns price history of a product, non-empty currency converts the prices.
+func (c *Client) GetPrices(ctx context.Context, productID uuid.UUID, currency string) (PriceList, error) {
+	var out PriceList
+	err := c.call(ctx, http.MethodGet, "/products/"+productID.String()+"/prices", currencyQuery(currency), nil, &out)
+	return out, err
+}
+
+// CreatePrice schedules a price of a product.
+func (c *Client) CreatePrice(ctx context.Context, productID uuid.UUID, req CreatePriceRequest) (Price, error) {
+	var out Price
+	err := c.call(ctx, http.MethodPost, "/products/"+productID.String()+"/prices", nil, req, &out)
+	return out, err
+}
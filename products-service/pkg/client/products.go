@@ -0,0 +1,79 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+// GetProducts returns a page of products matching filter.
+func (c *Client) GetProducts(ctx context.Context, filter ProductFilter) (ProductList, error) {
+	var out ProductList
+	err := c.call(ctx, http.MethodGet, "/products", filter.query(), nil, &out)
+	return out, err
+}
+
+// GetVendorProducts returns a page of products of a vendor matching filter.
+func (c *Client) GetVendorProducts(ctx context.Context, vendorID uuid.UUID, filter ProductFilter) (ProductList, error) {
+	var out ProductList
+	err := c.call(ctx, http.MethodGet, "/vendors/"+vendorID.String()+"/products", filter.query(), nil, &out)
+	return out, err
+}
+
+// GetProduct returns a product, non-empty currency converts its price.
+func (c *Client) GetProduct(ctx context.Context, id uuid.UUID, currency string) (Product, error) {
+	var out Product
+	err := c.call(ctx, http.MethodGet, "/products/"+id.String(), currencyQuery(currency), nil, &out)
+	return out, err
+}
+
+// CreateProduct creates a product.
+func (c *Client) CreateProduct(ctx context.Context, req CreateProductRequest) (Product, error) {
+	var out Product
+	err := c.call(ctx, http.MethodPost, "/products", nil, req, &out)
+	return out, err
+}
+
+// UpdateProduct updates a product.
+func (c *Client) UpdateProduct(ctx context.Context, id uuid.UUID, req UpdateProductRequest) (Product, error) {
+	var out Product
+	err := c.call(ctx, http.MethodPut, "/products/"+id.String(), nil, req, &out)
+	return out, err
+}
+
+// DeleteProduct deletes a product.
+func (c *Client) DeleteProduct(ctx context.Context, id uuid.UUID) error {
+	return c.call(ctx, http.MethodDelete, "/products/"+id.String(), nil, nil, nil)
+}
+
+// query returns query parameters of the filter.
+func (f ProductFilter) query() url.Values {
+	q := currencyQuery(f.Currency)
+	if f.Limit > 0 {
+		q.Set("limit", strconv.FormatUint(f.Limit, 10))
+	}
+	if f.Offset > 0 {
+		q.Set("offset", strconv.FormatUint(f.Offset, 10))
+	}
+	if f.CategoryID != nil {
+		q.Set("category", f.CategoryID.String())
+	}
+	if f.Tag != "" {
+		q.Set("tag", f.Tag)
+	}
+
+	return q
+}
+
+// currencyQuery returns query parameters with currency if it is set.
+func currencyQuery(currency string) url.Values {
+	q := url.Values{}
+	if currency != "" {
+		q.Set("currency", currency)
+	}
+
+	return q
+}
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+// GetVendors returns a page of vendors, zero limit means the default limit.
+func (c *Client) GetVendors(ctx context.Context, limit, offset uint64) (VendorList, error) {
+	q := url.Values{}
+	if limit > 0 {
+		q.Set("limit", strconv.FormatUint(limit, 10))
+	}
+	if offset > 0 {
+		q.Set("offset", strconv.FormatUint(offset, 10))
+	}
+
+	var out VendorList
+	err := c.call(ctx, http.MethodGet, "/vendors", q, nil, &out)
+	return out, err
+}
+
+// GetVendor returns a vendor.
+func (c *Client) GetVendor(ctx context.Context, id uuid.UUID) (Vendor, error) {
+	var out Vendor
+	err := c.call(ctx, http.MethodGet, "/vendors/"+id.String(), nil, nil, &out)
+	return out, err
+}
+
+// CreateVendor creates a vendor.
+func (c *Client) CreateVendor(ctx context.Context, req VendorRequest) (Vendor, error) {
+	var out Vendor
+	err := c.call(ctx, http.MethodPost, "/vendors", nil, req, &out)
+	return out, err
+}
+
+// UpdateVendor renames a vendor.
+func (c *Client) UpdateVendor(ctx context.Context, id uuid.UUID, req VendorRequest) (Vendor, error) {
+	var out Vendor
+	err := c.call(ctx, http.MethodPut, "/vendors/"+id.String(), nil, req, &out)
+	return out, err
+}
+
+// DeleteVendor deletes a vendor without products.
+func (c *Client) DeleteVendor(ctx context.Context, id uuid.UUID) error {
+	return c.call(ctx, http.MethodDelete, "/vendors/"+id.String(), nil, nil, nil)
+}
@@ -0,0 +1,226 @@
+package dbrouter
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/pkg/pgerrs"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// replicaStateQuery returns the WAL position a server has applied and its replication lag in seconds,
+// servers which are not in recovery are never behind themselves.
+const replicaStateQuery = `
+	SELECT (CASE WHEN pg_is_in_recovery() THEN pg_last_wal_replay_lsn() ELSE pg_current_wal_lsn() END)::text,
+	       CASE WHEN NOT pg_is_in_recovery() OR pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+	            ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+	       END::float8;
+	`
+
+var _ sqlx.ExtContext = &Router{}
+
+type (
+	// Router - routes read queries to healthy replicas which have applied writes of the request,
+	// other queries and fallbacks go to the primary. Only queries known to be reads must be run by Router,
+	// writes are still sent to the primary by ExecContext.
+	Router struct {
+		primary  *sqlx.DB
+		replicas []*replica
+		maxLag   atomic.Int64 // time.Duration, it may be changed by SetMaxLag while replicas are checked
+		next     atomic.Uint64
+		logger   *zap.Logger
+	}
+
+	// replica - state of a replica updated by health checks.
+	replica struct {
+		name      string
+		db        *sqlx.DB
+		healthy   atomic.Bool
+		replayLSN atomic.Uint64
+	}
+)
+
+// New - constructor of Router, replicas are named by their index in logs and unused until they are checked.
+func New(primary *sqlx.DB, replicas []*sqlx.DB, maxLag time.Duration, logger *zap.Logger) *Router {
+	r := &Router{
+		primary: primary,
+		logger:  logger.With(zap.String("component", "dbrouter")),
+	}
+	r.SetMaxLag(maxLag)
+
+	for i, db := range replicas {
+		r.replicas = append(r.replicas, &replica{name: "replica-" + strconv.Itoa(i), db: db})
+	}
+
+	return r
+}
+
+// HasReplicas reports whether reads can be routed to replicas, a nil router has no replicas.
+func (r *Router) HasReplicas() bool { return r != nil && len(r.replicas) > 0 }
+
+// SetMaxLag sets max lag of healthy replicas, it is applied by the next check.
+func (r *Router) SetMaxLag(maxLag time.Duration) { r.maxLag.Store(int64(maxLag)) }
+
+// Primary returns the primary database.
+func (r *Router) Primary() *sqlx.DB { return r.primary }
+
+// Close closes connections to replicas and to the primary.
+func (r *Router) Close() error {
+	errs := make([]error, 0, len(r.replicas)+1)
+	for _, rep := range r.replicas {
+		if err := rep.db.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", rep.name, err))
+		}
+	}
+	errs = append(errs, r.primary.Close())
+
+	return errors.Join(errs...)
+}
+
+// Check updates health and WAL positions of replicas, lagging replicas and replicas failed to respond are
+// unhealthy until the next check.
+func (r *Router) Check(ctx context.Context) {
+	for _, rep := range r.replicas {
+		var (
+			lsn        string
+			lagSeconds float64
+		)
+
+		err := rep.db.QueryRowxContext(ctx, replicaStateQuery).Scan(&lsn, &lagSeconds)
+		if err == nil {
+			var parsed uint64
+			if parsed, err = ParseLSN(lsn); err == nil {
+				rep.replayLSN.Store(parsed)
+			}
+		}
+
+		lag := time.Duration(lagSeconds * float64(time.Second))
+		healthy := err == nil && lag <= time.Duration(r.maxLag.Load())
+
+		if rep.healthy.Swap(healthy) != healthy {
+			r.logger.Info("replica health changed", zap.String("replica", rep.name),
+				zap.Bool("healthy", healthy), zap.Duration("lag", lag), zap.Error(err))
+		}
+	}
+}
+
+// Run checks replicas every interval until ctx is done, replicas not responding within interval are unhealthy.
+func (r *Router) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			checkCtx, cancel := context.WithTimeout(ctx, interval)
+			r.Check(checkCtx)
+			cancel()
+		}
+	}
+}
+
+// PrimaryLSN returns the current WAL position of the primary, replicas have applied writes committed before
+// once they reach it.
+func (r *Router) PrimaryLSN(ctx context.Context) (uint64, error) {
+	var lsn string
+	if err := r.primary.QueryRowxContext(ctx, `SELECT pg_current_wal_lsn()::text`).Scan(&lsn); err != nil {
+		return 0, err
+	}
+
+	return ParseLSN(lsn)
+}
+
+// pick returns a healthy replica which has applied the WAL position required by ctx, replicas are used
+// in turns. It returns nil when reads must go to the primary.
+func (r *Router) pick(ctx context.Context) *replica {
+	if len(r.replicas) == 0 {
+		return nil
+	}
+
+	minLSN := MinLSN(ctx)
+	start := r.next.Add(1)
+
+	for i := range uint64(len(r.replicas)) {
+		rep := r.replicas[(start+i)%uint64(len(r.replicas))]
+		if rep.healthy.Load() && rep.replayLSN.Load() >= minLSN {
+			return rep
+		}
+	}
+
+	return nil
+}
+
+// fallback marks a replica failed to run a query as unhealthy when it lost connection, the query is run
+// on the primary then.
+func (r *Router) fallback(rep *replica, err error) bool {
+	if !pgerrs.IsConnectionLost(err) {
+		return false
+	}
+
+	if rep.healthy.Swap(false) {
+		r.logger.Warn("replica is unavailable, reads fall back to primary",
+			zap.String("replica", rep.name), zap.Error(err))
+	}
+
+	return true
+}
+
+// QueryContext implements sqlx.QueryerContext.
+func (r *Router) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	if rep := r.pick(ctx); rep != nil {
+		rows, err := rep.db.QueryContext(ctx, query, args...)
+		if err == nil || !r.fallback(rep, err) {
+			return rows, err
+		}
+	}
+
+	return r.primary.QueryContext(ctx, query, args...)
+}
+
+// QueryxContext implements sqlx.QueryerContext.
+func (r *Router) QueryxContext(ctx context.Context, query string, args ...any) (*sqlx.Rows, error) {
+	if rep := r.pick(ctx); rep != nil {
+		rows, err := rep.db.QueryxContext(ctx, query, args...)
+		if err == nil || !r.fallback(rep, err) {
+			return rows, err
+		}
+	}
+
+	return r.primary.QueryxContext(ctx, query, args...)
+}
+
+// QueryRowxContext implements sqlx.QueryerContext.
+func (r *Router) QueryRowxContext(ctx context.Context, query string, args ...any) *sqlx.Row {
+	if rep := r.pick(ctx); rep != nil {
+		row := rep.db.QueryRowxContext(ctx, query, args...)
+		if row.Err() == nil || !r.fallback(rep, row.Err()) {
+			return row
+		}
+	}
+
+	return r.primary.QueryRowxContext(ctx, query, args...)
+}
+
+// ExecContext implements sqlx.ExecerContext, statements always run on the primary.
+func (r *Router) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	return r.primary.ExecContext(ctx, query, args...)
+}
+
+// DriverName implements sqlx.ExtContext.
+func (r *Router) DriverName() string { return r.primary.DriverName() }
+
+// Rebind implements sqlx.ExtContext.
+func (r *Router) Rebind(query string) string { return r.primary.Rebind(query) }
+
+// BindNamed implements sqlx.ExtContext.
+func (r *Router) BindNamed(query string, arg any) (string, []any, error) {
+	return r.primary.BindNamed(query, arg)
+}
@@ -0,0 +1,55 @@
+package dbrouter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// TestLSN tests parsing and formatting of WAL positions.
+func TestLSN(t *testing.T) {
+	lsn, err := ParseLSN("16/B374D848")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0x16B374D848), lsn)
+	assert.Equal(t, "16/B374D848", FormatLSN(lsn))
+
+	for _, invalid := range []string{"", "16", "16/", "x/1", "1/100000000"} {
+		_, err = ParseLSN(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+// TestRouterPick tests that reads go only to healthy replicas which have applied the WAL position of ctx.
+func TestRouterPick(t *testing.T) {
+	r := New(&sqlx.DB{}, []*sqlx.DB{{}, {}}, time.Second, zap.NewNop())
+	ctx := context.Background()
+
+	assert.Nil(t, r.pick(ctx), "replicas are unused until they are checked")
+
+	r.replicas[0].healthy.Store(true)
+	r.replicas[0].replayLSN.Store(100)
+	r.replicas[1].healthy.Store(true)
+	r.replicas[1].replayLSN.Store(200)
+
+	picked := map[*replica]bool{}
+	for range 4 {
+		picked[r.pick(ctx)] = true
+	}
+	assert.Len(t, picked, 2, "healthy replicas are used in turns")
+
+	for range 4 {
+		assert.Same(t, r.replicas[1], r.pick(WithMinLSN(ctx, 150)))
+	}
+	assert.Nil(t, r.pick(WithMinLSN(ctx, 250)), "no replica has applied the write")
+
+	r.replicas[1].healthy.Store(false)
+	assert.Nil(t, r.pick(WithMinLSN(ctx, 150)))
+	assert.Same(t, r.replicas[0], r.pick(ctx))
+
+	assert.Nil(t, New(&sqlx.DB{}, nil, time.Second, zap.NewNop()).pick(ctx))
+}
@@ -0,0 +1,47 @@
+package dbrouter
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// minLSNKey - key of the WAL position reads of a request must observe.
+type minLSNKey struct{}
+
+// ParseLSN parses WAL position in the Postgres text format, e.g. 16/B374D848.
+func ParseLSN(s string) (uint64, error) {
+	hi, lo, ok := strings.Cut(s, "/")
+	if !ok {
+		return 0, fmt.Errorf("invalid LSN %q", s)
+	}
+
+	high, err := strconv.ParseUint(hi, 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid LSN %q: %w", s, err)
+	}
+
+	low, err := strconv.ParseUint(lo, 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid LSN %q: %w", s, err)
+	}
+
+	return high<<32 | low, nil
+}
+
+// FormatLSN formats WAL position in the Postgres text format.
+func FormatLSN(lsn uint64) string {
+	return fmt.Sprintf("%X/%X", lsn>>32, uint32(lsn))
+}
+
+// WithMinLSN returns ctx whose reads are routed only to replicas which have applied lsn.
+func WithMinLSN(ctx context.Context, lsn uint64) context.Context {
+	return context.WithValue(ctx, minLSNKey{}, lsn)
+}
+
+// MinLSN returns the WAL position reads of ctx must observe, it is 0 when any healthy replica can be used.
+func MinLSN(ctx context.Context) uint64 {
+	lsn, _ := ctx.Value(minLSNKey{}).(uint64)
+	return lsn
+}
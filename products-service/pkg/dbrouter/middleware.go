@@ -0,0 +1,75 @@
+package dbrouter
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+const (
+	// HeaderLastWriteLSN - header with the WAL position of the last write of a client. It is responded to
+	// writes and read from requests, so reads are routed to replicas which have applied the write.
+	HeaderLastWriteLSN = "X-Last-Write-LSN"
+	// CookieLastWriteLSN - cookie holding HeaderLastWriteLSN for browsers.
+	CookieLastWriteLSN = "last_write_lsn"
+
+	// lastWriteLSNMaxAge - lifetime of the cookie, replicas lagging longer are unhealthy anyway.
+	lastWriteLSNMaxAge = time.Minute
+)
+
+// Middleware gives clients read-your-writes consistency: successful writes respond the WAL position of
+// the primary, requests sending it back read only from replicas which have applied it or from the primary.
+// It does nothing when there are no replicas.
+func Middleware(r *Router) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		if !r.HasReplicas() {
+			return ctx.Next()
+		}
+
+		lastWrite := ctx.Get(HeaderLastWriteLSN)
+		if lastWrite == "" {
+			lastWrite = ctx.Cookies(CookieLastWriteLSN)
+		}
+		if lsn, err := ParseLSN(lastWrite); err == nil {
+			ctx.Context().SetUserValue(minLSNKey{}, lsn)
+		}
+
+		if err := ctx.Next(); err != nil {
+			return err
+		}
+
+		if !isWrite(ctx.Method()) || ctx.Response().StatusCode() >= http.StatusBadRequest {
+			return nil
+		}
+
+		lsn, err := r.PrimaryLSN(ctx.Context())
+		if err != nil {
+			r.logger.Warn("failed to get WAL position of the write", zap.Error(err))
+			return nil
+		}
+
+		ctx.Set(HeaderLastWriteLSN, FormatLSN(lsn))
+		ctx.Cookie(&fiber.Cookie{
+			Name:     CookieLastWriteLSN,
+			Value:    FormatLSN(lsn),
+			MaxAge:   int(lastWriteLSNMaxAge.Seconds()),
+			Path:     "/",
+			HTTPOnly: true,
+			SameSite: fiber.CookieSameSiteLaxMode,
+		})
+
+		return nil
+	}
+}
+
+// isWrite reports whether requests with method change data.
+func isWrite(method string) bool {
+	switch method {
+	case fiber.MethodGet, fiber.MethodHead, fiber.MethodOptions:
+		return false
+	}
+
+	return true
+}
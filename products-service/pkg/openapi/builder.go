@@ -0,0 +1,172 @@
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// MIME types of request and response bodies.
+const (
+	MIMEApplicationJSON = "application/json"
+	MIMEMultipartForm   = "multipart/form-data"
+	MIMETextPlain       = "text/plain"
+	MIMETextHTML        = "text/html"
+	MIMETextEventStream = "text/event-stream"
+)
+
+type (
+	// Endpoint describes an HTTP handler with its Go models, the models are converted to schemas by reflection.
+	Endpoint struct {
+		Summary     string
+		Description string
+		Query       []Parameter // query and header parameters, path parameters are taken from the route
+		Request     any         // model of the request body, nil when there is no body
+		RequestType string      // media type of the request body, application/json by default
+		Status      int         // status code of a successful response
+		Response    any         // model of the successful response, nil when there is no body
+		// media type of the successful response, application/json by default
+		ResponseType string
+		Errors       []int // status codes of errors besides 500
+	}
+
+	// Builder builds an OpenAPI document.
+	Builder struct {
+		doc Document
+	}
+)
+
+// NewBuilder creates a builder of a document for an API served under basePath.
+func NewBuilder(info Info, basePath string) *Builder {
+	b := &Builder{
+		doc: Document{
+			OpenAPI: Version,
+			Info:    info,
+			Servers: []Server{{URL: basePath}},
+			Paths:   map[string]PathItem{},
+			Components: Components{
+				Schemas:   map[string]*Schema{},
+				Responses: map[string]*Response{},
+			},
+		},
+	}
+	b.addErrorResponses()
+
+	return b
+}
+
+// Add adds an operation of a route, path is in the router format relative to basePath, e.g. /products/:id.
+// It panics when the endpoint has no success status, so undescribed handlers are caught early.
+func (b *Builder) Add(method, routePath, tag string, e Endpoint) *Builder {
+	if e.Status == 0 {
+		panic("openapi: endpoint " + method + " " + routePath + " is not described")
+	}
+
+	specPath, params := convertPath(routePath)
+
+	op := &Operation{
+		Summary:     e.Summary,
+		Description: e.Description,
+		OperationID: operationID(method, specPath),
+		Parameters:  append(params, e.Query...),
+		Responses:   map[string]*Response{},
+	}
+	if tag != "" {
+		op.Tags = []string{tag}
+		if !slices.ContainsFunc(b.doc.Tags, func(t Tag) bool { return t.Name == tag }) {
+			b.doc.Tags = append(b.doc.Tags, Tag{Name: tag})
+		}
+	}
+
+	if e.Request != nil {
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]MediaType{defaultType(e.RequestType): {Schema: b.schemaOf(reflect.TypeOf(e.Request), true)}},
+		}
+	}
+
+	success := &Response{Description: http.StatusText(e.Status)}
+	if e.Response != nil {
+		success.Content = map[string]MediaType{
+			defaultType(e.ResponseType): {Schema: b.schemaOf(reflect.TypeOf(e.Response), false)},
+		}
+	}
+	op.Responses[strconv.Itoa(e.Status)] = success
+
+	for _, code := range append(e.Errors, http.StatusInternalServerError) {
+		op.Responses[strconv.Itoa(code)] = &Response{Ref: "#/components/responses/" + ErrorResponseName(code)}
+	}
+
+	item, ok := b.doc.Paths[specPath]
+	if !ok {
+		item = PathItem{}
+		b.doc.Paths[specPath] = item
+	}
+	item[strings.ToLower(method)] = op
+
+	return b
+}
+
+// Document returns the built document.
+func (b *Builder) Document() Document {
+	return b.doc
+}
+
+// QueryParam describes an optional query parameter of a scalar Go type given by example value.
+func QueryParam(name, description string, example any) Parameter {
+	return Parameter{
+		Name:        name,
+		In:          "query",
+		Description: description,
+		Schema:      (&Builder{}).schemaOf(reflect.TypeOf(example), true),
+	}
+}
+
+// HeaderParam describes an optional request header of a scalar Go type given by example value.
+func HeaderParam(name, description string, example any) Parameter {
+	p := QueryParam(name, description, example)
+	p.In = "header"
+
+	return p
+}
+
+// convertPath converts router path to OpenAPI path, e.g. /products/:id -> /products/{id},
+// parameters named id or *_id are UUIDs, others are strings.
+func convertPath(routePath string) (string, []Parameter) {
+	var params []Parameter
+
+	segments := strings.Split(routePath, "/")
+	for i, segment := range segments {
+		name, ok := strings.CutPrefix(segment, ":")
+		if !ok {
+			continue
+		}
+
+		schema := &Schema{Type: "string"}
+		if name == "id" || strings.HasSuffix(name, "_id") {
+			schema.Format = "uuid"
+		}
+
+		params = append(params, Parameter{Name: name, In: "path", Required: true, Schema: schema})
+		segments[i] = "{" + name + "}"
+	}
+
+	return strings.Join(segments, "/"), params
+}
+
+// operationID returns unique id of an operation, e.g. get_products_id.
+func operationID(method, specPath string) string {
+	id := strings.ToLower(method) + strings.NewReplacer("/", "_", "{", "", "}", "", "-", "_").Replace(specPath)
+	return strings.TrimSuffix(id, "_")
+}
+
+// defaultType returns media type or application/json when it is empty.
+func defaultType(mediaType string) string {
+	if mediaType == "" {
+		return MIMEApplicationJSON
+	}
+
+	return mediaType
+}
@@ -0,0 +1,63 @@
+package openapi
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	testRequest struct {
+		Name     string     `json:"name" valid:"required,max=10"`
+		Price    float64    `json:"price" valid:"min=0"`
+		ParentID *uuid.UUID `json:"parent_id"`
+	}
+
+	testResponse struct {
+		ID       uuid.UUID `json:"id"`
+		Note     string    `json:"note,omitempty"`
+		Children []testResponse
+	}
+)
+
+// TestBuilderAdd tests path conversion, schemas of request and response models and error references.
+func TestBuilderAdd(t *testing.T) {
+	doc := NewBuilder(Info{Title: "test", Version: "1"}, "/api").
+		Add(http.MethodPut, "/items/:id/parts/:name", "items", Endpoint{
+			Request:  testRequest{},
+			Status:   http.StatusOK,
+			Response: testResponse{},
+			Errors:   []int{http.StatusNotFound},
+		}).
+		Document()
+
+	op := doc.Paths["/items/{id}/parts/{name}"]["put"]
+	require.NotNil(t, op)
+	assert.Equal(t, "put_items_id_parts_name", op.OperationID)
+	require.Len(t, op.Parameters, 2)
+	assert.Equal(t, "uuid", op.Parameters[0].Schema.Format)
+	assert.Empty(t, op.Parameters[1].Schema.Format)
+	assert.Equal(t, "#/components/responses/404", op.Responses["404"].Ref)
+	assert.Equal(t, "#/components/responses/500", op.Responses["500"].Ref)
+	assert.Contains(t, doc.Components.Responses, "404")
+
+	req := doc.Components.Schemas["openapi.testRequest"]
+	require.NotNil(t, req)
+	assert.Equal(t, []string{"name"}, req.Required)
+	assert.Equal(t, 10, *req.Properties["name"].MaxLength)
+	assert.InDelta(t, 0, *req.Properties["price"].Minimum, 0)
+	assert.True(t, req.Properties["parent_id"].Nullable)
+
+	resp := doc.Components.Schemas["openapi.testResponse"]
+	require.NotNil(t, resp)
+	assert.Equal(t, []string{"id", "Children"}, resp.Required)
+	assert.Equal(t, "#/components/schemas/openapi.testResponse", resp.Properties["Children"].Items.Ref)
+}
+
+// TestBuilderAddUndescribed tests that an endpoint without success status panics.
+func TestBuilderAddUndescribed(t *testing.T) {
+	assert.Panics(t, func() { NewBuilder(Info{}, "/api").Add(http.MethodGet, "/items", "", Endpoint{}) })
+}
@@ -0,0 +1,86 @@
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/platform/responder"
+)
+
+// httpErrors - errors returned by handlers, documented as reusable responses with their messages as examples.
+var httpErrors = []errs.HTTPError{
+	errs.FieldsValidation{Errors: []string{"name::is_required", "price::max_value_is::1000000"}},
+	errs.BadRequest{Cause: "invalid product id"},
+	errs.Unauthorized{},
+	errs.PaymentRequired{},
+	errs.Forbidden{},
+	errs.NotFound{What: "product"},
+	errs.MethodNotAllowed{},
+	errs.NotAcceptable{},
+	errs.RequestTimeout{},
+	errs.Conflict{What: "product already exists"},
+	errs.Gone{},
+	errs.PayloadTooLarge{},
+	errs.UnsupportedMediaType{},
+	errs.TooManyRequests{},
+	errs.Internal{},
+	errs.NotImplemented{},
+	errs.BadGateway{},
+	errs.ServiceUnavailable{},
+	errs.GatewayTimeout{},
+	errs.HTTPVersionNotSupported{},
+}
+
+// errorDescriptions - details of error responses which are not obvious from their status text.
+var errorDescriptions = map[int]string{
+	http.StatusBadRequest: "Malformed request (`detail` is the cause) or invalid fields " +
+		"(`type` is `" + responder.ProblemTypeValidation + "`, `errors` lists `{field, code, params}`). " +
+		"Codes: `is_required`, `invalid_format`, `max_length_is`, `min_value_is`, `max_value_is`, " +
+		"`max_decimals_is`, `max_items_is`, `item_is_required`, `item_max_length_is`, `is_unknown`, " +
+		"`invalid_value`, `not_found`, params hold their limits.",
+	http.StatusNotFound:            "The resource is not found: `not_found - <what>`.",
+	http.StatusConflict:            "The request conflicts with the current state: `conflict - <what>`.",
+	http.StatusInternalServerError: "Unexpected error: `internal_server_error - <cause>`.",
+}
+
+// ErrorResponseName returns name of the reusable response of an error status code.
+func ErrorResponseName(code int) string {
+	return strconv.Itoa(code)
+}
+
+// addErrorResponses adds reusable responses of all errs status codes to components, errors are responded as
+// problem details or in the legacy format when it is accepted.
+func (b *Builder) addErrorResponses() {
+	problemSchema := b.schemaOf(reflect.TypeFor[responder.Problem](), false)
+	legacySchema := b.schemaOf(reflect.TypeFor[responder.Error](), false)
+
+	for _, e := range httpErrors {
+		code := e.StatusCode()
+		name := ErrorResponseName(code)
+		if _, ok := b.doc.Components.Responses[name]; ok {
+			// the first error of a status code is its example
+			continue
+		}
+
+		description := errorDescriptions[code]
+		if description == "" {
+			description = http.StatusText(code) + ": `" + e.Error() + "`."
+		}
+
+		b.doc.Components.Responses[name] = &Response{
+			Description: description,
+			Content: map[string]MediaType{
+				responder.MIMEProblemJSON: {
+					Schema:  problemSchema,
+					Example: responder.NewProblem(e, "0b4f2c1e-5d7a-4c1b-9e2f-3a6d8c9b1f20"),
+				},
+				responder.MIMELegacyErrorJSON: {
+					Schema:  legacySchema,
+					Example: responder.Error{Error: e.Error()},
+				},
+			},
+		}
+	}
+}
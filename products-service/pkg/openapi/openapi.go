@@ -0,0 +1,102 @@
+// Package openapi builds OpenAPI 3 documents from HTTP routes and their Go request and response models.
+package openapi
+
+// Version is the OpenAPI specification version of built documents.
+const Version = "3.0.3"
+
+// OpenAPI 3 document objects, only the subset used by the services.
+type (
+	// Document is the root object of an OpenAPI document.
+	Document struct {
+		OpenAPI    string              `json:"openapi"`
+		Info       Info                `json:"info"`
+		Servers    []Server            `json:"servers,omitempty"`
+		Tags       []Tag               `json:"tags,omitempty"`
+		Paths      map[string]PathItem `json:"paths"`
+		Components Components          `json:"components"`
+	}
+
+	// Info describes the API.
+	Info struct {
+		Title       string `json:"title"`
+		Description string `json:"description,omitempty"`
+		Version     string `json:"version"`
+	}
+
+	// Server describes a base URL of the API.
+	Server struct {
+		URL string `json:"url"`
+	}
+
+	// Tag groups operations.
+	Tag struct {
+		Name string `json:"name"`
+	}
+
+	// PathItem describes operations of a path keyed by lower-case HTTP method.
+	PathItem map[string]*Operation
+
+	// Operation describes a single API operation on a path.
+	Operation struct {
+		Tags        []string             `json:"tags,omitempty"`
+		Summary     string               `json:"summary,omitempty"`
+		Description string               `json:"description,omitempty"`
+		OperationID string               `json:"operationId"`
+		Parameters  []Parameter          `json:"parameters,omitempty"`
+		RequestBody *RequestBody         `json:"requestBody,omitempty"`
+		Responses   map[string]*Response `json:"responses"`
+	}
+
+	// Parameter describes a path or query parameter.
+	Parameter struct {
+		Name        string  `json:"name"`
+		In          string  `json:"in"`
+		Description string  `json:"description,omitempty"`
+		Required    bool    `json:"required,omitempty"`
+		Schema      *Schema `json:"schema"`
+	}
+
+	// RequestBody describes a request body.
+	RequestBody struct {
+		Required bool                 `json:"required"`
+		Content  map[string]MediaType `json:"content"`
+	}
+
+	// Response describes a response or references one of components.
+	Response struct {
+		Ref         string               `json:"$ref,omitempty"`
+		Description string               `json:"description,omitempty"`
+		Content     map[string]MediaType `json:"content,omitempty"`
+	}
+
+	// MediaType describes content of a media type.
+	MediaType struct {
+		Schema  *Schema `json:"schema,omitempty"`
+		Example any     `json:"example,omitempty"`
+	}
+
+	// Components holds reusable objects of the document.
+	Components struct {
+		Schemas   map[string]*Schema   `json:"schemas"`
+		Responses map[string]*Response `json:"responses"`
+	}
+
+	// Schema describes a data type.
+	Schema struct {
+		Ref                  string             `json:"$ref,omitempty"`
+		Type                 string             `json:"type,omitempty"`
+		Format               string             `json:"format,omitempty"`
+		Description          string             `json:"description,omitempty"`
+		Nullable             bool               `json:"nullable,omitempty"`
+		Enum                 []any              `json:"enum,omitempty"`
+		Minimum              *float64           `json:"minimum,omitempty"`
+		Maximum              *float64           `json:"maximum,omitempty"`
+		MinLength            *int               `json:"minLength,omitempty"`
+		MaxLength            *int               `json:"maxLength,omitempty"`
+		MaxItems             *int               `json:"maxItems,omitempty"`
+		Items                *Schema            `json:"items,omitempty"`
+		Properties           map[string]*Schema `json:"properties,omitempty"`
+		Required             []string           `json:"required,omitempty"`
+		AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	}
+)
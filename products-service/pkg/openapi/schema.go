@@ -0,0 +1,145 @@
+package openapi
+
+import (
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Binary is a model of binary content, e.g. a file field of a multipart form.
+type Binary []byte
+
+var (
+	binaryType  = reflect.TypeFor[Binary]()
+	timeType    = reflect.TypeFor[time.Time]()
+	uuidType    = reflect.TypeFor[uuid.UUID]()
+	decimalType = reflect.TypeFor[decimal.Decimal]()
+)
+
+// schemaOf returns schema of a Go type, named structs are stored in components and referenced.
+// Fields of input (request) models are required by their valid tags, fields of output models are required
+// unless they are omitted when empty.
+func (b *Builder) schemaOf(t reflect.Type, input bool) *Schema {
+	switch t {
+	case binaryType:
+		return &Schema{Type: "string", Format: "binary"}
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case uuidType:
+		return &Schema{Type: "string", Format: "uuid"}
+	case decimalType:
+		return &Schema{Type: "number"}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		s := b.schemaOf(t.Elem(), input)
+		if s.Ref != "" {
+			// siblings of $ref are ignored in OpenAPI 3.0
+			return s
+		}
+		s.Nullable = true
+		return s
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64", Minimum: ptr(0.0)}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: b.schemaOf(t.Elem(), input)}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: b.schemaOf(t.Elem(), input)}
+	case reflect.Struct:
+		return b.structRef(t, input)
+	default:
+		return &Schema{}
+	}
+}
+
+// structRef stores schema of a struct in components and returns a reference to it.
+func (b *Builder) structRef(t reflect.Type, input bool) *Schema {
+	name := schemaName(t)
+	ref := &Schema{Ref: "#/components/schemas/" + name}
+	if _, ok := b.doc.Components.Schemas[name]; ok {
+		return ref
+	}
+
+	s := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	// registered before fields, so recursive types are referenced instead of expanded forever
+	b.doc.Components.Schemas[name] = s
+
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		prop := b.schemaOf(field.Type, input)
+		required := applyValidTag(prop, field.Tag.Get("valid"))
+		if required || (!input && !strings.Contains(opts, "omitempty")) {
+			s.Required = append(s.Required, name)
+		}
+		s.Properties[name] = prop
+	}
+
+	return ref
+}
+
+// applyValidTag applies go-validator rules of a field to its schema and reports whether the field is required.
+func applyValidTag(s *Schema, tag string) bool {
+	var required bool
+	for rule := range strings.SplitSeq(tag, ",") {
+		name, value, _ := strings.Cut(rule, "=")
+		n, err := strconv.ParseFloat(value, 64)
+
+		switch {
+		case name == "required":
+			required = true
+		case err != nil || s.Ref != "":
+			continue
+		case s.Type == "string" && name == "max":
+			s.MaxLength = ptr(int(n))
+		case s.Type == "string" && name == "min":
+			s.MinLength = ptr(int(n))
+		case s.Type == "array" && name == "max":
+			s.MaxItems = ptr(int(n))
+		case name == "max":
+			s.Maximum = ptr(n)
+		case name == "min":
+			s.Minimum = ptr(n)
+		}
+	}
+
+	return required
+}
+
+// schemaName returns name of a named type qualified with its package name, e.g. responder.Error.
+func schemaName(t reflect.Type) string {
+	return path.Base(t.PkgPath()) + "." + t.Name()
+}
+
+// ptr returns a pointer to v.
+func ptr[T any](v T) *T { return &v }
@@ -0,0 +1,166 @@
+package pgerrs
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/lib/pq"
+)
+
+// SQLSTATE codes of errors classified by Map, see https://www.postgresql.org/docs/current/errcodes-appendix.html.
+const (
+	CodeNotNullViolation     = "23502"
+	CodeForeignKeyViolation  = "23503"
+	CodeUniqueViolation      = "23505"
+	CodeCheckViolation       = "23514"
+	CodeExclusionViolation   = "23P01"
+	CodeSerializationFailure = "40001"
+	CodeDeadlockDetected     = "40P01"
+	CodeTooManyConnections   = "53300"
+	CodeQueryCanceled        = "57014"
+	CodeAdminShutdown        = "57P01"
+	CodeCrashShutdown        = "57P02"
+	CodeCannotConnectNow     = "57P03"
+
+	classConnectionException = "08"
+)
+
+// TransactionConflict - describes a transaction aborted by a serialization failure or a deadlock,
+// such transactions are retried by Retry.
+// Status code: 409
+type TransactionConflict struct {
+	Cause error
+}
+
+// Error implements error interface.
+func (e TransactionConflict) Error() string {
+	return errs.Conflict{What: "concurrent update of the same data, try again"}.Error()
+}
+
+// StatusCode implements error interface.
+func (e TransactionConflict) StatusCode() int { return http.StatusConflict }
+
+// Unwrap returns the database error.
+func (e TransactionConflict) Unwrap() error { return e.Cause }
+
+// Code returns SQLSTATE code of a Postgres error of pgx or pq drivers, it is empty for other errors.
+func Code(err error) string {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return string(pqErr.Code)
+	}
+
+	return ""
+}
+
+// Constraint returns name of the constraint violated by a Postgres error, it is empty for other errors.
+func Constraint(err error) string {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.ConstraintName
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return pqErr.Constraint
+	}
+
+	return ""
+}
+
+// column returns name of the column of a Postgres error, it is empty for other errors.
+func column(err error) string {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.ColumnName
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return pqErr.Column
+	}
+
+	return ""
+}
+
+// IsRetryable reports whether the transaction failed with err can succeed when it is run again.
+func IsRetryable(err error) bool {
+	if errors.As(err, &TransactionConflict{}) {
+		return true
+	}
+
+	code := Code(err)
+
+	return code == CodeSerializationFailure || code == CodeDeadlockDetected
+}
+
+// IsConnectionLost reports whether err is caused by unavailable or lost connection to the database.
+func IsConnectionLost(err error) bool {
+	switch Code(err) {
+	case CodeTooManyConnections, CodeAdminShutdown, CodeCrashShutdown, CodeCannotConnectNow:
+		return true
+	case "":
+	default:
+		return strings.HasPrefix(Code(err), classConnectionException)
+	}
+
+	var connectErr *pgconn.ConnectError
+	var netErr net.Error
+
+	return errors.Is(err, driver.ErrBadConn) ||
+		errors.Is(err, sql.ErrConnDone) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.As(err, &connectErr) ||
+		errors.As(err, &netErr)
+}
+
+// Map maps database errors to application errors by SQLSTATE, errs.HTTPError are returned as is.
+// Repositories check constraints they know before, so the fields of requests are responded.
+func Map(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var httpErr errs.HTTPError
+	if errors.As(err, &httpErr) {
+		return err
+	}
+
+	switch code := Code(err); {
+	case code == CodeUniqueViolation:
+		return errs.AlreadyExists{What: Constraint(err)}
+	case code == CodeExclusionViolation:
+		return errs.Conflict{What: Constraint(err)}
+	case code == CodeCheckViolation:
+		return errs.BadRequest{Cause: "check constraint violated: " + Constraint(err)}
+	case code == CodeNotNullViolation:
+		return errs.FieldsValidation{Errors: []string{column(err) + "::is_required"}}
+	case code == CodeForeignKeyViolation:
+		return errs.BadRequest{Cause: "referenced entity not found: " + Constraint(err)}
+	case IsRetryable(err):
+		return TransactionConflict{Cause: err}
+	case errors.Is(err, context.Canceled):
+		// queries of canceled requests are canceled too, so the cancellation is checked before their code
+		return errs.RequestTimeout{Cause: "request canceled"}
+	case code == CodeQueryCanceled, errors.Is(err, context.DeadlineExceeded):
+		return errs.GatewayTimeout{Cause: "database query timed out"}
+	case IsConnectionLost(err):
+		return errs.ServiceUnavailable{Cause: "database is unavailable"}
+	}
+
+	return errs.Internal{Cause: err.Error()}
+}
@@ -0,0 +1,149 @@
+package pgerrs
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestMap tests that database errors of both drivers are mapped by SQLSTATE.
+func TestMap(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		want   error
+	}{
+		{
+			name:   "pgx unique violation",
+			err:    &pgconn.PgError{Code: CodeUniqueViolation, ConstraintName: "uq_vendors_normalized_name"},
+			status: http.StatusConflict,
+			want:   errs.AlreadyExists{What: "uq_vendors_normalized_name"},
+		},
+		{
+			name:   "pq unique violation",
+			err:    &pq.Error{Code: CodeUniqueViolation, Constraint: "uq_vendors_normalized_name"},
+			status: http.StatusConflict,
+			want:   errs.AlreadyExists{What: "uq_vendors_normalized_name"},
+		},
+		{
+			name:   "check violation",
+			err:    fmt.Errorf("insert: %w", &pgconn.PgError{Code: CodeCheckViolation, ConstraintName: "c"}),
+			status: http.StatusBadRequest,
+			want:   errs.BadRequest{Cause: "check constraint violated: c"},
+		},
+		{
+			name:   "not-null violation",
+			err:    &pgconn.PgError{Code: CodeNotNullViolation, ColumnName: "name"},
+			status: http.StatusBadRequest,
+			want:   errs.FieldsValidation{Errors: []string{"name::is_required"}},
+		},
+		{
+			name:   "query canceled by statement timeout",
+			err:    &pq.Error{Code: CodeQueryCanceled},
+			status: http.StatusGatewayTimeout,
+		},
+		{
+			name:   "context deadline",
+			err:    fmt.Errorf("query: %w", context.DeadlineExceeded),
+			status: http.StatusGatewayTimeout,
+		},
+		{
+			name:   "context canceled",
+			err:    context.Canceled,
+			status: http.StatusRequestTimeout,
+		},
+		{
+			name:   "query canceled by canceled request",
+			err:    errors.Join(context.Canceled, &pgconn.PgError{Code: CodeQueryCanceled}),
+			status: http.StatusRequestTimeout,
+		},
+		{
+			name:   "connection exception",
+			err:    &pgconn.PgError{Code: "08006"},
+			status: http.StatusServiceUnavailable,
+		},
+		{
+			name:   "bad connection",
+			err:    driver.ErrBadConn,
+			status: http.StatusServiceUnavailable,
+		},
+		{
+			name:   "deadlock",
+			err:    &pgconn.PgError{Code: CodeDeadlockDetected},
+			status: http.StatusConflict,
+		},
+		{
+			name:   "application error",
+			err:    errs.NotFound{What: "product"},
+			status: http.StatusNotFound,
+			want:   errs.NotFound{What: "product"},
+		},
+		{
+			name:   "unknown",
+			err:    errors.New("boom"),
+			status: http.StatusInternalServerError,
+			want:   errs.Internal{Cause: "boom"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Map(tt.err)
+
+			var httpErr errs.HTTPError
+			if assert.ErrorAs(t, got, &httpErr) {
+				assert.Equal(t, tt.status, httpErr.StatusCode())
+			}
+			if tt.want != nil {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+// TestRetry tests that only serialization failures and deadlocks are retried, at most MaxAttempts times.
+func TestRetry(t *testing.T) {
+	t.Run("succeeds after deadlock", func(t *testing.T) {
+		var calls int
+		err := Retry(context.Background(), func() error {
+			if calls++; calls == 1 {
+				return &pgconn.PgError{Code: CodeDeadlockDetected}
+			}
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		var calls int
+		err := Retry(context.Background(), func() error {
+			calls++
+			return TransactionConflict{Cause: &pq.Error{Code: CodeSerializationFailure}}
+		})
+
+		assert.ErrorAs(t, err, &TransactionConflict{})
+		assert.Equal(t, MaxAttempts, calls)
+	})
+
+	t.Run("does not retry other errors", func(t *testing.T) {
+		var calls int
+		err := Retry(context.Background(), func() error {
+			calls++
+			return &pgconn.PgError{Code: CodeUniqueViolation, ConstraintName: "c"}
+		})
+
+		assert.Equal(t, errs.AlreadyExists{What: "c"}, err)
+		assert.Equal(t, 1, calls)
+	})
+}
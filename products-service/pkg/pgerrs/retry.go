@@ -0,0 +1,36 @@
+package pgerrs
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+)
+
+const (
+	// MaxAttempts - max number of runs of a transaction failed with serialization failures or deadlocks.
+	MaxAttempts = 3
+
+	retryBaseDelay = 10 * time.Millisecond
+)
+
+// Retry runs fn, which runs a whole transaction, again while it fails with serialization failures or deadlocks,
+// at most MaxAttempts times. Errors are returned mapped by Map.
+func Retry(ctx context.Context, fn func() error) error {
+	var err error
+	for attempt := 1; ; attempt++ {
+		if err = fn(); err == nil || !IsRetryable(err) || attempt == MaxAttempts {
+			return Map(err)
+		}
+
+		// full jitter keeps concurrent transactions from colliding again
+		delay := time.Duration(rand.Int64N(int64(retryBaseDelay<<attempt))) + 1
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return Map(err)
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,172 @@
+//go:build integration
+
+package tests_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/categories"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	repocategories "github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/categories"
+	repoproducts "github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/products"
+	svccategories "github.com/at-kh/guru-apps-test-services/products-service/internal/api/services/categories"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// newCategory creates a category under parent, a root category when parent is nil.
+func newCategory(t *testing.T, db *sqlx.DB, name string, parent *uuid.UUID) categories.Category {
+	t.Helper()
+
+	c, err := repocategories.NewRepository(db, zap.NewExample()).Create(context.Background(),
+		categories.Category{Name: name, ParentID: parent})
+	require.NoError(t, err)
+
+	return c
+}
+
+func TestCategoriesRepository(t *testing.T) {
+	t.Parallel()
+
+	t.Run("moves under own subtree are rejected", func(t *testing.T) {
+		db := newTestDB(t)
+		ctx := context.Background()
+		service := svccategories.NewService(db, repocategories.NewRepository(db, zap.NewExample()), nil,
+			zap.NewExample())
+
+		root := newCategory(t, db, "Electronics", nil)
+		child := newCategory(t, db, "Computers", &root.ID)
+		grandchild := newCategory(t, db, "Laptops", &child.ID)
+
+		root.ParentID = &grandchild.ID
+		_, err := service.Update(ctx, root)
+		require.ErrorAs(t, err, &errs.BadRequest{})
+
+		root.ParentID = &root.ID
+		_, err = service.Update(ctx, root)
+		require.ErrorAs(t, err, &errs.BadRequest{})
+
+		grandchild.ParentID = &root.ID
+		moved, err := service.Update(ctx, grandchild)
+		require.NoError(t, err)
+		assert.Equal(t, root.ID, *moved.ParentID)
+	})
+
+	t.Run("concurrent opposite moves don't make a cycle", func(t *testing.T) {
+		db := newTestDB(t)
+		ctx := context.Background()
+		service := svccategories.NewService(db, repocategories.NewRepository(db, zap.NewExample()), nil,
+			zap.NewExample())
+
+		for range 20 {
+			a := newCategory(t, db, "a-"+uuid.NewString(), nil)
+			b := newCategory(t, db, "b-"+uuid.NewString(), nil)
+			a.ParentID, b.ParentID = &b.ID, &a.ID
+
+			var (
+				wg     sync.WaitGroup
+				errsAB = make([]error, 2)
+			)
+			for i, c := range []categories.Category{a, b} {
+				wg.Go(func() { _, errsAB[i] = service.Update(ctx, c) })
+			}
+			wg.Wait()
+
+			failed := 0
+			for _, err := range errsAB {
+				if err != nil {
+					require.ErrorAs(t, err, &errs.BadRequest{})
+					failed++
+				}
+			}
+			require.Equal(t, 1, failed, "exactly one of the moves is rejected")
+		}
+
+		list, err := service.GetAll(ctx)
+		require.NoError(t, err)
+		assert.Len(t, list, 40)
+	})
+
+	t.Run("delete reassigns products and subcategories", func(t *testing.T) {
+		db := newTestDB(t)
+		ctx := context.Background()
+		repo := repocategories.NewRepository(db, zap.NewExample())
+
+		root := newCategory(t, db, "Electronics", nil)
+		old := newCategory(t, db, "Gadgets", &root.ID)
+		sub := newCategory(t, db, "Watches", &old.ID)
+		target := newCategory(t, db, "Accessories", &root.ID)
+
+		_, err := repoproducts.NewRepository(db, zap.NewExample()).Create(ctx, products.Product{
+			Name: "watch-" + uuid.NewString(), Vendor: "vendorA", Price: decimal.New(1, 0),
+			CategoryIDs: []uuid.UUID{old.ID},
+		})
+		require.NoError(t, err)
+
+		err = repo.Delete(ctx, old.ID, nil)
+		require.ErrorAs(t, err, &errs.Conflict{})
+
+		err = repo.Delete(ctx, old.ID, &sub.ID)
+		require.ErrorAs(t, err, &errs.BadRequest{}, "a category can't be reassigned to its descendant")
+
+		require.NoError(t, repo.Delete(ctx, old.ID, &target.ID))
+
+		moved, err := repo.GetByID(ctx, sub.ID)
+		require.NoError(t, err)
+		assert.Equal(t, target.ID, *moved.ParentID)
+
+		list, err := repoproducts.NewRepository(db, zap.NewExample()).GetAll(ctx, 0, 0,
+			products.Filter{CategoryID: &target.ID})
+		require.NoError(t, err)
+		assert.Len(t, list.Products, 1)
+	})
+}
+
+func TestProductsRepository_CategoriesAndTags(t *testing.T) {
+	t.Parallel()
+
+	db := newTestDB(t)
+	ctx := context.Background()
+	repo := repoproducts.NewRepository(db, zap.NewExample())
+
+	root := newCategory(t, db, "Electronics", nil)
+	child := newCategory(t, db, "Computers", &root.ID)
+	other := newCategory(t, db, "Books", nil)
+
+	laptop, err := repo.Create(ctx, products.Product{
+		Name: "laptop", Vendor: "vendorA", Price: decimal.New(1, 0),
+		CategoryIDs: []uuid.UUID{child.ID}, Tags: []string{" Sale ", "new"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"new", "sale"}, laptop.Tags, "tags are normalized")
+
+	book, err := repo.Create(ctx, products.Product{
+		Name: "book", Vendor: "vendorA", Price: decimal.New(1, 0),
+		CategoryIDs: []uuid.UUID{other.ID}, Tags: []string{"sale"},
+	})
+	require.NoError(t, err)
+
+	list, err := repo.GetAll(ctx, 0, 0, products.Filter{CategoryID: &root.ID})
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{laptop.ID}, ids(list.Products), "products of subcategories are included")
+
+	list, err = repo.GetAll(ctx, 0, 0, products.Filter{Tag: "SALE"})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uuid.UUID{laptop.ID, book.ID}, ids(list.Products))
+
+	list, err = repo.GetAll(ctx, 0, 0, products.Filter{CategoryID: &other.ID, Tag: "new"})
+	require.NoError(t, err)
+	assert.Empty(t, list.Products)
+
+	_, err = repo.Create(ctx, products.Product{
+		Name: "missing", Vendor: "vendorA", Price: decimal.New(1, 0), CategoryIDs: []uuid.UUID{uuid.New()},
+	})
+	require.ErrorAs(t, err, &errs.FieldsValidation{}, "missing categories are fields errors")
+}
@@ -0,0 +1,73 @@
+//go:build integration
+
+package tests_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/images"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	repoimages "github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/images"
+	repoproducts "github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/products"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// newImage returns metadata of a PNG image of a product.
+func newImage(productID uuid.UUID) images.Image {
+	id := uuid.New()
+	return images.Image{
+		ID:          id,
+		ProductID:   productID,
+		Key:         images.Key(productID, id, "image/png"),
+		ContentType: "image/png",
+		Size:        3,
+		Checksum:    strings.Repeat("a", 64),
+	}
+}
+
+func TestImagesRepository(t *testing.T) {
+	t.Parallel()
+
+	db := newTestDB(t)
+	ctx := context.Background()
+	repo := repoimages.NewRepository(db, zap.NewExample())
+	productsRepo := repoproducts.NewRepository(db, zap.NewExample())
+
+	product, err := productsRepo.Create(ctx,
+		products.Product{Name: "images-" + uuid.NewString(), Vendor: "vendorA", Price: decimal.New(1, 0)})
+	require.NoError(t, err)
+
+	first, err := repo.Create(ctx, newImage(product.ID))
+	require.NoError(t, err)
+	assert.NotZero(t, first.CreatedAt)
+	second, err := repo.Create(ctx, newImage(product.ID))
+	require.NoError(t, err)
+
+	_, err = repo.Create(ctx, newImage(uuid.New()))
+	require.ErrorAs(t, err, &errs.NotFound{}, "images of missing products")
+
+	list, err := repo.GetByProductIDs(ctx, []uuid.UUID{product.ID, uuid.New()})
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, []uuid.UUID{first.ID, second.ID}, []uuid.UUID{list[0].ID, list[1].ID}, "the oldest first")
+	assert.Equal(t, first.Key, list[0].Key)
+
+	_, err = repo.Delete(ctx, uuid.New(), first.ID)
+	require.ErrorAs(t, err, &errs.NotFound{}, "images of other products")
+
+	deleted, err := repo.Delete(ctx, product.ID, first.ID)
+	require.NoError(t, err)
+	assert.Equal(t, first.Key, deleted.Key, "the key of the deleted image is returned to remove the object")
+
+	require.NoError(t, productsRepo.Delete(ctx, product.ID))
+	list, err = repo.GetByProductIDs(ctx, []uuid.UUID{product.ID})
+	require.NoError(t, err)
+	assert.Empty(t, list, "images are deleted with their product")
+}
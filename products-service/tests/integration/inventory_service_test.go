@@ -0,0 +1,78 @@
+//go:build integration
+
+package tests_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/at-kh/guru-apps-test-services/platform/errs"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/inventory"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	repoinventory "github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/inventory"
+	repoproducts "github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/products"
+	svcinventory "github.com/at-kh/guru-apps-test-services/products-service/internal/api/services/inventory"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestInventoryService_ConcurrentReservations(t *testing.T) {
+	t.Parallel()
+
+	const stock, reservations = 10, 30
+
+	ctx := context.Background()
+	logger := zap.NewExample()
+	db := newTestDB(t)
+	productsRepo := repoproducts.NewRepository(db, logger)
+	svc := svcinventory.NewService(db, productsRepo, repoinventory.NewRepository(db, logger), &publisherStub{}, logger)
+
+	created, err := productsRepo.Create(ctx,
+		products.Product{Name: "reserved-" + uuid.NewString(), Vendor: "vendorR", Price: decimal.New(10, 0)})
+	require.NoError(t, err)
+
+	_, err = svc.Adjust(ctx, inventory.Adjustment{ProductID: created.ID, Delta: stock})
+	require.NoError(t, err)
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		reserved int
+		errList  []error
+	)
+	for range reservations {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, reserveErr := svc.Reserve(ctx, inventory.Reservation{ProductID: created.ID, Quantity: 1})
+
+			mu.Lock()
+			defer mu.Unlock()
+			if reserveErr != nil {
+				errList = append(errList, reserveErr)
+				return
+			}
+			reserved++
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, stock, reserved, "every unit is reserved once")
+	require.Len(t, errList, reservations-stock)
+	for _, reserveErr := range errList {
+		var conflict errs.Conflict
+		assert.True(t, errors.As(reserveErr, &conflict), "rejected reservation returned %v", reserveErr)
+	}
+
+	stocks, err := svc.GetByProductID(ctx, created.ID)
+	require.NoError(t, err)
+	require.Len(t, stocks, 1)
+	assert.Equal(t, int64(stock), stocks[0].Quantity)
+	assert.Equal(t, int64(stock), stocks[0].Reserved, "the stock is not oversold")
+}
@@ -0,0 +1,270 @@
+//go:build integration
+
+package tests_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/app"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/config"
+	embeddedpostgres "github.com/fergusstrange/embedded-postgres"
+	"github.com/google/uuid"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	testPostgresDriver  = "pgx"
+	testPostgresDialect = "postgres"
+
+	// testConfigPath - configuration of the tests, see it for env variables overriding settings.
+	testConfigPath = "config.yaml"
+)
+
+// testMigrationsFS - the directory embedded into the binary by cmd/main.go, so tests apply the real migrations.
+var testMigrationsFS = os.DirFS("../../cmd")
+
+// testAdminDSN - DSN of the database of the server the tests run on, schemas and databases of tests are
+// created in it.
+var testAdminDSN string
+
+type (
+	// testConfig - configuration of the tests.
+	testConfig struct {
+		Postgres struct {
+			DSN          string        `yaml:"dsn"`
+			Version      string        `yaml:"version"`
+			CachePath    string        `yaml:"cache-path"`
+			StartTimeout time.Duration `yaml:"start-timeout"`
+		} `yaml:"postgres"`
+	}
+)
+
+// TestMain provisions the Postgres server of the tests: an existing server when its DSN is configured,
+// otherwise a throwaway embedded server stopped when the tests end.
+func TestMain(m *testing.M) {
+	cfg, err := loadTestConfig(testConfigPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "integration tests:", err)
+		os.Exit(1)
+	}
+
+	stop := func() error { return nil }
+	testAdminDSN = cfg.Postgres.DSN
+	if testAdminDSN == "" {
+		testAdminDSN, stop, err = startEmbeddedPostgres(cfg)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "integration tests: failed to start embedded postgres, "+
+				"set INTEGRATION_POSTGRES_DSN to use an existing server:", err)
+			os.Exit(1)
+		}
+	}
+
+	if err = installExtensions(testAdminDSN); err != nil {
+		fmt.Fprintln(os.Stderr, "integration tests:", err)
+		_ = stop()
+		os.Exit(1)
+	}
+
+	code := m.Run()
+	if err = stop(); err != nil {
+		fmt.Fprintln(os.Stderr, "integration tests: failed to stop embedded postgres:", err)
+	}
+
+	os.Exit(code)
+}
+
+// loadTestConfig reads the configuration of the tests from path and overrides it by env variables.
+func loadTestConfig(path string) (testConfig, error) {
+	var cfg testConfig
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, fmt.Errorf("failed to read config: %w", err)
+	}
+	if err = yaml.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("failed to parse config %s: %w", path, err)
+	}
+
+	if v, ok := os.LookupEnv("INTEGRATION_POSTGRES_DSN"); ok {
+		cfg.Postgres.DSN = v
+	}
+	if v, ok := os.LookupEnv("INTEGRATION_POSTGRES_VERSION"); ok {
+		cfg.Postgres.Version = v
+	}
+	if v, ok := os.LookupEnv("INTEGRATION_POSTGRES_CACHE_PATH"); ok {
+		cfg.Postgres.CachePath = v
+	}
+	if v, ok := os.LookupEnv("INTEGRATION_POSTGRES_START_TIMEOUT"); ok {
+		if cfg.Postgres.StartTimeout, err = time.ParseDuration(v); err != nil {
+			return cfg, fmt.Errorf("invalid INTEGRATION_POSTGRES_START_TIMEOUT: %w", err)
+		}
+	}
+
+	return cfg, nil
+}
+
+// startEmbeddedPostgres starts a server with data in a temporary directory on a free port, the binaries are
+// downloaded on the first run. Returns DSN of its database and a function stopping it.
+func startEmbeddedPostgres(cfg testConfig) (string, func() error, error) {
+	dir, err := os.MkdirTemp("", "products-service-postgres-")
+	if err != nil {
+		return "", nil, err
+	}
+
+	port, err := freePort()
+	if err != nil {
+		return "", nil, err
+	}
+
+	var logs bytes.Buffer
+	epCfg := embeddedpostgres.DefaultConfig().
+		Version(embeddedpostgres.PostgresVersion(cfg.Postgres.Version)).
+		Port(port).
+		RuntimePath(dir).
+		StartTimeout(cfg.Postgres.StartTimeout).
+		Logger(&logs)
+	if cfg.Postgres.CachePath != "" {
+		epCfg = epCfg.CachePath(cfg.Postgres.CachePath)
+	}
+
+	ep := embeddedpostgres.NewDatabase(epCfg)
+	if err = ep.Start(); err != nil {
+		_ = os.RemoveAll(dir)
+		return "", nil, fmt.Errorf("%w\n%s", err, logs.String())
+	}
+
+	stop := func() error {
+		defer func() { _ = os.RemoveAll(dir) }()
+		return ep.Stop()
+	}
+
+	return epCfg.GetConnectionURL() + "?sslmode=disable", stop, nil
+}
+
+// installExtensions installs extensions of the migrations into the public schema, so migrations applied in
+// schemas of tests find them.
+func installExtensions(dsn string) error {
+	db, err := sqlx.Connect(testPostgresDriver, dsn)
+	if err != nil {
+		return fmt.Errorf("failed to connect to postgres: %w", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	for _, ext := range []string{"pgcrypto", "btree_gist"} {
+		if _, err = db.Exec(`CREATE EXTENSION IF NOT EXISTS "` + ext + `" SCHEMA public`); err != nil {
+			return fmt.Errorf("failed to install extension %s: %w", ext, err)
+		}
+	}
+
+	return nil
+}
+
+// newTestDB creates a schema with the migrations applied for a test and returns a database using it by
+// default, so tests run in parallel without seeing data of each other. The schema is dropped when the test ends.
+func newTestDB(t testing.TB) *sqlx.DB {
+	t.Helper()
+
+	schema := "test_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	admin := openTestDB(t, testAdminDSN)
+	_, err := admin.Exec(`CREATE SCHEMA ` + schema)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := admin.Exec(`DROP SCHEMA ` + schema + ` CASCADE`)
+		require.NoError(t, err)
+	})
+
+	dsn := withDSNParams(t, testAdminDSN, "", url.Values{"search_path": {schema + ",public"}})
+	migrateTestDB(t, dsn, "up")
+
+	return openTestDB(t, dsn)
+}
+
+// newTestDatabase creates an empty database for a test and returns its DSN, e.g. for migrations changing
+// extensions, which belong to databases. The database is dropped when the test ends.
+func newTestDatabase(t testing.TB) string {
+	t.Helper()
+
+	name := "test_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	admin := openTestDB(t, testAdminDSN)
+	_, err := admin.Exec(`CREATE DATABASE ` + name)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := admin.Exec(`DROP DATABASE ` + name + ` WITH (FORCE)`)
+		require.NoError(t, err)
+	})
+
+	return withDSNParams(t, testAdminDSN, name, nil)
+}
+
+// migrateTestDB runs the migrate command of the application with args on a database.
+func migrateTestDB(t testing.TB, dsn string, args ...string) {
+	t.Helper()
+
+	cfg := &config.Config{}
+	cfg.Storage.Postgres = config.Postgres{
+		DSN:                dsn,
+		Driver:             testPostgresDriver,
+		Dialect:            testPostgresDialect,
+		MigrationDirectory: "dbschema/migrations",
+	}
+
+	a := app.New(app.Meta{}).
+		WithMigrationFS(testMigrationsFS).
+		WithConfig(cfg).
+		WithLogger(zaptest.NewLogger(t))
+
+	require.NoError(t, a.Migrate(context.Background(), args))
+}
+
+// openTestDB connects to a database, the connection pool is closed when the test ends.
+func openTestDB(t testing.TB, dsn string) *sqlx.DB {
+	t.Helper()
+
+	db, err := sqlx.Connect(testPostgresDriver, dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	return db
+}
+
+// withDSNParams returns dsn with the database replaced when it is not empty and params set.
+func withDSNParams(t testing.TB, dsn, database string, params url.Values) string {
+	t.Helper()
+
+	u, err := url.Parse(dsn)
+	require.NoError(t, err, "DSN must be in the URL form")
+
+	if database != "" {
+		u.Path = "/" + database
+	}
+	q := u.Query()
+	for k, v := range params {
+		q[k] = v
+	}
+	u.RawQuery = q.Encode()
+
+	return u.String()
+}
+
+// freePort returns a local port which is free at the moment.
+func freePort() (uint32, error) {
+	l, err := net.Listen("tcp4", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer l.Close()
+
+	return uint32(l.Addr().(*net.TCPAddr).Port), nil
+}
@@ -0,0 +1,90 @@
+//go:build integration
+
+package tests_test
+
+import (
+	"io/fs"
+	"path"
+	"strconv"
+	"testing"
+
+	migrate "github.com/rubenv/sql-migrate"
+	"github.com/stretchr/testify/require"
+)
+
+// migrationsCount returns number of the migrations applied by the tests.
+func migrationsCount(t *testing.T) int {
+	t.Helper()
+
+	names, err := fs.Glob(testMigrationsFS, path.Join("dbschema/migrations", "*.sql"))
+	require.NoError(t, err)
+	require.NotEmpty(t, names)
+
+	return len(names)
+}
+
+// tableNames returns tables of the public schema except the table of applied migrations.
+func tableNames(t *testing.T, dsn string) []string {
+	t.Helper()
+
+	var names []string
+	require.NoError(t, openTestDB(t, dsn).Select(&names, `
+		SELECT table_name FROM information_schema.tables
+		WHERE table_schema = 'public' AND table_name <> 'gorp_migrations'
+		ORDER BY table_name`))
+
+	return names
+}
+
+// appliedMigrations returns number of migrations applied to a database.
+func appliedMigrations(t *testing.T, dsn string) int {
+	t.Helper()
+
+	records, err := migrate.GetMigrationRecords(openTestDB(t, dsn).DB, testPostgresDialect)
+	require.NoError(t, err)
+
+	return len(records)
+}
+
+func TestMigrations_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	// down migrations drop extensions, so they are applied to a database of the test
+	dsn := newTestDatabase(t)
+	n := migrationsCount(t)
+
+	migrateTestDB(t, dsn, "up")
+	require.Equal(t, n, appliedMigrations(t, dsn))
+	tables := tableNames(t, dsn)
+	require.Contains(t, tables, "products")
+
+	t.Run("down all", func(t *testing.T) {
+		migrateTestDB(t, dsn, "down", strconv.Itoa(n), "-confirm")
+		require.Zero(t, appliedMigrations(t, dsn))
+		require.Empty(t, tableNames(t, dsn))
+	})
+
+	t.Run("up again", func(t *testing.T) {
+		migrateTestDB(t, dsn, "up")
+		require.Equal(t, n, appliedMigrations(t, dsn))
+		require.Equal(t, tables, tableNames(t, dsn))
+	})
+
+	t.Run("redo last", func(t *testing.T) {
+		migrateTestDB(t, dsn, "redo", "-confirm")
+		require.Equal(t, n, appliedMigrations(t, dsn))
+		require.Equal(t, tables, tableNames(t, dsn))
+	})
+
+	t.Run("down and up one by one", func(t *testing.T) {
+		for i := n; i > 0; i-- {
+			migrateTestDB(t, dsn, "down", "-confirm")
+			require.Equal(t, i-1, appliedMigrations(t, dsn))
+		}
+		for i := 1; i <= n; i++ {
+			migrateTestDB(t, dsn, "up", "1")
+			require.Equal(t, i, appliedMigrations(t, dsn))
+		}
+		require.Equal(t, tables, tableNames(t, dsn))
+	})
+}
@@ -0,0 +1,91 @@
+//go:build integration
+
+package tests_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/prices"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	repoprices "github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/prices"
+	repoproducts "github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/products"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestPricesRepository_Create(t *testing.T) {
+	t.Parallel()
+
+	t.Run("price before a promo is in effect after it ends", func(t *testing.T) {
+		db := newTestDB(t)
+		ctx := context.Background()
+		productsRepo := repoproducts.NewRepository(db, zap.NewExample())
+		pricesRepo := repoprices.NewRepository(db, zap.NewExample())
+
+		created, err := productsRepo.Create(ctx,
+			products.Product{Name: "promo-" + uuid.NewString(), Vendor: "vendorA", Price: decimal.New(10, 0)})
+		require.NoError(t, err)
+
+		from := time.Now().Add(200 * time.Millisecond)
+		to := from.Add(200 * time.Millisecond)
+		_, err = pricesRepo.Create(ctx, prices.Price{
+			ProductID: created.ID, Amount: decimal.New(8, 0), Currency: "USD", EffectiveFrom: from, EffectiveTo: &to,
+		})
+		require.NoError(t, err)
+
+		history, err := pricesRepo.GetByProductID(ctx, created.ID)
+		require.NoError(t, err)
+		require.Len(t, history, 3, "the open price is split around the promo")
+		assert.True(t, decimal.New(10, 0).Equal(history[0].Amount))
+		assert.Nil(t, history[0].EffectiveTo)
+		assert.True(t, decimal.New(8, 0).Equal(history[1].Amount))
+		assert.True(t, decimal.New(10, 0).Equal(history[2].Amount))
+		require.NotNil(t, history[2].EffectiveTo)
+
+		time.Sleep(time.Until(to) + 50*time.Millisecond)
+
+		got, err := productsRepo.GetByID(ctx, created.ID)
+		require.NoError(t, err)
+		assert.True(t, decimal.New(10, 0).Equal(got.Price), "got %s", got.Price)
+
+		later := to.Add(time.Hour)
+		_, err = pricesRepo.Create(ctx, prices.Price{
+			ProductID: created.ID, Amount: decimal.New(12, 0), Currency: "USD", EffectiveFrom: later,
+		})
+		require.NoError(t, err)
+
+		history, err = pricesRepo.GetByProductID(ctx, created.ID)
+		require.NoError(t, err)
+		require.Len(t, history, 4)
+		require.NotNil(t, history[1].EffectiveTo, "the continued price is closed by an open-ended price")
+		assert.WithinDuration(t, later, *history[1].EffectiveTo, time.Millisecond)
+	})
+
+	t.Run("[ERROR] overlapping promos", func(t *testing.T) {
+		db := newTestDB(t)
+		ctx := context.Background()
+		pricesRepo := repoprices.NewRepository(db, zap.NewExample())
+
+		created, err := repoproducts.NewRepository(db, zap.NewExample()).Create(ctx,
+			products.Product{Name: "promo-" + uuid.NewString(), Vendor: "vendorA", Price: decimal.New(10, 0)})
+		require.NoError(t, err)
+
+		from := time.Now().Add(time.Hour)
+		to := from.Add(time.Hour)
+		promo := prices.Price{
+			ProductID: created.ID, Amount: decimal.New(8, 0), Currency: "USD", EffectiveFrom: from, EffectiveTo: &to,
+		}
+		_, err = pricesRepo.Create(ctx, promo)
+		require.NoError(t, err)
+
+		overlapping := from.Add(30 * time.Minute)
+		promo.EffectiveFrom = overlapping
+		_, err = pricesRepo.Create(ctx, promo)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,104 @@
+//go:build integration
+
+package tests_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/changes"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/product_changes"
+	repoproducts "github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/products"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// waitChanges returns changes after a position once there are n of them, transactions of parallel tests hold
+// back changes committed after they are started.
+func waitChanges(t *testing.T, repo *product_changes.Repository, after changes.Position, n int) changes.Changes {
+	t.Helper()
+
+	var got changes.Changes
+	require.Eventually(t, func() bool {
+		var err error
+		got, err = repo.GetAfter(context.Background(), after, changes.MaxBatch)
+		require.NoError(t, err)
+		return len(got) >= n
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Len(t, got, n)
+
+	return got
+}
+
+func TestProductChangesRepository(t *testing.T) {
+	t.Parallel()
+
+	t.Run("changes are recorded by triggers", func(t *testing.T) {
+		db := newTestDB(t)
+		ctx := context.Background()
+		repo := product_changes.NewRepository(db, zap.NewExample())
+		productsRepo := repoproducts.NewRepository(db, zap.NewExample())
+
+		start, err := repo.Latest(ctx)
+		require.NoError(t, err)
+
+		created, err := productsRepo.Create(ctx,
+			products.Product{Name: "changed-" + uuid.NewString(), Vendor: "vendorA", Price: decimal.New(1, 0)})
+		require.NoError(t, err)
+		created.Description = "updated"
+		_, err = productsRepo.Update(ctx, created)
+		require.NoError(t, err)
+		require.NoError(t, productsRepo.Delete(ctx, created.ID))
+
+		got := waitChanges(t, repo, start, 3)
+		assert.Equal(t, []string{changes.TypeCreate, changes.TypeUpdate, changes.TypeDelete},
+			[]string{got[0].Type, got[1].Type, got[2].Type})
+		for _, c := range got {
+			assert.Equal(t, created.ID, c.ProductID)
+			assert.True(t, c.Position.After(start))
+		}
+
+		after, err := repo.GetAfter(ctx, got[0].Position, 1)
+		require.NoError(t, err)
+		assert.Equal(t, changes.Changes{got[1]}, after)
+
+		purged, err := repo.Purge(ctx, time.Now().Add(time.Minute))
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), purged)
+	})
+
+	t.Run("changes are read in order of commits", func(t *testing.T) {
+		db := newTestDB(t)
+		ctx := context.Background()
+		repo := product_changes.NewRepository(db, zap.NewExample())
+
+		start, err := repo.Latest(ctx)
+		require.NoError(t, err)
+
+		tx, err := db.BeginTxx(ctx, nil)
+		require.NoError(t, err)
+		defer func() { _ = tx.Rollback() }()
+
+		first, err := repoproducts.NewRepository(tx, zap.NewExample()).Create(ctx,
+			products.Product{Name: "first-" + uuid.NewString(), Vendor: "vendorA", Price: decimal.New(1, 0)})
+		require.NoError(t, err)
+
+		second, err := repoproducts.NewRepository(db, zap.NewExample()).Create(ctx,
+			products.Product{Name: "second-" + uuid.NewString(), Vendor: "vendorA", Price: decimal.New(1, 0)})
+		require.NoError(t, err)
+
+		got, err := repo.GetAfter(ctx, start, changes.MaxBatch)
+		require.NoError(t, err)
+		assert.Empty(t, got, "changes committed after a running transaction are held back")
+
+		require.NoError(t, tx.Commit())
+
+		got = waitChanges(t, repo, start, 2)
+		assert.Equal(t, []uuid.UUID{first.ID, second.ID}, []uuid.UUID{got[0].ProductID, got[1].ProductID})
+	})
+}
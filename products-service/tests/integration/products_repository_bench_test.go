@@ -0,0 +1,74 @@
+//go:build integration
+
+package tests_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/seed"
+	"github.com/google/uuid"
+)
+
+// benchCount - number of products stored before benchmarks of reads.
+const benchCount = 10_000
+
+// newBenchRepo returns a repository with benchCount generated products and their ids.
+func newBenchRepo(b *testing.B) (repositories.ProductsRepository, []uuid.UUID) {
+	b.Helper()
+
+	repo := newRepo(b)
+	ids, err := repo.CreateBatch(context.Background(), seed.NewGenerator(1).Products(benchCount))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	return repo, ids
+}
+
+func BenchmarkRepository_Create(b *testing.B) {
+	repo := newRepo(b)
+	p := seed.NewGenerator(1).Product()
+	name := p.Name
+
+	b.ReportAllocs()
+	for i := 0; b.Loop(); i++ {
+		p.Name = name + " " + strconv.Itoa(i)
+		if _, err := repo.Create(context.Background(), p); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkRepository_GetByID(b *testing.B) {
+	repo, ids := newBenchRepo(b)
+
+	b.ReportAllocs()
+	for i := 0; b.Loop(); i++ {
+		if _, err := repo.GetByID(context.Background(), ids[i%len(ids)]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkRepository_GetAll(b *testing.B) {
+	repo, _ := newBenchRepo(b)
+
+	for name, filter := range map[string]products.Filter{
+		"all":    {},
+		"by tag": {Tag: "electronics"},
+	} {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; b.Loop(); i++ {
+				offset := uint64(i%50) * products.DefaultLimit
+				if _, err := repo.GetAll(context.Background(), products.DefaultLimit, offset, filter); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
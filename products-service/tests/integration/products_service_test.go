@@ -0,0 +1,112 @@
+//go:build integration
+
+package tests_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/inventory"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories"
+	repoproducts "github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/products"
+	svcproducts "github.com/at-kh/guru-apps-test-services/products-service/internal/api/services/products"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var _ repositories.SQSPublisherRepository = &publisherStub{}
+
+// publisherStub - publisher of events recording ids of products, it fails sends with err when it is set.
+type publisherStub struct {
+	mu      sync.Mutex
+	err     error
+	created []uuid.UUID
+}
+
+func (p *publisherStub) CreateToNotificationsService(_ context.Context, id uuid.UUID) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.err != nil {
+		return p.err
+	}
+	p.created = append(p.created, id)
+
+	return nil
+}
+
+func (p *publisherStub) UpdateToNotificationsService(context.Context, uuid.UUID) error { return p.err }
+
+func (p *publisherStub) DeleteToNotificationsService(context.Context, uuid.UUID) error { return p.err }
+
+func (p *publisherStub) StockAlertToNotificationsService(context.Context, inventory.Alert) error {
+	return p.err
+}
+
+// newService returns the service of products with Postgres repositories in a migrated schema of the test
+// publishing events to publisher, the database of the schema is returned to check its data.
+func newService(t *testing.T, publisher repositories.SQSPublisherRepository) (*svcproducts.Service, *sqlx.DB) {
+	logger := zap.NewExample()
+	db := newTestDB(t)
+
+	svc := svcproducts.NewService(repoproducts.NewRepository(db, logger), repoproducts.NewTxRepository(db, logger),
+		nil, nil, nil, nil, nil, publisher, nil, logger)
+
+	return svc, db
+}
+
+// countRows returns number of rows in a table.
+func countRows(t *testing.T, db *sqlx.DB, table string) int {
+	t.Helper()
+
+	var n int
+	require.NoError(t, db.Get(&n, `SELECT count(*) FROM `+table))
+
+	return n
+}
+
+func TestService_Create(t *testing.T) {
+	t.Parallel()
+
+	product := products.Product{
+		Name:        "published-" + uuid.NewString(),
+		Vendor:      "vendorP",
+		Description: "some",
+		Price:       decimal.NewFromFloat(3.5),
+	}
+
+	t.Run("[SUCCESS] published product is committed", func(t *testing.T) {
+		t.Parallel()
+
+		publisher := &publisherStub{}
+		svc, db := newService(t, publisher)
+
+		created, err := svc.Create(context.Background(), product)
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{created.ID}, publisher.created)
+
+		require.Equal(t, 1, countRows(t, db, "products"))
+		require.Equal(t, 1, countRows(t, db, "vendors"))
+	})
+
+	t.Run("[ERROR] product is rolled back when it is not published", func(t *testing.T) {
+		t.Parallel()
+
+		publisher := &publisherStub{err: errors.New("queue does not exist")}
+		svc, db := newService(t, publisher)
+
+		_, err := svc.Create(context.Background(), product)
+		require.ErrorIs(t, err, publisher.err)
+		require.Empty(t, publisher.created)
+
+		// the vendor created with the product is rolled back too
+		require.Zero(t, countRows(t, db, "products"))
+		require.Zero(t, countRows(t, db, "vendors"))
+	})
+}
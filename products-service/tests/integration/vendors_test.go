@@ -0,0 +1,116 @@
+//go:build integration
+
+package tests_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/at-kh/guru-apps-test-services/products-service/internal/api/domain/products"
+	repoproducts "github.com/at-kh/guru-apps-test-services/products-service/internal/api/repositories/products"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// vendorsMigrations - the number of migrations applied before vendors are created.
+const vendorsMigrations = "4"
+
+func TestNormalizeVendorName(t *testing.T) {
+	t.Parallel()
+
+	db := newTestDB(t)
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "Acme", want: "acme"},
+		{name: "  ACME  ", want: "acme"},
+		{name: "Acme Inc.", want: "acme"},
+		{name: "Acme, LLC", want: "acme"},
+		{name: "Acme Corporation", want: "acme"},
+		{name: "Acme-Tools GmbH", want: "acme tools"},
+		{name: "Incorporated Co", want: "incorporated"},
+		{name: "Co", want: "co"}, // a name of only a suffix is kept
+		{name: "Inco Ltd", want: "inco"},
+	}
+
+	for _, tt := range tests {
+		var got string
+		require.NoError(t, db.Get(&got, `SELECT normalize_vendor_name($1)`, tt.name))
+		assert.Equal(t, tt.want, got, tt.name)
+	}
+}
+
+func TestMigrations_VendorsAreMerged(t *testing.T) {
+	t.Parallel()
+
+	dsn := newTestDatabase(t)
+	migrateTestDB(t, dsn, "up", vendorsMigrations)
+	db := openTestDB(t, dsn)
+
+	insert := `INSERT INTO products (name, vendor, price, created_at) VALUES ($1, $2, 1, now() + $3 * interval '1s')`
+	for i, p := range [][2]string{
+		{"Widget", "Acme"},
+		{"Widget", "ACME Inc."},
+		{"Gadget", "acme, llc"},
+		{"Widget", "Globex"},
+	} {
+		_, err := db.Exec(insert, p[0], p[1], i)
+		require.NoError(t, err)
+	}
+
+	migrateTestDB(t, dsn, "up", "1")
+
+	var vendors []string
+	require.NoError(t, db.Select(&vendors, `SELECT name FROM vendors ORDER BY name`))
+	assert.Equal(t, []string{"Acme", "Globex"}, vendors, "spellings are merged, the earliest one is kept")
+
+	var names []string
+	require.NoError(t, db.Select(&names, `
+		SELECT p.name FROM products p JOIN vendors v ON v.id = p.vendor_id
+		WHERE v.name = 'Acme' ORDER BY p.created_at`))
+	require.Len(t, names, 3)
+	assert.Equal(t, "Widget", names[0])
+	assert.Regexp(t, `^Widget \([0-9a-f]{8}\)$`, names[1], "duplicates after merging keep their id suffix")
+	assert.Equal(t, "Gadget", names[2])
+}
+
+func TestProductsRepository_ResolveVendor(t *testing.T) {
+	t.Parallel()
+
+	db := newTestDB(t)
+	ctx := context.Background()
+	repo := repoproducts.NewRepository(db, zap.NewExample())
+
+	first, err := repo.Create(ctx, products.Product{Name: "first", Vendor: "Initech", Price: decimal.New(1, 0)})
+	require.NoError(t, err)
+	second, err := repo.Create(ctx, products.Product{Name: "second", Vendor: "INITECH inc", Price: decimal.New(1, 0)})
+	require.NoError(t, err)
+	assert.Equal(t, first.VendorID, second.VendorID)
+	assert.Equal(t, "Initech", second.Vendor, "the vendor keeps its first spelling")
+
+	// products of a new vendor created concurrently share the vendor
+	var (
+		wg      sync.WaitGroup
+		created = make([]products.Product, 10)
+		errs    = make([]error, 10)
+		vendor  = "Vendor " + uuid.NewString()
+	)
+	for i := range created {
+		wg.Go(func() {
+			created[i], errs[i] = repo.Create(ctx,
+				products.Product{Name: uuid.NewString(), Vendor: vendor, Price: decimal.New(1, 0)})
+		})
+	}
+	wg.Wait()
+
+	for i := range created {
+		require.NoError(t, errs[i])
+		assert.Equal(t, created[0].VendorID, created[i].VendorID)
+	}
+}